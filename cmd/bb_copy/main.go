@@ -29,6 +29,10 @@ import (
 // ZIPWritingBlobAccess, this tool can also be used to backup and
 // restore parts of the Content Addressable Storage.
 
+// listedBlobsBatchSize is the maximum number of objects obtained by
+// listing the contents of the source that are replicated at once.
+const listedBlobsBatchSize = 1000
+
 func main() {
 	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
 		if len(os.Args) != 2 {
@@ -97,6 +101,34 @@ func main() {
 				return util.StatusWrapf(err, "Failed to schedule replication of blob with digest %#v", blobDigest.String())
 			}
 		}
+		if configuration.CopyAllBlobs {
+			// Replicate all objects contained in the source in
+			// batches, so that the replicator can check for
+			// existence in bulk.
+			blobDigests := digest.NewSetBuilder()
+			replicateBatch := func() error {
+				batch := blobDigests.Build()
+				blobDigests = digest.NewSetBuilder()
+				if err := replicator.ReplicateMultiple(ctx, batch); err != nil {
+					return util.StatusWrap(err, "Failed to schedule replication of listed blobs")
+				}
+				return nil
+			}
+			if err := source.BlobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+				blobDigests.Add(blobDigest)
+				if blobDigests.Length() < listedBlobsBatchSize {
+					return nil
+				}
+				return replicateBatch()
+			}); err != nil {
+				return util.StatusWrap(err, "Failed to list blobs contained in the source")
+			}
+			if blobDigests.Length() > 0 {
+				if err := replicateBatch(); err != nil {
+					return err
+				}
+			}
+		}
 		for i, directory := range configuration.Directories {
 			directoryDigest, err := digestFunction.NewDigestFromProto(directory)
 			if err != nil {
@@ -5,15 +5,19 @@ import (
 	"os"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/audit"
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
 	"github.com/buildbarn/bb-storage/pkg/builder"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_storage"
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
 	"github.com/buildbarn/bb-storage/pkg/proto/icas"
@@ -47,12 +51,18 @@ func main() {
 		var cacheCapabilitiesProviders []capabilities.Provider
 		var cacheCapabilitiesAuthorizers []auth.Authorizer
 
+		// Data stores against which administrative operations may
+		// be performed. These are accessed without going through
+		// the data stores' own authorizers.
+		adminBlobAccesses := map[admin.StorageType]blobstore.BlobAccess{}
+
 		// Content Addressable Storage (CAS).
 		var contentAddressableStorageInfo *blobstore_configuration.BlobAccessInfo
 		var contentAddressableStorage blobstore.BlobAccess
 		if configuration.ContentAddressableStorage != nil {
 			info, authorizedBackend, allAuthorizers, err := newScannableBlobAccess(
 				dependenciesGroup,
+				grpcClientFactory,
 				configuration.ContentAddressableStorage,
 				blobstore_configuration.NewCASBlobAccessCreator(
 					grpcClientFactory,
@@ -60,10 +70,30 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Content Addressable Storage")
 			}
-			cacheCapabilitiesProviders = append(cacheCapabilitiesProviders, info.BlobAccess)
+			// The ByteStream server is capable of compressing
+			// and decompressing data on the fly, regardless of
+			// the backend that is used.
+			cacheCapabilitiesProviders = append(
+				cacheCapabilitiesProviders,
+				capabilities.NewSupportedCompressorsSettingProvider(info.BlobAccess, compression.SupportedCompressors))
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
 			contentAddressableStorageInfo = &info
 			contentAddressableStorage = authorizedBackend
+			adminBlobAccesses[admin.StorageType_CONTENT_ADDRESSABLE_STORAGE] = info.BlobAccess
+		}
+
+		// Optional: retain data of ByteStream uploads that
+		// terminated prematurely, so that they may be resumed.
+		var uploadStagingArea *grpcservers.UploadStagingArea
+		if resumableUploads := configuration.ResumableUploads; resumableUploads != nil {
+			retention := resumableUploads.Retention
+			if err := retention.CheckValid(); err != nil {
+				return util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid resumable uploads retention")
+			}
+			uploadStagingArea = grpcservers.NewUploadStagingArea(
+				clock.SystemClock,
+				resumableUploads.MaximumSizeBytes,
+				retention.AsDuration())
 		}
 
 		// Action Cache (AC).
@@ -71,6 +101,7 @@ func main() {
 		if configuration.ActionCache != nil {
 			info, authorizedBackend, allAuthorizers, putAuthorizer, err := newNonScannableBlobAccess(
 				dependenciesGroup,
+				grpcClientFactory,
 				configuration.ActionCache,
 				blobstore_configuration.NewACBlobAccessCreator(
 					contentAddressableStorageInfo,
@@ -84,13 +115,15 @@ func main() {
 				capabilities.NewActionCacheUpdateEnabledClearingProvider(info.BlobAccess, putAuthorizer))
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
 			actionCache = authorizedBackend
+			adminBlobAccesses[admin.StorageType_ACTION_CACHE] = info.BlobAccess
 		}
 
 		// Buildbarn extension: Indirect Content Addressable Storage (ICAS).
 		var indirectContentAddressableStorage blobstore.BlobAccess
 		if configuration.IndirectContentAddressableStorage != nil {
-			_, authorizedBackend, _, err := newScannableBlobAccess(
+			info, authorizedBackend, _, err := newScannableBlobAccess(
 				dependenciesGroup,
+				grpcClientFactory,
 				configuration.IndirectContentAddressableStorage,
 				blobstore_configuration.NewICASBlobAccessCreator(
 					grpcClientFactory,
@@ -99,13 +132,15 @@ func main() {
 				return util.StatusWrap(err, "Failed to create Indirect Content Addressable Storage")
 			}
 			indirectContentAddressableStorage = authorizedBackend
+			adminBlobAccesses[admin.StorageType_INDIRECT_CONTENT_ADDRESSABLE_STORAGE] = info.BlobAccess
 		}
 
 		// Buildbarn extension: Initial Size Class Cache (ISCC).
 		var initialSizeClassCache blobstore.BlobAccess
 		if configuration.InitialSizeClassCache != nil {
-			_, authorizedBackend, _, _, err := newNonScannableBlobAccess(
+			info, authorizedBackend, _, _, err := newNonScannableBlobAccess(
 				dependenciesGroup,
+				grpcClientFactory,
 				configuration.InitialSizeClassCache,
 				blobstore_configuration.NewISCCBlobAccessCreator(
 					grpcClientFactory,
@@ -114,13 +149,15 @@ func main() {
 				return util.StatusWrap(err, "Failed to create Initial Size Class Cache")
 			}
 			initialSizeClassCache = authorizedBackend
+			adminBlobAccesses[admin.StorageType_INITIAL_SIZE_CLASS_CACHE] = info.BlobAccess
 		}
 
 		// Buildbarn extension: File System Access Cache (FSAC).
 		var fileSystemAccessCache blobstore.BlobAccess
 		if configuration.FileSystemAccessCache != nil {
-			_, authorizedBackend, _, _, err := newNonScannableBlobAccess(
+			info, authorizedBackend, _, _, err := newNonScannableBlobAccess(
 				dependenciesGroup,
+				grpcClientFactory,
 				configuration.FileSystemAccessCache,
 				blobstore_configuration.NewFSACBlobAccessCreator(
 					grpcClientFactory,
@@ -129,6 +166,7 @@ func main() {
 				return util.StatusWrap(err, "Failed to create File System Access Cache")
 			}
 			fileSystemAccessCache = authorizedBackend
+			adminBlobAccesses[admin.StorageType_FILE_SYSTEM_ACCESS_CACHE] = info.BlobAccess
 		}
 
 		var capabilitiesProviders []capabilities.Provider
@@ -152,10 +190,28 @@ func main() {
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create execute authorizer")
 			}
-			buildQueue = builder.NewAuthorizingBuildQueue(baseBuildQueue, executeAuthorizer)
+			executeAuditLogger, err := audit.NewLoggerFromConfiguration(configuration.ExecuteAuditLogger, "execution", dependenciesGroup, grpcClientFactory)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create execute audit logger")
+			}
+			buildQueue = builder.NewAuthorizingBuildQueue(baseBuildQueue, executeAuthorizer, executeAuditLogger)
 			capabilitiesProviders = append(capabilitiesProviders, buildQueue)
 		}
 
+		// Optional: Admin service.
+		var adminServer admin.AdminServer
+		if adminAuthorizerConfiguration := configuration.AdminAuthorizer; adminAuthorizerConfiguration != nil {
+			adminAuthorizer, err := auth.DefaultAuthorizerFactory.NewAuthorizerFromConfiguration(adminAuthorizerConfiguration)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create admin authorizer")
+			}
+			adminAuditLogger, err := audit.NewLoggerFromConfiguration(configuration.AdminAuditLogger, "admin", dependenciesGroup, grpcClientFactory)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create admin audit logger")
+			}
+			adminServer = grpcservers.NewAdminServer(adminBlobAccesses, adminAuthorizer, adminAuditLogger)
+		}
+
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.GrpcServers,
 			func(s grpc.ServiceRegistrar) {
@@ -169,7 +225,8 @@ func main() {
 						s,
 						grpcservers.NewByteStreamServer(
 							contentAddressableStorage,
-							1<<16))
+							1<<16,
+							uploadStagingArea))
 				}
 				if actionCache != nil {
 					remoteexecution.RegisterActionCacheServer(
@@ -202,6 +259,9 @@ func main() {
 				if buildQueue != nil {
 					remoteexecution.RegisterExecutionServer(s, buildQueue)
 				}
+				if adminServer != nil {
+					admin.RegisterAdminServer(s, adminServer)
+				}
 				if len(capabilitiesProviders) > 0 {
 					remoteexecution.RegisterCapabilitiesServer(
 						s,
@@ -219,7 +279,7 @@ func main() {
 	})
 }
 
-func newNonScannableBlobAccess(dependenciesGroup program.Group, configuration *bb_storage.NonScannableBlobAccessConfiguration, creator blobstore_configuration.BlobAccessCreator) (blobstore_configuration.BlobAccessInfo, blobstore.BlobAccess, []auth.Authorizer, auth.Authorizer, error) {
+func newNonScannableBlobAccess(dependenciesGroup program.Group, grpcClientFactory bb_grpc.ClientFactory, configuration *bb_storage.NonScannableBlobAccessConfiguration, creator blobstore_configuration.BlobAccessCreator) (blobstore_configuration.BlobAccessInfo, blobstore.BlobAccess, []auth.Authorizer, auth.Authorizer, error) {
 	info, err := blobstore_configuration.NewBlobAccessFromConfiguration(dependenciesGroup, configuration.Backend, creator)
 	if err != nil {
 		return blobstore_configuration.BlobAccessInfo{}, nil, nil, nil, err
@@ -233,15 +293,19 @@ func newNonScannableBlobAccess(dependenciesGroup program.Group, configuration *b
 	if err != nil {
 		return blobstore_configuration.BlobAccessInfo{}, nil, nil, nil, util.StatusWrap(err, "Failed to create Put() authorizer")
 	}
+	auditLogger, err := audit.NewLoggerFromConfiguration(configuration.AuditLogger, creator.GetStorageTypeName(), dependenciesGroup, grpcClientFactory)
+	if err != nil {
+		return blobstore_configuration.BlobAccessInfo{}, nil, nil, nil, util.StatusWrap(err, "Failed to create audit logger")
+	}
 
 	return info,
-		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, nil),
+		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, nil, auditLogger),
 		[]auth.Authorizer{getAuthorizer, putAuthorizer},
 		putAuthorizer,
 		nil
 }
 
-func newScannableBlobAccess(dependenciesGroup program.Group, configuration *bb_storage.ScannableBlobAccessConfiguration, creator blobstore_configuration.BlobAccessCreator) (blobstore_configuration.BlobAccessInfo, blobstore.BlobAccess, []auth.Authorizer, error) {
+func newScannableBlobAccess(dependenciesGroup program.Group, grpcClientFactory bb_grpc.ClientFactory, configuration *bb_storage.ScannableBlobAccessConfiguration, creator blobstore_configuration.BlobAccessCreator) (blobstore_configuration.BlobAccessInfo, blobstore.BlobAccess, []auth.Authorizer, error) {
 	info, err := blobstore_configuration.NewBlobAccessFromConfiguration(dependenciesGroup, configuration.Backend, creator)
 	if err != nil {
 		return blobstore_configuration.BlobAccessInfo{}, nil, nil, err
@@ -259,9 +323,13 @@ func newScannableBlobAccess(dependenciesGroup program.Group, configuration *bb_s
 	if err != nil {
 		return blobstore_configuration.BlobAccessInfo{}, nil, nil, util.StatusWrap(err, "Failed to create FindMissing() authorizer")
 	}
+	auditLogger, err := audit.NewLoggerFromConfiguration(configuration.AuditLogger, creator.GetStorageTypeName(), dependenciesGroup, grpcClientFactory)
+	if err != nil {
+		return blobstore_configuration.BlobAccessInfo{}, nil, nil, util.StatusWrap(err, "Failed to create audit logger")
+	}
 
 	return info,
-		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer),
+		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer, auditLogger),
 		[]auth.Authorizer{getAuthorizer, putAuthorizer, findMissingAuthorizer},
 		nil
 }
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_storage_fsck"
+	blockdevice_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A utility for inspecting and checking the integrity of the data
+// stored by a persistent "local" storage backend, while bb_storage is
+// not running.
+//
+// It reports which blocks referenced by the persistent state can be
+// restored, and walks over all records in the key-location map to
+// check whether they reference data that is present. If digest
+// functions are provided, the contents of objects are validated as
+// well. Optionally, records found to be corrupted are cleared, and
+// invalid blocks are removed from the persistent state.
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bb_storage_fsck bb_storage_fsck.jsonnet")
+		}
+		var configuration bb_storage_fsck.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+
+		localConfiguration := configuration.Local
+		if localConfiguration == nil {
+			return status.Error(codes.InvalidArgument, "No local storage backend configuration provided")
+		}
+		persistent := localConfiguration.Persistent
+		if persistent == nil {
+			return status.Error(codes.InvalidArgument, "Local storage backend does not have persistency enabled")
+		}
+		blocksOnBlockDevice := localConfiguration.GetBlocksOnBlockDevice()
+		if blocksOnBlockDevice == nil {
+			return status.Error(codes.InvalidArgument, "Local storage backend does not store blocks on a block device")
+		}
+		keyLocationMapOnBlockDevice := localConfiguration.GetKeyLocationMapOnBlockDevice()
+		if keyLocationMapOnBlockDevice == nil {
+			return status.Error(codes.InvalidArgument, "Local storage backend does not store the key-location map on a block device")
+		}
+
+		// Read the persistent state.
+		persistentStateDirectory, err := filesystem.NewLocalDirectory(path.NewLocalParser(persistent.StateDirectoryPath))
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to open persistent state directory %#v", persistent.StateDirectoryPath)
+		}
+		defer persistentStateDirectory.Close()
+		if persistent.WriteAheadJournal {
+			// Upon startup, bb_storage replays the journal on
+			// top of the persistent state. Checking or
+			// repairing the persistent state without taking
+			// the journal into account would yield incorrect
+			// results, as blocks that are removed would be
+			// restored by replaying the journal.
+			hasJournalSegments, err := local.HasJournalSegments(persistentStateDirectory)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to check for journal segments in %#v", persistent.StateDirectoryPath)
+			}
+			if hasJournalSegments {
+				return status.Errorf(codes.FailedPrecondition, "Persistent state directory %#v contains write-ahead journal segments. Start bb_storage and shut it down gracefully to incorporate them into the persistent state", persistent.StateDirectoryPath)
+			}
+		}
+		// Records in the key-location map may have been
+		// cleared by a resize that was interrupted.
+		rehashInterrupted, err := local.HasBlockDeviceBackedLocationRecordsRehashFile(persistentStateDirectory)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to check for key-location map rehash file in %#v", persistent.StateDirectoryPath)
+		}
+		if rehashInterrupted {
+			return status.Errorf(codes.FailedPrecondition, "Persistent state directory %#v contains a key-location map rehash file. Start bb_storage to complete resizing the key-location map", persistent.StateDirectoryPath)
+		}
+		persistentStateStore := local.NewStrictDirectoryBackedPersistentStateStore(persistentStateDirectory)
+		persistentState, err := persistentStateStore.ReadPersistentState()
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to read persistent state from %#v", persistent.StateDirectoryPath)
+		}
+
+		// Open the block devices. Only permit writes if repairs
+		// need to be performed.
+		openBlockDevice := func(blockDeviceConfiguration *blockdevice_pb.Configuration) (blockdevice.BlockDevice, int, int64, error) {
+			if configuration.Repair {
+				return blockdevice.NewBlockDeviceFromConfiguration(blockDeviceConfiguration, false)
+			}
+			return blockdevice.NewReadOnlyBlockDeviceFromConfiguration(blockDeviceConfiguration)
+		}
+		var blocksDevices []io.ReaderAt
+		var sectorSizeBytes int
+		var deviceSectorCounts []int64
+		for i, blockDeviceConfiguration := range append([]*blockdevice_pb.Configuration{blocksOnBlockDevice.Source}, blocksOnBlockDevice.AdditionalSources...) {
+			blocksDevice, deviceSectorSizeBytes, sectorCount, err := openBlockDevice(blockDeviceConfiguration)
+			if err != nil {
+				return util.StatusWrapf(err, "Failed to open blocks block device %d", i)
+			}
+			if i == 0 {
+				sectorSizeBytes = deviceSectorSizeBytes
+			} else if deviceSectorSizeBytes != sectorSizeBytes {
+				return status.Errorf(codes.InvalidArgument, "Blocks block device %d has sector size %d, while block device 0 has sector size %d", i, deviceSectorSizeBytes, sectorSizeBytes)
+			}
+			blocksDevices = append(blocksDevices, blocksDevice)
+			deviceSectorCounts = append(deviceSectorCounts, sectorCount)
+		}
+		keyLocationMapDevice, keyLocationMapSectorSizeBytes, keyLocationMapSectorCount, err := openBlockDevice(keyLocationMapOnBlockDevice)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to open key-location map block device")
+		}
+
+		// Compute the dimensions of the blocks and the
+		// key-location map in the same way as bb_storage does.
+		blockCount := local.GetBlockDeviceBackedBlockCount(localConfiguration)
+		blockSectorCount, deviceBlockCounts := local.GetStripedBlockGeometry(deviceSectorCounts, blockCount)
+		if blockSectorCount <= 0 {
+			return status.Errorf(codes.InvalidArgument, "Block devices only have %v sectors (%d bytes each), which is less than the total number of blocks (%d)", deviceSectorCounts, sectorSizeBytes, blockCount)
+		}
+		var encryptionKeys *local.EncryptionKeys
+		if encryption := localConfiguration.Encryption; encryption != nil {
+			encryptionKeys, err = local.NewEncryptionKeysFromConfiguration(encryption)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to load encryption keys")
+			}
+		}
+		recordSizeBytes := local.GetBlockDeviceBackedLocationRecordSize(encryptionKeys)
+		recordsCount := local.GetHashingKeyLocationMapRecordsCount(
+			local.GetBlockDeviceBackedLocationRecordArraySize(keyLocationMapSectorSizeBytes, keyLocationMapSectorCount, encryptionKeys))
+
+		validator, err := newBlobContentsValidator(configuration.DigestFunctions, configuration.InstanceNames, localConfiguration.Compressor)
+		if err != nil {
+			return err
+		}
+		report, err := local.CheckPersistentStorage(
+			persistentState,
+			blocksDevices,
+			sectorSizeBytes,
+			int64(sectorSizeBytes)*blockSectorCount,
+			deviceBlockCounts,
+			keyLocationMapDevice,
+			recordsCount,
+			encryptionKeys,
+			validator)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to check persistent storage")
+		}
+		printReport(report)
+
+		if !configuration.Repair {
+			if report.InvalidBlocks > 0 || len(report.CorruptRecords) > 0 {
+				return status.Error(codes.DataLoss, "Storage contains invalid blocks or corrupted records")
+			}
+			return nil
+		}
+
+		// Clear corrupted records in the key-location map.
+		emptyRecord := make([]byte, recordSizeBytes)
+		for _, corruptRecord := range report.CorruptRecords {
+			if _, err := keyLocationMapDevice.WriteAt(emptyRecord, int64(corruptRecord.Slot)*recordSizeBytes); err != nil {
+				return util.StatusWrapf(err, "Failed to clear record in slot %d", corruptRecord.Slot)
+			}
+		}
+		if err := keyLocationMapDevice.Sync(); err != nil {
+			return util.StatusWrap(err, "Failed to synchronize key-location map block device")
+		}
+		fmt.Printf("Cleared %d corrupted records\n", len(report.CorruptRecords))
+
+		// Remove invalid blocks from the persistent state.
+		if report.InvalidBlocks > 0 {
+			persistentState.Blocks = persistentState.Blocks[:len(report.Blocks)]
+			if err := persistentStateStore.WritePersistentState(persistentState); err != nil {
+				return util.StatusWrapf(err, "Failed to write persistent state to %#v", persistent.StateDirectoryPath)
+			}
+			fmt.Printf("Removed %d invalid blocks from the persistent state\n", report.InvalidBlocks)
+		}
+		return nil
+	})
+}
+
+// newBlobContentsValidator creates a BlobContentsValidator that
+// computes the digests of objects using a set of digest functions, and
+// compares the resulting keys against the ones stored in records of
+// the key-location map. If the storage backend has compression
+// enabled, objects are decompressed prior to validating them.
+func newBlobContentsValidator(digestFunctionValues []remoteexecution.DigestFunction_Value, instanceNameStrings []string, compressor remoteexecution.Compressor_Value) (local.BlobContentsValidator, error) {
+	if len(digestFunctionValues) == 0 {
+		return nil, nil
+	}
+	instanceNames := make([]digest.InstanceName, 0, len(instanceNameStrings))
+	for _, instanceNameString := range instanceNameStrings {
+		instanceName, err := digest.NewInstanceName(instanceNameString)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid instance name %#v", instanceNameString)
+		}
+		instanceNames = append(instanceNames, instanceName)
+	}
+	digestFunctions := make([]digest.Function, 0, len(digestFunctionValues))
+	for _, digestFunctionValue := range digestFunctionValues {
+		digestFunction, err := digest.EmptyInstanceName.GetDigestFunction(digestFunctionValue, 0)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid digest function %s", digestFunctionValue)
+		}
+		digestFunctions = append(digestFunctions, digestFunction)
+	}
+
+	// validateContents writes the contents of an object into
+	// digest generators, and checks whether any of the resulting
+	// digests corresponds with the key.
+	validateContents := func(key local.Key, sizeBytes int64, writeContents func(w io.Writer) error) (bool, error) {
+		generators := make([]*digest.Generator, 0, len(digestFunctions))
+		writers := make([]io.Writer, 0, len(digestFunctions))
+		for _, digestFunction := range digestFunctions {
+			generator := digestFunction.NewGenerator(sizeBytes)
+			generators = append(generators, generator)
+			writers = append(writers, generator)
+		}
+		if err := writeContents(io.MultiWriter(writers...)); err != nil {
+			return false, err
+		}
+
+		for _, generator := range generators {
+			blobDigest := generator.Sum()
+			if local.NewKeyFromString(blobDigest.GetKey(digest.KeyWithoutInstance)) == key {
+				return true, nil
+			}
+			for _, instanceName := range instanceNames {
+				digestFunction, err := instanceName.GetDigestFunction(blobDigest.GetDigestFunction().GetEnumValue(), 0)
+				if err != nil {
+					return false, err
+				}
+				instanceDigest, err := digestFunction.NewDigest(blobDigest.GetHashString(), blobDigest.GetSizeBytes())
+				if err != nil {
+					return false, err
+				}
+				if local.NewKeyFromString(instanceDigest.GetKey(digest.KeyWithInstance)) == key {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		return func(key local.Key, sizeBytes int64, r io.ReaderAt) (bool, error) {
+			return validateContents(key, sizeBytes, func(w io.Writer) error {
+				_, err := io.Copy(w, io.NewSectionReader(r, 0, sizeBytes))
+				return err
+			})
+		}, nil
+	}
+
+	return func(key local.Key, sizeBytes int64, r io.ReaderAt) (bool, error) {
+		decompress := func(w io.Writer) (int64, error) {
+			decompressedReader, err := compression.NewDecompressingReader(
+				buffer.NewValidatedBufferFromReaderAt(nopReadAtCloser{ReaderAt: r}, sizeBytes).ToChunkReader(0, 64*1024),
+				compressor)
+			if err != nil {
+				return 0, err
+			}
+			defer decompressedReader.Close()
+			return io.Copy(w, decompressedReader)
+		}
+
+		// Digest generators need to know the size of the
+		// object up front. Decompress the object twice, so
+		// that it does not need to be held in memory.
+		decompressedSizeBytes, err := decompress(io.Discard)
+		if err != nil {
+			return false, err
+		}
+		return validateContents(key, decompressedSizeBytes, func(w io.Writer) error {
+			_, err := decompress(w)
+			return err
+		})
+	}, nil
+}
+
+// nopReadAtCloser adds a no-op Close() function to an io.ReaderAt.
+type nopReadAtCloser struct {
+	io.ReaderAt
+}
+
+func (nopReadAtCloser) Close() error {
+	return nil
+}
+
+func printReport(report *local.PersistentStorageReport) {
+	fmt.Printf("Oldest epoch ID: %d\n", report.OldestEpochID)
+	fmt.Printf("Epochs: %d\n", report.EpochCount)
+	fmt.Printf("Blocks: %d\n", len(report.Blocks))
+	for i, block := range report.Blocks {
+		fmt.Printf(
+			"  Block %d: device %d, offset %d, %d of %d bytes written, encryption key %d, %d epochs, %d records referencing %d bytes, %d corrupted records\n",
+			i,
+			block.BlockLocation.GetDeviceIndex(),
+			block.BlockLocation.GetOffsetBytes(),
+			block.WriteOffsetBytes,
+			block.BlockLocation.GetSizeBytes(),
+			block.BlockLocation.GetEncryptionKeyId(),
+			block.EpochCount,
+			block.ValidRecords,
+			block.ValidRecordsSizeBytes,
+			block.CorruptRecords)
+	}
+	if report.InvalidBlocks > 0 {
+		fmt.Printf("Invalid blocks: %d (%s)\n", report.InvalidBlocks, report.InvalidBlocksReason)
+	}
+
+	fmt.Printf("Records: %d\n", report.RecordsCount)
+	fmt.Printf("  Empty: %d\n", report.EmptyRecords)
+	fmt.Printf("  Deleted: %d\n", report.DeletedRecords)
+	fmt.Printf("  Verified: %d\n", report.VerifiedRecords)
+	fmt.Printf("  Unverified: %d\n", report.UnverifiedRecords)
+	fmt.Printf("  Corrupted: %d\n", len(report.CorruptRecords))
+	for _, corruptRecord := range report.CorruptRecords {
+		location := corruptRecord.Record.Location
+		fmt.Printf(
+			"    Slot %d: block %d, offset %d, size %d: %s\n",
+			corruptRecord.Slot,
+			location.BlockIndex,
+			location.OffsetBytes,
+			location.SizeBytes,
+			corruptRecord.Reason)
+	}
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"context"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/audit"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+// NewLoggerFromConfiguration creates a Logger based on options stored
+// in a configuration file. The service name is attached to every
+// record, so that records of multiple data stores may be told apart.
+// If no configuration is provided, all events are discarded.
+func NewLoggerFromConfiguration(configuration *pb.LoggerConfiguration, service string, group program.Group, grpcClientFactory bb_grpc.ClientFactory) (Logger, error) {
+	if configuration == nil {
+		return DiscardingLogger, nil
+	}
+	switch backend := configuration.Backend.(type) {
+	case *pb.LoggerConfiguration_JsonLinesPath:
+		f, err := os.OpenFile(backend.JsonLinesPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to open audit log file %#v", backend.JsonLinesPath)
+		}
+		return NewJSONLinesLogger(f, clock.SystemClock, service), nil
+	case *pb.LoggerConfiguration_Otlp:
+		flushInterval := backend.Otlp.FlushInterval
+		if err := flushInterval.CheckValid(); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid flush interval")
+		}
+		if flushInterval.AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Flush interval must be positive")
+		}
+		if backend.Otlp.MaximumBatchSize <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Maximum batch size must be positive")
+		}
+		if backend.Otlp.MaximumBufferedRecords < backend.Otlp.MaximumBatchSize {
+			return nil, status.Error(codes.InvalidArgument, "Maximum number of buffered records must be at least the maximum batch size")
+		}
+		client, err := grpcClientFactory.NewClientFromConfiguration(backend.Otlp.GrpcClient)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to create OTLP gRPC client")
+		}
+		logger := NewOTLPLogger(
+			collogspb.NewLogsServiceClient(client),
+			clock.SystemClock,
+			service,
+			int(backend.Otlp.MaximumBatchSize),
+			int(backend.Otlp.MaximumBufferedRecords))
+		group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			return logger.Run(ctx, flushInterval.AsDuration())
+		})
+		return logger, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "No audit logger backend provided")
+	}
+}
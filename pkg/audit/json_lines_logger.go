@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+)
+
+type jsonLinesLogger struct {
+	clock   clock.Clock
+	service string
+
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONLinesLogger creates a Logger that writes events to an
+// io.Writer, using the JSON Lines format. This can be used to store
+// audit logs in a file.
+func NewJSONLinesLogger(w io.Writer, clock clock.Clock, service string) Logger {
+	return &jsonLinesLogger{
+		clock:   clock,
+		service: service,
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (l *jsonLinesLogger) Log(ctx context.Context, event *Event) {
+	r := newRecord(ctx, l.clock.Now(), l.service, event)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if err := l.encoder.Encode(&r); err != nil {
+		log.Print("Failed to write audit log record: ", err)
+	}
+}
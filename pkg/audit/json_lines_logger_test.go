@@ -0,0 +1,91 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/audit"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestJSONLinesLogger(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	var output bytes.Buffer
+	logger := audit.NewJSONLinesLogger(&output, clock, "ac")
+
+	t.Run("Allowed", func(t *testing.T) {
+		// Only the public part of the authentication metadata
+		// should be logged.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0).UTC())
+		logger.Log(
+			auth.NewContextWithAuthenticationMetadata(
+				ctx,
+				auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+					Public: structpb.NewStructValue(&structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"user": structpb.NewStringValue("jdoe"),
+						},
+					}),
+					Private: structpb.NewStringValue("secret"),
+				})),
+			&audit.Event{
+				Operation:    "Put",
+				InstanceName: digest.MustNewInstanceName("hello"),
+				Digest:       digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 0),
+			})
+		require.Equal(
+			t,
+			"{\"time\":\"1970-01-01T00:16:40Z\",\"service\":\"ac\",\"operation\":\"Put\",\"instanceName\":\"hello\",\"digest\":\"1-e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855-0-hello\",\"decision\":\"ALLOWED\",\"authenticationMetadata\":{\"user\":\"jdoe\"}}\n",
+			output.String())
+		output.Reset()
+	})
+
+	t.Run("Denied", func(t *testing.T) {
+		// Requests of unauthenticated clients should not have
+		// any authentication metadata attached.
+		clock.EXPECT().Now().Return(time.Unix(1001, 0).UTC())
+		logger.Log(ctx, &audit.Event{
+			Operation:    "FindMissing",
+			InstanceName: digest.MustNewInstanceName("hello"),
+			Digest:       digest.BadDigest,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
+		require.Equal(
+			t,
+			"{\"time\":\"1970-01-01T00:16:41Z\",\"service\":\"ac\",\"operation\":\"FindMissing\",\"instanceName\":\"hello\",\"decision\":\"DENIED\",\"error\":\"You shall not pass\"}\n",
+			output.String())
+		output.Reset()
+	})
+
+	t.Run("Performed", func(t *testing.T) {
+		// Events logged after a request has been performed
+		// should contain its outcome.
+		clock.EXPECT().Now().Return(time.Unix(1002, 0).UTC())
+		logger.Log(ctx, &audit.Event{
+			Operation:    "Put",
+			InstanceName: digest.MustNewInstanceName("hello"),
+			Digest:       digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 0),
+			Performed:    true,
+			Result:       status.Error(codes.Unavailable, "Storage backend offline"),
+		})
+		require.Equal(
+			t,
+			"{\"time\":\"1970-01-01T00:16:42Z\",\"service\":\"ac\",\"operation\":\"Put\",\"instanceName\":\"hello\",\"digest\":\"1-e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855-0-hello\",\"decision\":\"ALLOWED\",\"result\":\"Unavailable\",\"resultMessage\":\"Storage backend offline\"}\n",
+			output.String())
+	})
+}
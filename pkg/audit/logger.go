@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/status"
+)
+
+// Decision of whether a request was permitted to be performed.
+type Decision string
+
+const (
+	// DecisionAllowed indicates that the request was permitted.
+	DecisionAllowed Decision = "ALLOWED"
+	// DecisionDenied indicates that the request was rejected by an
+	// authorizer.
+	DecisionDenied Decision = "DENIED"
+)
+
+// Event that needs to be recorded in the audit log.
+type Event struct {
+	Operation    string
+	InstanceName digest.InstanceName
+	// Digest of the object being accessed. This may be set to
+	// digest.BadDigest for operations that don't pertain to a
+	// single object.
+	Digest digest.Digest
+	// Error that caused the request to be denied. If nil, the
+	// request was permitted.
+	Error error
+	// Whether the event is logged after a permitted request has
+	// been performed, meaning that its outcome is known.
+	Performed bool
+	// Error that was returned while performing the request. This
+	// field is only used if Performed is set.
+	Result error
+}
+
+// Logger of events that need to be recorded for auditing purposes.
+// Implementations are responsible for attaching the time at which the
+// event occurred, and the identity of the client that performed it.
+type Logger interface {
+	Log(ctx context.Context, event *Event)
+}
+
+type discardingLogger struct{}
+
+func (discardingLogger) Log(ctx context.Context, event *Event) {}
+
+// DiscardingLogger is an implementation of Logger that discards all
+// events. It can be used in places where audit logging is disabled.
+var DiscardingLogger Logger = discardingLogger{}
+
+// record of an event, as it is written to the audit log.
+type record struct {
+	Time                   time.Time `json:"time"`
+	Service                string    `json:"service"`
+	Operation              string    `json:"operation"`
+	InstanceName           string    `json:"instanceName"`
+	Digest                 string    `json:"digest,omitempty"`
+	Decision               Decision  `json:"decision"`
+	Error                  string    `json:"error,omitempty"`
+	Result                 string    `json:"result,omitempty"`
+	ResultMessage          string    `json:"resultMessage,omitempty"`
+	AuthenticationMetadata any       `json:"authenticationMetadata,omitempty"`
+}
+
+func newRecord(ctx context.Context, now time.Time, service string, event *Event) record {
+	r := record{
+		Time:         now,
+		Service:      service,
+		Operation:    event.Operation,
+		InstanceName: event.InstanceName.String(),
+		Decision:     DecisionAllowed,
+		// Only log the public part of the authentication
+		// metadata, as the private part may contain secrets.
+		AuthenticationMetadata: auth.AuthenticationMetadataFromContext(ctx).GetRaw()["public"],
+	}
+	if event.Digest != digest.BadDigest {
+		r.Digest = event.Digest.String()
+	}
+	if event.Error != nil {
+		r.Decision = DecisionDenied
+		r.Error = status.Convert(event.Error).Message()
+	}
+	if event.Performed {
+		s := status.Convert(event.Result)
+		r.Result = s.Code().String()
+		r.ResultMessage = s.Message()
+	}
+	return r
+}
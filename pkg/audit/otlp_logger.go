@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+var (
+	otlpLoggerPrometheusMetrics sync.Once
+
+	otlpLoggerDiscardedRecords = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "audit",
+			Name:      "otlp_logger_discarded_records_total",
+			Help:      "Number of audit log records that were discarded, because the maximum number of buffered records was reached or because they could not be exported upon termination",
+		})
+)
+
+// OTLPLogger is an implementation of Logger that exports events as
+// OpenTelemetry log records. To reduce the number of calls against the
+// OpenTelemetry collector, records are buffered, and exported in
+// batches by Run().
+//
+// Records that fail to be exported are retained, and are retried
+// during the next flush. To bound memory usage, the oldest records are
+// discarded if the number of buffered records exceeds a limit.
+type OTLPLogger struct {
+	client                 collogspb.LogsServiceClient
+	clock                  clock.Clock
+	service                string
+	maximumBatchSize       int
+	maximumBufferedRecords int
+
+	lock           sync.Mutex
+	pendingRecords []*logspb.LogRecord
+	wakeup         chan struct{}
+}
+
+// NewOTLPLogger creates an OTLPLogger that does not have any buffered
+// records.
+func NewOTLPLogger(client collogspb.LogsServiceClient, clock clock.Clock, service string, maximumBatchSize, maximumBufferedRecords int) *OTLPLogger {
+	otlpLoggerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(otlpLoggerDiscardedRecords)
+	})
+
+	return &OTLPLogger{
+		client:                 client,
+		clock:                  clock,
+		service:                service,
+		maximumBatchSize:       maximumBatchSize,
+		maximumBufferedRecords: maximumBufferedRecords,
+
+		wakeup: make(chan struct{}, 1),
+	}
+}
+
+func newStringKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+// Log an event, by adding it to the list of records to be exported.
+func (l *OTLPLogger) Log(ctx context.Context, event *Event) {
+	r := newRecord(ctx, l.clock.Now(), l.service, event)
+	body, err := json.Marshal(&r)
+	if err != nil {
+		log.Print("Failed to marshal audit log record: ", err)
+		return
+	}
+
+	// Store the full record in the body. Expose the fields on which
+	// filtering is likely to take place as attributes.
+	severityNumber, severityText := logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	if r.Decision == DecisionDenied {
+		severityNumber, severityText = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+	}
+	logRecord := &logspb.LogRecord{
+		TimeUnixNano:   uint64(r.Time.UnixNano()),
+		SeverityNumber: severityNumber,
+		SeverityText:   severityText,
+		Body: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: string(body)},
+		},
+		Attributes: []*commonpb.KeyValue{
+			newStringKeyValue("buildbarn.audit.service", r.Service),
+			newStringKeyValue("buildbarn.audit.operation", r.Operation),
+			newStringKeyValue("buildbarn.audit.instance_name", r.InstanceName),
+			newStringKeyValue("buildbarn.audit.decision", string(r.Decision)),
+		},
+	}
+	if r.Result != "" {
+		logRecord.Attributes = append(logRecord.Attributes, newStringKeyValue("buildbarn.audit.result", r.Result))
+	}
+
+	l.lock.Lock()
+	l.pendingRecords = append(l.pendingRecords, logRecord)
+	l.discardOldestRecordsLocked()
+	if len(l.pendingRecords) >= l.maximumBatchSize {
+		select {
+		case l.wakeup <- struct{}{}:
+		default:
+		}
+	}
+	l.lock.Unlock()
+}
+
+// discardOldestRecordsLocked discards the oldest buffered records if
+// the maximum number of buffered records is exceeded.
+func (l *OTLPLogger) discardOldestRecordsLocked() {
+	if excess := len(l.pendingRecords) - l.maximumBufferedRecords; excess > 0 {
+		clear(l.pendingRecords[:excess])
+		l.pendingRecords = l.pendingRecords[excess:]
+		otlpLoggerDiscardedRecords.Add(float64(excess))
+	}
+}
+
+// flush exports buffered records in batches. If exporting fails, the
+// records are placed back in the buffer, so that they are retried
+// during the next flush. The return value indicates whether all
+// records were exported successfully.
+func (l *OTLPLogger) flush(ctx context.Context) bool {
+	for {
+		l.lock.Lock()
+		batchSize := min(len(l.pendingRecords), l.maximumBatchSize)
+		logRecords := l.pendingRecords[:batchSize:batchSize]
+		l.pendingRecords = l.pendingRecords[batchSize:]
+		l.lock.Unlock()
+
+		if batchSize == 0 {
+			return true
+		}
+		if _, err := l.client.Export(ctx, &collogspb.ExportLogsServiceRequest{
+			ResourceLogs: []*logspb.ResourceLogs{{
+				ScopeLogs: []*logspb.ScopeLogs{{
+					Scope: &commonpb.InstrumentationScope{
+						Name: "github.com/buildbarn/bb-storage/pkg/audit",
+					},
+					LogRecords: logRecords,
+				}},
+			}},
+		}); err != nil {
+			log.Printf("Failed to export %d audit log records: %s", batchSize, err)
+			l.lock.Lock()
+			l.pendingRecords = append(logRecords, l.pendingRecords...)
+			l.discardOldestRecordsLocked()
+			l.lock.Unlock()
+			return false
+		}
+		if batchSize < l.maximumBatchSize {
+			return true
+		}
+	}
+}
+
+// Run the exporting of buffered records. Records are exported
+// periodically, or as soon as the maximum batch size is reached. If
+// exporting fails, the next attempt is only made after the flush
+// interval has passed. Upon termination, any remaining records are
+// exported.
+func (l *OTLPLogger) Run(ctx context.Context, flushInterval time.Duration) error {
+	wakeup := l.wakeup
+	for {
+		timer, timerChannel := l.clock.NewTimer(flushInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if !l.flush(context.WithoutCancel(ctx)) {
+				l.lock.Lock()
+				discarded := len(l.pendingRecords)
+				l.pendingRecords = nil
+				l.lock.Unlock()
+				log.Printf("Discarding %d audit log records that could not be exported upon termination", discarded)
+				otlpLoggerDiscardedRecords.Add(float64(discarded))
+			}
+			return nil
+		case <-timerChannel:
+		case <-wakeup:
+			timer.Stop()
+		}
+		if l.flush(ctx) {
+			wakeup = l.wakeup
+		} else {
+			wakeup = nil
+		}
+	}
+}
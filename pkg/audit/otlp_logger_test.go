@@ -0,0 +1,97 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/audit"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+// getExportedOperations returns the values of the operation attribute
+// of all log records contained in an export request.
+func getExportedOperations(request *collogspb.ExportLogsServiceRequest) []string {
+	var operations []string
+	for _, resourceLogs := range request.ResourceLogs {
+		for _, scopeLogs := range resourceLogs.ScopeLogs {
+			for _, logRecord := range scopeLogs.LogRecords {
+				for _, attribute := range logRecord.Attributes {
+					if attribute.Key == "buildbarn.audit.operation" {
+						operations = append(operations, attribute.Value.GetStringValue())
+					}
+				}
+			}
+		}
+	}
+	return operations
+}
+
+func TestOTLPLogger(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	client := mock.NewMockLogsServiceClient(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	logger := audit.NewOTLPLogger(client, clock, "cas", 2, 3)
+
+	// Log more records than may be buffered. This should cause the
+	// oldest record to be discarded.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(4)
+	for _, operation := range []string{"Op0", "Op1", "Op2", "Op3"} {
+		logger.Log(ctx, &audit.Event{
+			Operation:    operation,
+			InstanceName: digest.EmptyInstanceName,
+			Digest:       digest.BadDigest,
+		})
+	}
+
+	// As the maximum batch size was reached, records should be
+	// exported without waiting for the timer to expire. Records of
+	// batches that fail to be exported should be retained.
+	runCtx, cancel := context.WithCancel(ctx)
+	timer1 := mock.NewMockTimer(ctrl)
+	clock.EXPECT().NewTimer(time.Minute).Return(timer1, nil)
+	timer1.EXPECT().Stop()
+	client.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *collogspb.ExportLogsServiceRequest, opts ...grpc.CallOption) (*collogspb.ExportLogsServiceResponse, error) {
+			require.Equal(t, []string{"Op1", "Op2"}, getExportedOperations(request))
+			return nil, status.Error(codes.Unavailable, "Collector is offline")
+		})
+
+	// Once the timer expires, the records should be exported again,
+	// in batches of at most the maximum batch size.
+	timer2 := mock.NewMockTimer(ctrl)
+	timerChannel2 := make(chan time.Time, 1)
+	timerChannel2 <- time.Unix(1060, 0)
+	clock.EXPECT().NewTimer(time.Minute).Return(timer2, timerChannel2)
+	client.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *collogspb.ExportLogsServiceRequest, opts ...grpc.CallOption) (*collogspb.ExportLogsServiceResponse, error) {
+			require.Equal(t, []string{"Op1", "Op2"}, getExportedOperations(request))
+			return &collogspb.ExportLogsServiceResponse{}, nil
+		})
+	client.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *collogspb.ExportLogsServiceRequest, opts ...grpc.CallOption) (*collogspb.ExportLogsServiceResponse, error) {
+			require.Equal(t, []string{"Op3"}, getExportedOperations(request))
+			return &collogspb.ExportLogsServiceResponse{}, nil
+		})
+
+	// Terminate the exporting of records.
+	timer3 := mock.NewMockTimer(ctrl)
+	clock.EXPECT().NewTimer(time.Minute).DoAndReturn(func(d time.Duration) (*mock.MockTimer, <-chan time.Time) {
+		cancel()
+		return timer3, nil
+	})
+	timer3.EXPECT().Stop()
+
+	require.NoError(t, logger.Run(runCtx, time.Minute))
+}
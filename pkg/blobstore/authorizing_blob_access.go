@@ -3,6 +3,7 @@ package blobstore
 import (
 	"context"
 
+	"github.com/buildbarn/bb-storage/pkg/audit"
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -16,23 +17,35 @@ type authorizingBlobAccess struct {
 	getAuthorizer         auth.Authorizer
 	putAuthorizer         auth.Authorizer
 	findMissingAuthorizer auth.Authorizer
+	auditLogger           audit.Logger
 }
 
 // NewAuthorizingBlobAccess creates a new BlobAccess which guards blob
 // accesses by checks with Authorizers. Calls to GetCapabilities() are
 // not checked, for the reason that the exact logic for this differs
 // between the Action Cache (AC) and Content Addressable Storage (CAS).
-func NewAuthorizingBlobAccess(base BlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer auth.Authorizer) BlobAccess {
+//
+// All calls to Put(), and all calls that are denied are recorded in the
+// audit log. Calls to Put() that are permitted are recorded after the
+// backend has returned, so that the log contains their outcome.
+func NewAuthorizingBlobAccess(base BlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer auth.Authorizer, auditLogger audit.Logger) BlobAccess {
 	return &authorizingBlobAccess{
 		BlobAccess:            base,
 		getAuthorizer:         getAuthorizer,
 		putAuthorizer:         putAuthorizer,
 		findMissingAuthorizer: findMissingAuthorizer,
+		auditLogger:           auditLogger,
 	}
 }
 
 func (ba *authorizingBlobAccess) Get(ctx context.Context, d digest.Digest) buffer.Buffer {
 	if err := auth.AuthorizeSingleInstanceName(ctx, ba.getAuthorizer, d.GetInstanceName()); err != nil {
+		ba.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "Get",
+			InstanceName: d.GetInstanceName(),
+			Digest:       d,
+			Error:        err,
+		})
 		return buffer.NewBufferFromError(util.StatusWrap(err, "Authorization"))
 	}
 	return ba.BlobAccess.Get(ctx, d)
@@ -40,6 +53,12 @@ func (ba *authorizingBlobAccess) Get(ctx context.Context, d digest.Digest) buffe
 
 func (ba *authorizingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
 	if err := auth.AuthorizeSingleInstanceName(ctx, ba.getAuthorizer, parentDigest.GetInstanceName()); err != nil {
+		ba.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "GetFromComposite",
+			InstanceName: parentDigest.GetInstanceName(),
+			Digest:       childDigest,
+			Error:        err,
+		})
 		return buffer.NewBufferFromError(util.StatusWrap(err, "Authorization"))
 	}
 	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
@@ -47,9 +66,23 @@ func (ba *authorizingBlobAccess) GetFromComposite(ctx context.Context, parentDig
 
 func (ba *authorizingBlobAccess) Put(ctx context.Context, d digest.Digest, b buffer.Buffer) error {
 	if err := auth.AuthorizeSingleInstanceName(ctx, ba.putAuthorizer, d.GetInstanceName()); err != nil {
+		ba.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "Put",
+			InstanceName: d.GetInstanceName(),
+			Digest:       d,
+			Error:        err,
+		})
 		return util.StatusWrap(err, "Authorization")
 	}
-	return ba.BlobAccess.Put(ctx, d, b)
+	err := ba.BlobAccess.Put(ctx, d, b)
+	ba.auditLogger.Log(ctx, &audit.Event{
+		Operation:    "Put",
+		InstanceName: d.GetInstanceName(),
+		Digest:       d,
+		Performed:    true,
+		Result:       err,
+	})
+	return err
 }
 
 func (ba *authorizingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
@@ -65,8 +98,18 @@ func (ba *authorizingBlobAccess) FindMissing(ctx context.Context, digests digest
 	errs := ba.findMissingAuthorizer.Authorize(ctx, instanceNames)
 	for i, err := range errs {
 		if err != nil {
+			ba.auditLogger.Log(ctx, &audit.Event{
+				Operation:    "FindMissing",
+				InstanceName: instanceNames[i],
+				Digest:       digest.BadDigest,
+				Error:        err,
+			})
 			return digest.EmptySet, util.StatusWrapf(err, "Authorization of instance name %#v", instanceNames[i].String())
 		}
 	}
 	return ba.BlobAccess.FindMissing(ctx, digests)
 }
+
+func (ba *authorizingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
@@ -9,6 +9,7 @@ import (
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/audit"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
@@ -25,7 +26,8 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	getAuthorizer := mock.NewMockAuthorizer(ctrl)
 	putAuthorizer := mock.NewMockAuthorizer(ctrl)
 	findMissingAuthorizer := mock.NewMockAuthorizer(ctrl)
-	ba := blobstore.NewAuthorizingBlobAccess(baseBlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer)
+	auditLogger := mock.NewMockLogger(ctrl)
+	ba := blobstore.NewAuthorizingBlobAccess(baseBlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer, auditLogger)
 	d := digest.MustNewDigest("beep", remoteexecution.DigestFunction_SHA256, "693d8db7b05e99c6b7a7c0616456039d89c555029026936248085193559a0b5d", 16)
 	d2 := digest.MustNewDigest("bop/bip", remoteexecution.DigestFunction_SHA256, "da95ccd92a874d2169839cd90d9045be61d17df779fb28fe520a7465c6063723", 3)
 	digests := digest.GetUnion([]digest.Set{d.ToSingletonSet(), d2.ToSingletonSet()})
@@ -48,6 +50,12 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 
 	t.Run("Get-Denied", func(t *testing.T) {
 		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Get",
+			InstanceName: beep,
+			Digest:       d,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
 
 		_, err := ba.Get(ctx, d).ToByteSlice(30)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
@@ -66,6 +74,12 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	t.Run("GetFromComposite-Denied", func(t *testing.T) {
 		blobSlicer := mock.NewMockBlobSlicer(ctrl)
 		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "GetFromComposite",
+			InstanceName: beep,
+			Digest:       d2,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
 
 		_, err := ba.GetFromComposite(ctx, d, d2, blobSlicer).ToByteSlice(30)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
@@ -74,13 +88,42 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	t.Run("Put-Allowed", func(t *testing.T) {
 		putAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{nil})
 		baseBlobAccess.EXPECT().Put(ctx, d, wantBuf).Return(nil)
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Put",
+			InstanceName: beep,
+			Digest:       d,
+			Performed:    true,
+		})
 
 		err := ba.Put(ctx, d, wantBuf)
 		require.NoError(t, err)
 	})
 
+	t.Run("Put-BackendFailure", func(t *testing.T) {
+		// Permitted writes should be logged after the backend
+		// has returned, so that failures are recorded as well.
+		putAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{nil})
+		baseBlobAccess.EXPECT().Put(ctx, d, wantBuf).Return(status.Error(codes.Unavailable, "Storage backend offline"))
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Put",
+			InstanceName: beep,
+			Digest:       d,
+			Performed:    true,
+			Result:       status.Error(codes.Unavailable, "Storage backend offline"),
+		})
+
+		err := ba.Put(ctx, d, wantBuf)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Storage backend offline"), err)
+	})
+
 	t.Run("Put-Denied", func(t *testing.T) {
 		putAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Put",
+			InstanceName: beep,
+			Digest:       d,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
 
 		err := ba.Put(ctx, d, wantBuf)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
@@ -105,6 +148,12 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 				return []error{wantErr, nil}
 			}
 		})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "FindMissing",
+			InstanceName: bopBip,
+			Digest:       digest.BadDigest,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
 
 		_, err := ba.FindMissing(ctx, digests)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization of instance name \"bop/bip\": You shall not pass"), err)
@@ -19,8 +19,50 @@ type BlobAccess interface {
 	GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer
 	Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error
 	FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error)
+
+	// FindMissingStreaming is a streaming variant of FindMissing().
+	// Instead of providing all digests at once, digests are provided
+	// one by one by calling Add() on the stream that is returned.
+	// Digests of objects that are absent are reported through
+	// reportMissing, either while Add() is called, or as part of
+	// Finish(). Implementations are responsible for splitting up
+	// requests into batches of an appropriate size.
+	FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream
+
+	// Invalidate causes objects with the provided digests to be
+	// reported as absent, until they are written again. This can
+	// be used to remove corrupted objects from the Content
+	// Addressable Storage (CAS) or poisoned entries from the Action
+	// Cache (AC). Invalidating objects that are absent is not an
+	// error.
+	Invalidate(ctx context.Context, digests digest.Set) error
+
+	// InvalidateInstanceNamePrefix causes all objects stored under
+	// instance names that start with the provided prefix to be
+	// reported as absent, until they are written again. Backends
+	// that do not partition objects by instance name return
+	// INVALID_ARGUMENT or UNIMPLEMENTED.
+	InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error
+
+	// ListDigests enumerates the contents of the data store,
+	// reporting the digests of all objects that are stored under
+	// the instance name and digest function that are provided.
+	// This can be used to copy or audit the contents of a data
+	// store without knowing the digests of objects in advance.
+	//
+	// Digests may be reported more than once. Objects that are
+	// written or removed while listing is in progress may or may
+	// not be reported. Backends that are not capable of enumerating
+	// their contents return UNIMPLEMENTED.
+	ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error
 }
 
+// ListDigestsReporter is called by BlobAccess.ListDigests() to report
+// the digest of an object that is present. Implementations of
+// BlobAccess never call it concurrently. Listing is terminated if an
+// error is returned, causing ListDigests() to return the same error.
+type ListDigestsReporter func(digest digest.Digest) error
+
 // RecommendedFindMissingDigestsCount corresponds to the maximum number
 // of digests that is safe to provide to BlobAccess.FindMissing()
 // without running into size limits of underlying protocols.
@@ -31,12 +73,7 @@ type BlobAccess interface {
 // implementations limit the maximum message size to a small number of
 // megabytes (4 MB for Java, 16 MB for Go).
 //
-// TODO: Would it make sense to replace BlobAccess.FindMissing() with a
-// streaming API that abstracts away the maximum digests count? That way
-// we can ensure proper batching of digests, even when sharding is used,
-// ExistenceCachingBlobAccess has a high hit rate, etc..
-//
-// It would be nice if such an API also supported decomposition of large
-// objects natively. See the "Future work" section in ADR#3 for details:
-// https://github.com/buildbarn/bb-adrs/blob/master/0003-cas-decomposition.md#future-work
+// Callers that need to check the existence of an arbitrary number of
+// objects should use BlobAccess.FindMissingStreaming(), which
+// abstracts away the maximum digests count.
 const RecommendedFindMissingDigestsCount = 10000
@@ -0,0 +1,102 @@
+package compression
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SupportedCompressors is the list of compression algorithms that may
+// be passed to NewCompressingChunkReader() and
+// NewDecompressingReader(). It can be used to fill in the
+// CacheCapabilities.supported_compressors field. The IDENTITY
+// compressor is omitted, as REv2 requires it to be supported
+// implicitly.
+var SupportedCompressors = []remoteexecution.Compressor_Value{
+	remoteexecution.Compressor_ZSTD,
+	remoteexecution.Compressor_DEFLATE,
+}
+
+type compressingChunkReader struct {
+	r                     buffer.ChunkReader
+	maximumChunkSizeBytes int
+
+	compressedData bytes.Buffer
+	encoder        io.WriteCloser
+	err            error
+}
+
+// NewCompressingChunkReader creates a decorator for ChunkReader that
+// compresses the data returned by the underlying ChunkReader using a
+// given compression algorithm. Chunks returned by this ChunkReader do
+// not exceed the provided maximum size.
+//
+// Compression is performed synchronously as part of Read(), meaning
+// that no goroutines are launched.
+func NewCompressingChunkReader(r buffer.ChunkReader, compressor remoteexecution.Compressor_Value, maximumChunkSizeBytes int) (buffer.ChunkReader, error) {
+	cr := &compressingChunkReader{
+		r:                     r,
+		maximumChunkSizeBytes: maximumChunkSizeBytes,
+	}
+	switch compressor {
+	case remoteexecution.Compressor_ZSTD:
+		// Disable concurrency, as the default is to use
+		// GOMAXPROCS. We should just use a single thread,
+		// because many BlobAccess operations may run in
+		// parallel.
+		encoder, err := zstd.NewWriter(&cr.compressedData, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard encoder")
+		}
+		cr.encoder = encoder
+	case remoteexecution.Compressor_DEFLATE:
+		encoder, err := flate.NewWriter(&cr.compressedData, flate.DefaultCompression)
+		if err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create DEFLATE encoder")
+		}
+		cr.encoder = encoder
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "Unsupported compressor %s", compressor)
+	}
+	return cr, nil
+}
+
+func (r *compressingChunkReader) Read() ([]byte, error) {
+	// Feed data into the encoder until it yields compressed data.
+	for r.compressedData.Len() == 0 && r.err == nil {
+		chunk, err := r.r.Read()
+		if err == io.EOF {
+			if err := r.encoder.Close(); err != nil {
+				r.err = util.StatusWrapWithCode(err, codes.Internal, "Failed to finalize compressed stream")
+			} else {
+				r.err = io.EOF
+			}
+		} else if err != nil {
+			r.err = err
+		} else if _, err := r.encoder.Write(chunk); err != nil {
+			r.err = util.StatusWrapWithCode(err, codes.Internal, "Failed to compress data")
+		}
+	}
+
+	if r.compressedData.Len() == 0 {
+		return nil, r.err
+	}
+	chunk := make([]byte, min(r.compressedData.Len(), r.maximumChunkSizeBytes))
+	r.compressedData.Read(chunk)
+	return chunk, nil
+}
+
+func (r *compressingChunkReader) Close() {
+	if r.err != io.EOF {
+		r.encoder.Close()
+	}
+	r.r.Close()
+}
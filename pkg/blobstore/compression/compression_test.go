@@ -0,0 +1,118 @@
+package compression_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("Hello world "), 1000)
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "eaf3bbc6b957bc81b7442689c9c51baa", 12000)
+
+	for _, compressor := range compression.SupportedCompressors {
+		t.Run(compressor.String(), func(t *testing.T) {
+			// Compress the data, while ensuring that the
+			// chunk size limit is respected.
+			r, err := compression.NewCompressingChunkReader(
+				buffer.NewValidatedBufferFromByteSlice(data).ToChunkReader(0, 100),
+				compressor,
+				10)
+			require.NoError(t, err)
+			var compressedData []byte
+			for {
+				chunk, err := r.Read()
+				if err != nil {
+					require.Equal(t, io.EOF, err)
+					break
+				}
+				require.LessOrEqual(t, len(chunk), 10)
+				compressedData = append(compressedData, chunk...)
+			}
+			r.Close()
+			require.Less(t, len(compressedData), len(data))
+
+			// Decompressing the data should yield the
+			// original contents, matching the digest.
+			dr, err := compression.NewDecompressingReader(
+				buffer.NewValidatedBufferFromByteSlice(compressedData).ToChunkReader(0, 7),
+				compressor)
+			require.NoError(t, err)
+			decompressedData, err := buffer.NewCASBufferFromReader(blobDigest, dr, buffer.UserProvided).ToByteSlice(100000)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressedData)
+		})
+	}
+}
+
+func TestAppendUncompressedStream(t *testing.T) {
+	for _, compressor := range compression.SupportedCompressors {
+		t.Run(compressor.String(), func(t *testing.T) {
+			// Streams should be readable regardless of
+			// whether the data spans zero, one or multiple
+			// blocks.
+			for _, sizeBytes := range []int{0, 1, 0xffff, 128 * 1024, 300000} {
+				data := make([]byte, sizeBytes)
+				for i := range data {
+					data[i] = byte(i * 7)
+				}
+				uncompressedStream, err := compression.AppendUncompressedStream([]byte("Prefix"), data, compressor)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Prefix"), uncompressedStream[:6])
+				require.LessOrEqual(t, len(uncompressedStream), 6+sizeBytes+30)
+
+				dr, err := compression.NewDecompressingReader(
+					buffer.NewValidatedBufferFromByteSlice(uncompressedStream[6:]).ToChunkReader(0, 1000),
+					compressor)
+				require.NoError(t, err)
+				decompressedData, err := io.ReadAll(dr)
+				require.NoError(t, err)
+				require.NoError(t, dr.Close())
+				require.Equal(t, data, decompressedData)
+			}
+		})
+	}
+
+	t.Run("UnsupportedCompressor", func(t *testing.T) {
+		_, err := compression.AppendUncompressedStream(nil, nil, remoteexecution.Compressor_BROTLI)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "Unsupported compressor BROTLI"), err)
+	})
+}
+
+func TestDecompressingReaderMalformedData(t *testing.T) {
+	dr, err := compression.NewDecompressingReader(
+		buffer.NewValidatedBufferFromByteSlice([]byte("This is not Zstandard")).ToChunkReader(0, 100),
+		remoteexecution.Compressor_ZSTD)
+	require.NoError(t, err)
+	_, err = dr.Read(make([]byte, 100))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, dr.Close())
+}
+
+func TestDecompressingReaderUnderlyingError(t *testing.T) {
+	dr, err := compression.NewDecompressingReader(
+		buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")).ToChunkReader(0, 100),
+		remoteexecution.Compressor_DEFLATE)
+	require.NoError(t, err)
+	_, err = dr.Read(make([]byte, 100))
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	require.NoError(t, dr.Close())
+}
+
+func TestUnsupportedCompressor(t *testing.T) {
+	_, err := compression.NewCompressingChunkReader(
+		buffer.NewValidatedBufferFromByteSlice(nil).ToChunkReader(0, 100),
+		remoteexecution.Compressor_BROTLI,
+		100)
+	testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "Unsupported compressor BROTLI"), err)
+}
@@ -0,0 +1,100 @@
+package compression
+
+import (
+	"compress/flate"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type decompressingReader struct {
+	source  *chunkReaderSource
+	decoder io.Reader
+	closer  func()
+}
+
+// NewDecompressingReader creates a ReadCloser that yields the
+// decompressed contents of the data returned by a ChunkReader. This
+// can be used to convert compressed data received over the network
+// back into its original form, so that it may be passed to
+// buffer.NewCASBufferFromReader() for validation.
+//
+// Errors returned by the underlying ChunkReader are propagated
+// unmodified. Malformed compressed data causes INVALID_ARGUMENT errors
+// to be returned.
+func NewDecompressingReader(r buffer.ChunkReader, compressor remoteexecution.Compressor_Value) (io.ReadCloser, error) {
+	source := &chunkReaderSource{r: r}
+	switch compressor {
+	case remoteexecution.Compressor_ZSTD:
+		// Disable concurrency, as the default is to use
+		// GOMAXPROCS. We should just use a single thread,
+		// because many BlobAccess operations may run in
+		// parallel.
+		decoder, err := zstd.NewReader(source, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			r.Close()
+			return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to create Zstandard decoder")
+		}
+		return &decompressingReader{
+			source:  source,
+			decoder: decoder,
+			closer:  decoder.Close,
+		}, nil
+	case remoteexecution.Compressor_DEFLATE:
+		decoder := flate.NewReader(source)
+		return &decompressingReader{
+			source:  source,
+			decoder: decoder,
+			closer:  func() { decoder.Close() },
+		}, nil
+	default:
+		r.Close()
+		return nil, status.Errorf(codes.Unimplemented, "Unsupported compressor %s", compressor)
+	}
+}
+
+func (r *decompressingReader) Read(p []byte) (int, error) {
+	n, err := r.decoder.Read(p)
+	if err != nil && err != io.EOF {
+		if r.source.err != nil && r.source.err != io.EOF {
+			// Error originates from the underlying stream.
+			return n, r.source.err
+		}
+		return n, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to decompress data")
+	}
+	return n, err
+}
+
+func (r *decompressingReader) Close() error {
+	r.closer()
+	r.source.r.Close()
+	return nil
+}
+
+// chunkReaderSource is an adapter for ChunkReader that turns it into
+// an io.Reader, so that it can be passed to decoders. Any error
+// returned by the ChunkReader is retained, so that it may be
+// distinguished from errors caused by malformed compressed data.
+type chunkReaderSource struct {
+	r         buffer.ChunkReader
+	lastChunk []byte
+	err       error
+}
+
+func (r *chunkReaderSource) Read(p []byte) (int, error) {
+	for len(r.lastChunk) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.lastChunk, r.err = r.r.Read()
+	}
+	n := copy(p, r.lastChunk)
+	r.lastChunk = r.lastChunk[n:]
+	return n, nil
+}
@@ -0,0 +1,72 @@
+package compression
+
+import (
+	"encoding/binary"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// zstdRawBlockMaximumSizeBytes is the maximum size of a block
+	// in a Zstandard frame, which is also the window size that is
+	// announced in the frame header.
+	zstdRawBlockMaximumSizeBytes = 128 * 1024
+	// deflateStoredBlockMaximumSizeBytes is the maximum size of a
+	// stored (non-compressed) block in a DEFLATE stream.
+	deflateStoredBlockMaximumSizeBytes = 0xffff
+)
+
+// AppendUncompressedStream appends data to a byte slice, using the
+// framing of a given compression algorithm without compressing it.
+// The resulting stream can be read back using NewDecompressingReader().
+//
+// This can be used to store data that does not benefit from
+// compression, as the size of the resulting stream only exceeds the
+// size of the original data by a small, predictable amount.
+func AppendUncompressedStream(dst, data []byte, compressor remoteexecution.Compressor_Value) ([]byte, error) {
+	switch compressor {
+	case remoteexecution.Compressor_ZSTD:
+		// Frame header without a content size, checksum or
+		// dictionary, followed by a window descriptor
+		// announcing a 128 KiB window. Data is stored in raw
+		// blocks, each having a 3 byte header.
+		dst = append(dst, 0x28, 0xb5, 0x2f, 0xfd, 0x00, 0x38)
+		for {
+			n := min(len(data), zstdRawBlockMaximumSizeBytes)
+			header := uint32(n) << 3
+			if n == len(data) {
+				header |= 1
+			}
+			dst = append(dst, byte(header), byte(header>>8), byte(header>>16))
+			dst = append(dst, data[:n]...)
+			data = data[n:]
+			if len(data) == 0 {
+				return dst, nil
+			}
+		}
+	case remoteexecution.Compressor_DEFLATE:
+		// Stored blocks consist of a byte aligned header,
+		// followed by the length of the block and its one's
+		// complement.
+		for {
+			n := min(len(data), deflateStoredBlockMaximumSizeBytes)
+			if n == len(data) {
+				dst = append(dst, 0x01)
+			} else {
+				dst = append(dst, 0x00)
+			}
+			dst = binary.LittleEndian.AppendUint16(dst, uint16(n))
+			dst = binary.LittleEndian.AppendUint16(dst, ^uint16(n))
+			dst = append(dst, data[:n]...)
+			data = data[n:]
+			if len(data) == 0 {
+				return dst, nil
+			}
+		}
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "Unsupported compressor %s", compressor)
+	}
+}
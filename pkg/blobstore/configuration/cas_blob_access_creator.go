@@ -3,19 +3,24 @@ package configuration
 import (
 	"context"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcclients"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/splitting"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/cloud/aws"
 	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	bb_http "github.com/buildbarn/bb-storage/pkg/http"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/google/uuid"
@@ -99,9 +104,22 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 		// TODO: Should we provide a configuration option, so
 		// that digest.KeyWithoutInstance can be used?
 		return BlobAccessInfo{
-			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, 65536),
+			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, clock.SystemClock, 65536, remoteexecution.Compressor_IDENTITY),
 			DigestKeyFormat: digest.KeyWithInstance,
 		}, "grpc", nil
+	case *pb.BlobAccessConfiguration_CompressingGrpc:
+		compressor := backend.CompressingGrpc.Compressor
+		if !slices.Contains(compression.SupportedCompressors, compressor) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Unsupported compressor %s", compressor)
+		}
+		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.CompressingGrpc.Client)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      grpcclients.NewCASBlobAccess(client, uuid.NewRandom, clock.SystemClock, 65536, compressor),
+			DigestKeyFormat: digest.KeyWithInstance,
+		}, "compressing_grpc", nil
 	case *pb.BlobAccessConfiguration_ReferenceExpanding:
 		// The backend used by ReferenceExpandingBlobAccess is
 		// an Indirect Content Addressable Storage (ICAS). This
@@ -134,7 +152,7 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 			return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create AWS config")
 		}
 
-		roundTripper, err := bb_http.NewRoundTripperFromConfiguration(backend.ReferenceExpanding.HttpClient)
+		roundTripper, err := http_client.NewRoundTripperFromConfiguration(backend.ReferenceExpanding.HttpClient)
 		if err != nil {
 			return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create HTTP client")
 		}
@@ -164,6 +182,31 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 				bac.maximumMessageSizeBytes),
 			DigestKeyFormat: indirectContentAddressableStorage.DigestKeyFormat,
 		}, "reference_expanding", nil
+	case *pb.BlobAccessConfiguration_Splitting:
+		averageChunkSizeBytes := backend.Splitting.AverageChunkSizeBytes
+		if !splitting.IsValidAverageChunkSize(int(averageChunkSizeBytes)) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Average chunk size of %d bytes is not a power of two between 1 KiB and 1 MiB", averageChunkSizeBytes)
+		}
+		contentAddressableStorage, err := nestedCreator.NewNestedBlobAccess(backend.Splitting.ContentAddressableStorage, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		indirectContentAddressableStorage, err := nestedCreator.NewNestedBlobAccess(
+			backend.Splitting.IndirectContentAddressableStorage,
+			NewICASBlobAccessCreator(
+				bac.grpcClientFactory,
+				bac.maximumMessageSizeBytes))
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess: splitting.NewSplittingBlobAccess(
+				contentAddressableStorage.BlobAccess,
+				indirectContentAddressableStorage.BlobAccess,
+				int(averageChunkSizeBytes),
+				bac.maximumMessageSizeBytes),
+			DigestKeyFormat: contentAddressableStorage.DigestKeyFormat.Combine(indirectContentAddressableStorage.DigestKeyFormat),
+		}, "splitting", nil
 	default:
 		return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Configuration did not contain a supported storage backend")
 	}
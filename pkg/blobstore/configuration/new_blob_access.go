@@ -3,11 +3,17 @@ package configuration
 import (
 	"archive/zip"
 	"context"
+	"fmt"
+	"log"
+	"math"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/readcaching"
@@ -21,16 +27,22 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
+	pb_local "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	blockdevice_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blockdevice"
 	digest_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/digest"
 	"github.com/buildbarn/bb-storage/pkg/random"
 	"github.com/buildbarn/bb-storage/pkg/util"
-	"github.com/fxtlabs/primes"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maximumConsistentHashingVirtualNodes limits the size of hash rings
+// used by sharding with consistent hashing, so that a misconfiguration
+// with large weights does not cause excessive memory usage.
+const maximumConsistentHashingVirtualNodes = 1 << 20
+
 // BlobAccessInfo contains an instance of BlobAccess and information
 // relevant to its creation. It is returned by functions that construct
 // BlobAccess instances, such as NewBlobAccessFromConfiguration().
@@ -115,11 +127,47 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if combinedDigestKeyFormat == nil {
 			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Cannot create sharding blob access without any undrained backends")
 		}
+
+		shardPermuter := sharding.NewWeightedShardPermuter(weights)
+		if consistentHashing := backend.Sharding.ConsistentHashing; consistentHashing != nil {
+			if consistentHashing.VirtualNodesPerWeight == 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "The number of virtual nodes per weight must be positive")
+			}
+			totalVirtualNodes := uint64(0)
+			for _, weight := range weights {
+				totalVirtualNodes += uint64(weight) * uint64(consistentHashing.VirtualNodesPerWeight)
+			}
+			if totalVirtualNodes > maximumConsistentHashingVirtualNodes {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Shards would have a total of %d virtual nodes, which exceeds the maximum of %d", totalVirtualNodes, maximumConsistentHashingVirtualNodes)
+			}
+			shardPermuter = sharding.NewConsistentHashingShardPermuter(weights, consistentHashing.VirtualNodesPerWeight)
+		}
+
+		var drainingShards *sharding.DrainingShardSet
+		if path := backend.Sharding.DrainingShardsPath; path != "" {
+			refreshInterval := backend.Sharding.DrainingShardsRefreshInterval
+			if err := refreshInterval.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid draining shards refresh interval")
+			}
+			if refreshInterval.AsDuration() <= 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Draining shards refresh interval must be positive")
+			}
+			drainingShards = sharding.NewDrainingShardSet(backends, storageTypeName)
+			if err := drainingShards.LoadFromFile(path); err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				drainingShards.WatchFile(ctx, clock.SystemClock, path, refreshInterval.AsDuration(), util.DefaultErrorLogger)
+				return nil
+			})
+		}
 		return BlobAccessInfo{
 			BlobAccess: sharding.NewShardingBlobAccess(
 				backends,
-				sharding.NewWeightedShardPermuter(weights),
-				backend.Sharding.HashInitialization),
+				shardPermuter,
+				backend.Sharding.HashInitialization,
+				drainingShards,
+				storageTypeName),
 			DigestKeyFormat: *combinedDigestKeyFormat,
 		}, "sharding", nil
 	case *pb.BlobAccessConfiguration_Mirrored:
@@ -139,8 +187,25 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
+		var readHedger *mirrored.ReadHedger
+		if readHedging := backend.Mirrored.ReadHedging; readHedging != nil {
+			if p := readHedging.LatencyPercentile; p <= 0 || p >= 100 {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Latency percentile %g is not between 0 and 100", p)
+			}
+			if err := readHedging.MinimumDelay.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid minimum delay")
+			}
+			if err := readHedging.MaximumDelay.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid maximum delay")
+			}
+			readHedger = mirrored.NewReadHedger(
+				clock.SystemClock,
+				readHedging.LatencyPercentile,
+				readHedging.MinimumDelay.AsDuration(),
+				readHedging.MaximumDelay.AsDuration())
+		}
 		return BlobAccessInfo{
-			BlobAccess:      mirrored.NewMirroredBlobAccess(backendA.BlobAccess, backendB.BlobAccess, replicatorAToB, replicatorBToA),
+			BlobAccess:      mirrored.NewMirroredBlobAccess(backendA.BlobAccess, backendB.BlobAccess, replicatorAToB, replicatorBToA, backend.Mirrored.ReadFailover, readHedger),
 			DigestKeyFormat: backendA.DigestKeyFormat.Combine(backendB.DigestKeyFormat),
 		}, "mirrored", nil
 	case *pb.BlobAccessConfiguration_Local:
@@ -149,6 +214,21 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			digestKeyFormat = creator.GetBaseDigestKeyFormat()
 		}
 		persistent := backend.Local.Persistent
+		compressor := backend.Local.Compressor
+		if compressor != remoteexecution.Compressor_IDENTITY && !slices.Contains(compression.SupportedCompressors, compressor) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Unsupported compressor %s", compressor)
+		}
+		var encryptionKeys *local.EncryptionKeys
+		if encryption := backend.Local.Encryption; encryption != nil {
+			if backend.Local.GetBlocksOnBlockDevice() == nil || backend.Local.GetKeyLocationMapOnBlockDevice() == nil {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Encryption is only supported when storing both blocks and the key-location map on a block device")
+			}
+			var err error
+			encryptionKeys, err = local.NewEncryptionKeysFromConfiguration(encryption)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to load encryption keys")
+			}
+		}
 
 		// Create the backing store for blocks of data.
 		var backendType string
@@ -164,51 +244,95 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			// is no need to take sector sizes into account.
 			// Use a sector size of 1 byte to achieve
 			// maximum storage density.
+			if compressor != remoteexecution.Compressor_IDENTITY {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Compression is only supported when storing blocks on a block device")
+			}
 			sectorSizeBytes = 1
 			blockSectorCount = blocksBackend.BlocksInMemory.BlockSizeBytes
 			blockAllocator = local.NewInMemoryBlockAllocator(int(blocksBackend.BlocksInMemory.BlockSizeBytes))
 		case *pb.LocalBlobAccessConfiguration_BlocksOnBlockDevice_:
 			backendType = "local_block_device"
-			// Data may be stored on a block device that is
-			// memory mapped. Automatically determine the
-			// block size based on the size of the block
-			// device and the number of blocks.
+			// Data may be stored on one or more block
+			// devices that are memory mapped. Automatically
+			// determine the block size based on the size of
+			// the block devices and the number of blocks.
 			blocksOnBlockDevice := blocksBackend.BlocksOnBlockDevice
-			var blockDevice blockdevice.BlockDevice
-			var sectorCount int64
-			var err error
-			blockDevice, sectorSizeBytes, sectorCount, err = blockdevice.NewBlockDeviceFromConfiguration(
-				blocksOnBlockDevice.Source,
-				persistent == nil)
-			if err != nil {
-				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to open blocks block device")
+			blockDeviceConfigurations := append(
+				[]*blockdevice_pb.Configuration{blocksOnBlockDevice.Source},
+				blocksOnBlockDevice.AdditionalSources...)
+			blockDevices := make([]blockdevice.BlockDevice, 0, len(blockDeviceConfigurations))
+			deviceSectorCounts := make([]int64, 0, len(blockDeviceConfigurations))
+			for i, blockDeviceConfiguration := range blockDeviceConfigurations {
+				blockDevice, deviceSectorSizeBytes, sectorCount, err := blockdevice.NewBlockDeviceFromConfiguration(
+					blockDeviceConfiguration,
+					persistent == nil)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open blocks block device %d", i)
+				}
+				if i == 0 {
+					sectorSizeBytes = deviceSectorSizeBytes
+				} else if deviceSectorSizeBytes != sectorSizeBytes {
+					return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Blocks block device %d has sector size %d, while block device 0 has sector size %d", i, deviceSectorSizeBytes, sectorSizeBytes)
+				}
+				blockDevices = append(blockDevices, blockDevice)
+				deviceSectorCounts = append(deviceSectorCounts, sectorCount)
 			}
-			dataSyncer = blockDevice.Sync
-			blockCount := blocksOnBlockDevice.SpareBlocks + backend.Local.OldBlocks + backend.Local.CurrentBlocks + backend.Local.NewBlocks
-			blockSectorCount = sectorCount / int64(blockCount)
+			dataSyncer = func() error {
+				for i, blockDevice := range blockDevices {
+					if err := blockDevice.Sync(); err != nil {
+						return util.StatusWrapf(err, "Failed to synchronize blocks block device %d", i)
+					}
+				}
+				return nil
+			}
+			blockCount := local.GetBlockDeviceBackedBlockCount(backend.Local)
+			var deviceBlockCounts []int
+			blockSectorCount, deviceBlockCounts = local.GetStripedBlockGeometry(deviceSectorCounts, blockCount)
 			if blockSectorCount <= 0 {
-				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Block device only has %d sectors (%d bytes each), which is less than the total number of blocks (%d), meaning this backend would be incapable of storing any data", sectorCount, sectorSizeBytes, blockCount)
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Block devices only have %v sectors (%d bytes each), which is less than the total number of blocks (%d), meaning this backend would be incapable of storing any data", deviceSectorCounts, sectorSizeBytes, blockCount)
 			}
 
 			cachedReadBufferFactory, err := newCachedReadBufferFactory(blocksOnBlockDevice.DataIntegrityValidationCache, readBufferFactory, digestKeyFormat)
 			if err != nil {
 				return BlobAccessInfo{}, "", err
 			}
+			if compressor != remoteexecution.Compressor_IDENTITY {
+				if blocksOnBlockDevice.DataIntegrityValidationCache != nil {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Compression cannot be combined with a data integrity validation cache")
+				}
+				cachedReadBufferFactory = blobstore.NewDecompressingReadBufferFactory(cachedReadBufferFactory, compressor)
+			}
 
-			blockAllocator = local.NewBlockDeviceBackedBlockAllocator(
-				blockDevice,
-				cachedReadBufferFactory,
-				sectorSizeBytes,
-				blockSectorCount,
-				int(blockCount),
-				storageTypeName)
+			blockAllocators := make([]local.BlockAllocator, 0, len(blockDevices))
+			for i, blockDevice := range blockDevices {
+				blockAllocators = append(blockAllocators, local.NewBlockDeviceBackedBlockAllocator(
+					blockDevice,
+					cachedReadBufferFactory,
+					sectorSizeBytes,
+					blockSectorCount,
+					deviceBlockCounts[i],
+					encryptionKeys,
+					storageTypeName))
+			}
+			if len(blockAllocators) == 1 {
+				blockAllocator = blockAllocators[0]
+			} else {
+				blockAllocator = local.NewStripingBlockAllocator(blockAllocators)
+			}
 		default:
 			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Blocks backend not specified")
 		}
 
 		var globalLock sync.RWMutex
 		var blockList local.BlockList
+		var persistentBlockList *local.PersistentBlockList
+		var persistentStateStore local.PersistentStateStore
+		var invalidatedPrefixesStore local.InvalidatedInstanceNamePrefixesStore
+		var persistentState *pb_local.PersistentState
 		var keyLocationMapHashInitialization uint64
+		var journal local.Journal
+		var journalEntries []local.KeyLocationMapEntry
+		var persistentStateDirectory filesystem.Directory
 		initialBlockCount := 0
 		if persistent == nil {
 			// Persistency is disabled. Provide a simple
@@ -218,61 +342,47 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		} else {
 			// Persistency is enabled. Reload previous
 			// persistent state from disk.
-			persistentStateDirectory, err := filesystem.NewLocalDirectory(path.NewLocalParser(persistent.StateDirectoryPath))
+			var err error
+			persistentStateDirectory, err = filesystem.NewLocalDirectory(path.NewLocalParser(persistent.StateDirectoryPath))
 			if err != nil {
 				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open persistent state directory %#v", persistent.StateDirectoryPath)
 			}
-			persistentStateStore := local.NewDirectoryBackedPersistentStateStore(persistentStateDirectory)
-			persistentState, err := persistentStateStore.ReadPersistentState()
+			persistentStateStore = local.NewDirectoryBackedPersistentStateStore(persistentStateDirectory)
+			invalidatedPrefixesStore = local.NewDirectoryBackedInvalidatedInstanceNamePrefixesStore(persistentStateDirectory)
+			persistentState, err = persistentStateStore.ReadPersistentState()
 			if err != nil {
 				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to reload persistent state from %#v", persistent.StateDirectoryPath)
 			}
 			keyLocationMapHashInitialization = persistentState.KeyLocationMapHashInitialization
 
+			if persistent.WriteAheadJournal {
+				// Restore blocks and objects that were
+				// written after the persistent state was
+				// last updated.
+				if backend.Local.HierarchicalInstanceNames {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "The write-ahead journal cannot be combined with hierarchical instance names")
+				}
+				var journalRecords []*pb_local.JournalRecord
+				journal, journalRecords, err = local.NewDirectoryBackedJournal(persistentStateDirectory, dataSyncer, util.DefaultErrorLogger)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open journal in %#v", persistent.StateDirectoryPath)
+				}
+				persistentState.Blocks, journalEntries = local.ReplayJournal(persistentState.Blocks, journalRecords)
+			}
+
 			// Create a persistent BlockList. This will
 			// attempt to reattach the old blocks. The
 			// number of valid blocks is returned, so that
 			// the dimensions of the OldNewCurrentLocationBlobMap
-			// can be set properly.
-			var persistentBlockList *local.PersistentBlockList
+			// can be set properly. Blocks that were created
+			// while the block device had a different
+			// geometry are restored as well.
 			persistentBlockList, initialBlockCount = local.NewPersistentBlockList(
 				blockAllocator,
+				journal,
 				persistentState.OldestEpochId,
 				persistentState.Blocks)
 			blockList = persistentBlockList
-
-			// Start goroutines that update the persistent
-			// state file when writes and block releases
-			// occur.
-			if err := persistent.MinimumEpochInterval.CheckValid(); err != nil {
-				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to obtain minimum epoch duration")
-			}
-			minimumEpochInterval := persistent.MinimumEpochInterval.AsDuration()
-			periodicSyncer := local.NewPeriodicSyncer(
-				persistentBlockList,
-				&globalLock,
-				persistentStateStore,
-				clock.SystemClock,
-				util.DefaultErrorLogger,
-				10*time.Second,
-				minimumEpochInterval,
-				keyLocationMapHashInitialization,
-				dataSyncer)
-			// TODO: Run this as part of the program.Group,
-			// so that it gets cleaned up upon shutdown.
-			go func() {
-				for {
-					periodicSyncer.ProcessBlockRelease()
-				}
-			}()
-			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-				for periodicSyncer.ProcessBlockPut(ctx) {
-				}
-				// TODO: Let PeriodicSyncer propagate errors
-				// upwards in case they occur after the context
-				// has been cancelled.
-				return nil
-			})
 		}
 
 		blockListGrowthPolicy, err := creator.NewBlockListGrowthPolicy(
@@ -282,7 +392,7 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			return BlobAccessInfo{}, "", err
 		}
 
-		locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		oldCurrentNewLocationBlobMap := local.NewOldCurrentNewLocationBlobMap(
 			blockList,
 			blockListGrowthPolicy,
 			util.DefaultErrorLogger,
@@ -291,10 +401,62 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			int(backend.Local.OldBlocks),
 			int(backend.Local.NewBlocks),
 			initialBlockCount)
+		var locationBlobMap local.SizeClassBackend = oldCurrentNewLocationBlobMap
+		if sizeClasses := backend.Local.SizeClasses; len(sizeClasses) > 0 {
+			// Store objects in separate pools of blocks,
+			// based on their size.
+			if persistent != nil {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Size classes cannot be combined with persistency")
+			}
+			// Block references stored in the key-location
+			// map also identify the size class, which
+			// reduces the number of blocks that can be
+			// referenced in every size class.
+			backendsCount := len(sizeClasses) + 1
+			maximumBlocksPerSizeClass := (math.MaxUint16 + 1) / backendsCount
+			blocks := int(backend.Local.OldBlocks) + int(backend.Local.CurrentBlocks) + int(backend.Local.NewBlocks)
+			if blocks > maximumBlocksPerSizeClass {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "The total number of blocks is %d, while at most %d blocks may be used when %d size classes are configured", blocks, maximumBlocksPerSizeClass, len(sizeClasses))
+			}
+			maximumSizesBytes := make([]int64, 0, len(sizeClasses))
+			sizeClassBackends := make([]local.SizeClassBackend, 0, len(sizeClasses)+1)
+			blocksCounts := make([]int, 0, len(sizeClasses)+1)
+			for i, sizeClass := range sizeClasses {
+				if i > 0 && sizeClass.MaximumSizeBytes <= maximumSizesBytes[i-1] {
+					return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum size of size class %d is not greater than that of its predecessor", i)
+				}
+				sizeClassBlocks := int(sizeClass.OldBlocks) + int(sizeClass.CurrentBlocks) + int(sizeClass.NewBlocks)
+				if sizeClassBlocks > maximumBlocksPerSizeClass {
+					return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "The total number of blocks of size class %d is %d, while at most %d blocks may be used when %d size classes are configured", i, sizeClassBlocks, maximumBlocksPerSizeClass, len(sizeClasses))
+				}
+				sizeClassGrowthPolicy, err := creator.NewBlockListGrowthPolicy(
+					int(sizeClass.CurrentBlocks),
+					int(sizeClass.NewBlocks))
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapf(err, "Size class %d", i)
+				}
+				maximumSizesBytes = append(maximumSizesBytes, sizeClass.MaximumSizeBytes)
+				blocksCounts = append(blocksCounts, sizeClassBlocks)
+				sizeClassBackends = append(sizeClassBackends, local.NewOldCurrentNewLocationBlobMap(
+					local.NewVolatileBlockList(blockAllocator),
+					sizeClassGrowthPolicy,
+					util.DefaultErrorLogger,
+					fmt.Sprintf("%s_size_class_%d", storageTypeName, i),
+					int64(sectorSizeBytes)*blockSectorCount,
+					int(sizeClass.OldBlocks),
+					int(sizeClass.NewBlocks),
+					0))
+			}
+			locationBlobMap = local.NewSizeClassLocationBlobMap(
+				maximumSizesBytes,
+				append(sizeClassBackends, oldCurrentNewLocationBlobMap),
+				append(blocksCounts, blocks))
+		}
 
 		// Create the backing store for the key-location map.
 		var locationRecordArraySize int
 		var locationRecordArray local.LocationRecordArray
+		var keyLocationMapBlockDevice blockdevice.BlockDevice
 		switch keyLocationMapBackend := backend.Local.KeyLocationMapBackend.(type) {
 		case *pb.LocalBlobAccessConfiguration_KeyLocationMapInMemory_:
 			locationRecordArraySize = int(keyLocationMapBackend.KeyLocationMapInMemory.Entries)
@@ -308,22 +470,18 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			if err != nil {
 				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to open key-location map block device")
 			}
-			locationRecordArraySize = int((int64(sectorSizeBytes) * sectorCount) / local.BlockDeviceBackedLocationRecordSize)
+			keyLocationMapBlockDevice = blockDevice
+			locationRecordArraySize = local.GetBlockDeviceBackedLocationRecordArraySize(sectorSizeBytes, sectorCount, encryptionKeys)
 			locationRecordArray = local.NewBlockDeviceBackedLocationRecordArray(
 				blockDevice,
-				locationBlobMap)
+				locationBlobMap,
+				encryptionKeys)
 		default:
 			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Key-location map backend not specified")
 		}
 
-		// Considering that FNV-1a is used to compute keys and
-		// HashingKeyLocationMap uses simple modulo arithmetic
-		// to store entries in the location record array, ensure
-		// that the size that is used is prime. This causes the
-		// best dispersion of hash table entries.
-		for locationRecordArraySize > 3 && !primes.IsPrime(locationRecordArraySize) {
-			locationRecordArraySize--
-		}
+		deviceRecordsCount := locationRecordArraySize
+		locationRecordArraySize = local.GetHashingKeyLocationMapRecordsCount(locationRecordArraySize)
 
 		keyLocationMap := local.NewHashingKeyLocationMap(
 			locationRecordArray,
@@ -333,23 +491,173 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			int(backend.Local.KeyLocationMapMaximumPutAttempts),
 			storageTypeName)
 
+		if persistent != nil {
+			// If the size of the key-location map changed
+			// since the persistent state was written,
+			// records need to be moved to their new slots.
+			// Persistent state written by older versions
+			// does not contain the size of the key-location
+			// map, in which case it may have changed as
+			// well. Rehashing is not needed if no blocks are
+			// present, as all records are invalid. An
+			// interrupted migration always needs to be
+			// completed, as records may have been cleared.
+			oldRecordsCount := persistentState.KeyLocationMapRecordsCount
+			rehashInterrupted, err := local.HasBlockDeviceBackedLocationRecordsRehashFile(persistentStateDirectory)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to check for key-location map rehash file in %#v", persistent.StateDirectoryPath)
+			}
+			if keyLocationMapBlockDevice != nil && (rehashInterrupted || (len(persistentState.Blocks) > 0 && oldRecordsCount != int64(locationRecordArraySize))) {
+				migratedRecords, err := local.RehashBlockDeviceBackedLocationRecords(
+					persistentStateDirectory,
+					keyLocationMapBlockDevice,
+					locationBlobMap,
+					encryptionKeys,
+					deviceRecordsCount,
+					keyLocationMap)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to resize key-location map")
+				}
+				if err := keyLocationMapBlockDevice.Sync(); err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to synchronize key-location map block device")
+				}
+				if err := persistentStateStore.WritePersistentState(&pb_local.PersistentState{
+					OldestEpochId:                    persistentState.OldestEpochId,
+					Blocks:                           persistentState.Blocks[:initialBlockCount],
+					KeyLocationMapHashInitialization: keyLocationMapHashInitialization,
+					KeyLocationMapRecordsCount:       int64(locationRecordArraySize),
+				}); err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to write persistent state to %#v", persistent.StateDirectoryPath)
+				}
+				if oldRecordsCount == 0 {
+					log.Printf("Rehashed key-location map stored in %#v with %d records, as its previous size is unknown, retaining %d records", persistent.StateDirectoryPath, locationRecordArraySize, migratedRecords)
+				} else {
+					log.Printf("Resized key-location map stored in %#v from %d to %d records, retaining %d records", persistent.StateDirectoryPath, oldRecordsCount, locationRecordArraySize, migratedRecords)
+				}
+			}
+			if err := local.RemoveBlockDeviceBackedLocationRecordsRehashFile(persistentStateDirectory); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to remove key-location map rehash file from %#v", persistent.StateDirectoryPath)
+			}
+
+			if journal != nil {
+				// Recreate entries in the key-location
+				// map for objects restored from the
+				// journal. These are only valid if all
+				// blocks could be restored, as the epoch
+				// through which these objects are
+				// referenced is attached to the last block.
+				if initialBlockCount == len(persistentState.Blocks) {
+					for _, entry := range journalEntries {
+						if entry.Location.IsTombstone() {
+							err = keyLocationMap.Delete(entry.Key)
+						} else {
+							err = keyLocationMap.Put(entry.Key, entry.Location)
+						}
+						if err != nil {
+							return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to replay journal")
+						}
+					}
+				} else if len(journalEntries) > 0 {
+					log.Printf("Discarding %d entries contained in the journal in %#v, as only %d out of %d blocks could be restored", len(journalEntries), persistent.StateDirectoryPath, initialBlockCount, len(persistentState.Blocks))
+				}
+				keyLocationMap = local.NewJournalingKeyLocationMap(keyLocationMap, persistentBlockList)
+
+				// Entries in the key-location map must be
+				// synchronized before the journal
+				// segments containing them are removed.
+				if keyLocationMapBlockDevice != nil {
+					blocksDataSyncer := dataSyncer
+					dataSyncer = func() error {
+						if err := blocksDataSyncer(); err != nil {
+							return err
+						}
+						if err := keyLocationMapBlockDevice.Sync(); err != nil {
+							return util.StatusWrap(err, "Failed to synchronize key-location map block device")
+						}
+						return nil
+					}
+				}
+			}
+
+			// Start goroutines that update the persistent
+			// state file when writes and block releases
+			// occur.
+			if err := persistent.MinimumEpochInterval.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to obtain minimum epoch duration")
+			}
+			minimumEpochInterval := persistent.MinimumEpochInterval.AsDuration()
+			periodicSyncer := local.NewPeriodicSyncer(
+				persistentBlockList,
+				&globalLock,
+				persistentStateStore,
+				clock.SystemClock,
+				util.DefaultErrorLogger,
+				10*time.Second,
+				minimumEpochInterval,
+				keyLocationMapHashInitialization,
+				locationRecordArraySize,
+				dataSyncer,
+				journal)
+			// TODO: Run this as part of the program.Group,
+			// so that it gets cleaned up upon shutdown.
+			go func() {
+				for {
+					periodicSyncer.ProcessBlockRelease()
+				}
+			}()
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for periodicSyncer.ProcessBlockPut(ctx) {
+				}
+				// TODO: Let PeriodicSyncer propagate errors
+				// upwards in case they occur after the context
+				// has been cancelled.
+				return nil
+			})
+		}
+
+		// If compression is enabled, compress objects before
+		// they are written into blocks.
+		var compressingLocationBlobMap local.LocationBlobMap = locationBlobMap
+		if compressor != remoteexecution.Compressor_IDENTITY {
+			compressingLocationBlobMap = local.NewCompressingLocationBlobMap(locationBlobMap, &globalLock, compressor)
+		}
+
 		var localBlobAccess blobstore.BlobAccess
 		if backend.Local.HierarchicalInstanceNames {
 			localBlobAccess, err = creator.NewHierarchicalInstanceNamesLocalBlobAccess(
 				keyLocationMap,
-				locationBlobMap,
+				compressingLocationBlobMap,
 				&globalLock)
 			if err != nil {
 				return BlobAccessInfo{}, "", err
 			}
 		} else {
+			// Permit invalidation of instance name prefixes
+			// if objects are partitioned by instance name.
+			var invalidatedPrefixes *local.InvalidatedInstanceNamePrefixes
+			if digestKeyFormat == digest.KeyWithInstance {
+				invalidatedPrefixes, err = local.NewInvalidatedInstanceNamePrefixes(invalidatedPrefixesStore)
+				if err != nil {
+					return BlobAccessInfo{}, "", err
+				}
+			}
 			localBlobAccess = local.NewFlatBlobAccess(
 				keyLocationMap,
-				locationBlobMap,
+				compressingLocationBlobMap,
 				digestKeyFormat,
+				storageTypeName == "cas",
+				invalidatedPrefixes,
 				&globalLock,
 				storageTypeName,
 				creator.GetDefaultCapabilitiesProvider())
+			if journal != nil {
+				localBlobAccess = local.NewJournalSyncingBlobAccess(localBlobAccess, journal)
+			}
+		}
+		if compressor != remoteexecution.Compressor_IDENTITY {
+			// Offsets of slices don't correspond to offsets
+			// within compressed data.
+			localBlobAccess = blobstore.NewSlicingBlobAccess(localBlobAccess)
 		}
 		return BlobAccessInfo{
 			BlobAccess:      localBlobAccess,
@@ -502,6 +810,41 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			BlobAccess:      blobAccess,
 			DigestKeyFormat: digestKeyFormat,
 		}, "zip_writing", nil
+	case *pb.BlobAccessConfiguration_QuotaEnforcing:
+		config := backend.QuotaEnforcing
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		window := config.Window
+		if err := window.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid window")
+		}
+		if window.AsDuration() <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Window must be positive")
+		}
+		quotas := make([]blobstore.InstanceNamePrefixQuota, 0, len(config.InstanceNamePrefixes))
+		for k, quota := range config.InstanceNamePrefixes {
+			instanceNamePrefix, err := digest.NewInstanceName(k)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", k)
+			}
+			quotas = append(quotas, blobstore.InstanceNamePrefixQuota{
+				InstanceNamePrefix:                   instanceNamePrefix,
+				DeprioritizationThresholdBytes:       quota.DeprioritizationThresholdBytes,
+				MaximumConcurrentDeprioritizedWrites: quota.MaximumConcurrentDeprioritizedWrites,
+				MaximumBytes:                         quota.MaximumBytes,
+			})
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewQuotaEnforcingBlobAccess(
+				base.BlobAccess,
+				clock.SystemClock,
+				window.AsDuration(),
+				quotas,
+				creator.GetStorageTypeName()),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "quota_enforcing", nil
 	}
 	return creator.NewCustomBlobAccess(configuration, nc)
 }
@@ -0,0 +1,114 @@
+package blobstore
+
+import (
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// decompressingReadBufferFactoryChunkSizeBytes is the size of the
+// chunks of compressed data that are fed into the decompressor.
+const decompressingReadBufferFactoryChunkSizeBytes = 64 * 1024
+
+type decompressingReadBufferFactory struct {
+	base       ReadBufferFactory
+	compressor remoteexecution.Compressor_Value
+}
+
+// NewDecompressingReadBufferFactory creates a decorator for
+// ReadBufferFactory that assumes that all data provided to it is
+// compressed. Data is decompressed before being passed on to the
+// underlying ReadBufferFactory, meaning that checksum validation is
+// performed against the uncompressed data.
+//
+// Compressed data that is malformed is reported through the
+// DataIntegrityCallback, similar to data that does not match the
+// expected checksum.
+func NewDecompressingReadBufferFactory(base ReadBufferFactory, compressor remoteexecution.Compressor_Value) ReadBufferFactory {
+	return &decompressingReadBufferFactory{
+		base:       base,
+		compressor: compressor,
+	}
+}
+
+func (f *decompressingReadBufferFactory) newBufferFromChunkReader(blobDigest digest.Digest, r buffer.ChunkReader, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	decompressedReader, err := compression.NewDecompressingReader(r, f.compressor)
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return f.base.NewBufferFromReader(
+		blobDigest,
+		&dataIntegrityReportingReader{
+			ReadCloser:            decompressedReader,
+			dataIntegrityCallback: dataIntegrityCallback,
+		},
+		dataIntegrityCallback)
+}
+
+func (f *decompressingReadBufferFactory) NewBufferFromByteSlice(blobDigest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.newBufferFromChunkReader(
+		blobDigest,
+		buffer.NewValidatedBufferFromByteSlice(data).ToChunkReader(0, decompressingReadBufferFactoryChunkSizeBytes),
+		dataIntegrityCallback)
+}
+
+func (f *decompressingReadBufferFactory) NewBufferFromReader(blobDigest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.newBufferFromChunkReader(
+		blobDigest,
+		&readerChunkReader{r: r},
+		dataIntegrityCallback)
+}
+
+func (f *decompressingReadBufferFactory) NewBufferFromReaderAt(blobDigest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.newBufferFromChunkReader(
+		blobDigest,
+		buffer.NewValidatedBufferFromReaderAt(r, sizeBytes).ToChunkReader(0, decompressingReadBufferFactoryChunkSizeBytes),
+		dataIntegrityCallback)
+}
+
+// dataIntegrityReportingReader is a decorator for the reader returned
+// by compression.NewDecompressingReader() that invokes a
+// DataIntegrityCallback if the compressed data is malformed.
+type dataIntegrityReportingReader struct {
+	io.ReadCloser
+	dataIntegrityCallback buffer.DataIntegrityCallback
+}
+
+func (r *dataIntegrityReportingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && status.Code(err) == codes.InvalidArgument {
+		r.dataIntegrityCallback(false)
+		return n, util.StatusWrapWithCode(err, codes.Internal, "Failed to decompress data")
+	}
+	return n, err
+}
+
+// readerChunkReader is an adapter for io.ReadCloser that implements
+// buffer.ChunkReader, without performing any checksum validation.
+type readerChunkReader struct {
+	r io.ReadCloser
+}
+
+func (r *readerChunkReader) Read() ([]byte, error) {
+	chunk := make([]byte, decompressingReadBufferFactoryChunkSizeBytes)
+	for {
+		n, err := r.r.Read(chunk)
+		if n > 0 {
+			return chunk[:n], nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *readerChunkReader) Close() {
+	r.r.Close()
+}
@@ -0,0 +1,95 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDecompressingReadBufferFactory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	readBufferFactory := blobstore.NewDecompressingReadBufferFactory(blobstore.CASReadBufferFactory, remoteexecution.Compressor_ZSTD)
+	data := bytes.Repeat([]byte("Hello world "), 1000)
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "eaf3bbc6b957bc81b7442689c9c51baa", 12000)
+
+	r, err := compression.NewCompressingChunkReader(
+		buffer.NewValidatedBufferFromByteSlice(data).ToChunkReader(0, 1000),
+		remoteexecution.Compressor_ZSTD,
+		1000)
+	require.NoError(t, err)
+	var compressedData []byte
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		compressedData = append(compressedData, chunk...)
+	}
+	r.Close()
+
+	t.Run("ByteSlice", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		decompressedData, err := readBufferFactory.NewBufferFromByteSlice(blobDigest, compressedData, dataIntegrityCallback.Call).ToByteSlice(100000)
+		require.NoError(t, err)
+		require.Equal(t, data, decompressedData)
+	})
+
+	t.Run("Reader", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		decompressedData, err := readBufferFactory.NewBufferFromReader(blobDigest, io.NopCloser(bytes.NewReader(compressedData)), dataIntegrityCallback.Call).ToByteSlice(100000)
+		require.NoError(t, err)
+		require.Equal(t, data, decompressedData)
+	})
+
+	t.Run("ReaderAt", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+		reader := mock.NewMockReadAtCloser(ctrl)
+		reader.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(bytes.NewReader(compressedData).ReadAt).AnyTimes()
+		reader.EXPECT().Close()
+
+		decompressedData, err := readBufferFactory.NewBufferFromReaderAt(blobDigest, reader, int64(len(compressedData)), dataIntegrityCallback.Call).ToByteSlice(100000)
+		require.NoError(t, err)
+		require.Equal(t, data, decompressedData)
+	})
+
+	t.Run("MalformedData", func(t *testing.T) {
+		// Data that cannot be decompressed should be reported
+		// as a data integrity error.
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := readBufferFactory.NewBufferFromByteSlice(blobDigest, []byte("This is not Zstandard"), dataIntegrityCallback.Call).ToByteSlice(100000)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := readBufferFactory.NewBufferFromByteSlice(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000000", 12000),
+			compressedData,
+			dataIntegrityCallback.Call).ToByteSlice(100000)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
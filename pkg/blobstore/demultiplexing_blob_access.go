@@ -125,6 +125,76 @@ func (ba *demultiplexingBlobAccess) FindMissing(ctx context.Context, digests dig
 	return allMissing.Build(), nil
 }
 
+func (ba *demultiplexingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *demultiplexingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Partition the digest set into one set per backend.
+	type partitionInfo struct {
+		digests digest.SetBuilder
+		backend BlobAccess
+	}
+	perBackendPartitions := map[string]*partitionInfo{}
+	for _, instanceNameDigests := range digests.PartitionByInstanceName() {
+		firstDigest, _ := instanceNameDigests.First()
+		backend, backendName, patcher, err := ba.getBackend(firstDigest.GetInstanceName())
+		if err != nil {
+			return err
+		}
+		partition, ok := perBackendPartitions[backendName]
+		if !ok {
+			partition = &partitionInfo{
+				digests: digest.NewSetBuilder(),
+				backend: backend,
+			}
+			perBackendPartitions[backendName] = partition
+		}
+		for _, blobDigest := range instanceNameDigests.Items() {
+			partition.digests.Add(patcher.PatchDigest(blobDigest))
+		}
+	}
+
+	for backendName, partition := range perBackendPartitions {
+		if err := partition.backend.Invalidate(ctx, partition.digests.Build()); err != nil {
+			return util.StatusWrapf(err, "Backend %#v", backendName)
+		}
+	}
+	return nil
+}
+
+// InvalidateInstanceNamePrefix forwards the request to the backend that
+// is responsible for the instance name prefix. Backends that are
+// responsible for instance names below the prefix are not affected.
+func (ba *demultiplexingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	backend, backendName, patcher, err := ba.getBackend(instanceNamePrefix)
+	if err != nil {
+		return err
+	}
+	if err := backend.InvalidateInstanceNamePrefix(ctx, patcher.PatchInstanceName(instanceNamePrefix)); err != nil {
+		return util.StatusWrapf(err, "Backend %#v", backendName)
+	}
+	return nil
+}
+
+func (ba *demultiplexingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	backend, backendName, patcher, err := ba.getBackend(digestFunction.GetInstanceName())
+	if err != nil {
+		return err
+	}
+	patchedDigestFunction, err := patcher.PatchInstanceName(digestFunction.GetInstanceName()).GetDigestFunction(digestFunction.GetEnumValue(), 0)
+	if err != nil {
+		return err
+	}
+	if err := backend.ListDigests(ctx, patchedDigestFunction, func(blobDigest digest.Digest) error {
+		// Undo changes to the instance name.
+		return reportDigest(patcher.UnpatchDigest(blobDigest))
+	}); err != nil {
+		return util.StatusWrapf(err, "Backend %#v", backendName)
+	}
+	return nil
+}
+
 func (ba *demultiplexingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	backend, backendName, patcher, err := ba.getBackend(instanceName)
 	if err != nil {
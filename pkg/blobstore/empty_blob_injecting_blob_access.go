@@ -63,3 +63,23 @@ func (ba *emptyBlobInjectingBlobAccess) Put(ctx context.Context, digest digest.D
 func (ba *emptyBlobInjectingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
 	return ba.BlobAccess.FindMissing(ctx, digests.RemoveEmptyBlob())
 }
+
+func (ba *emptyBlobInjectingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return emptyBlobInjectingFindMissingStream{
+		FindMissingStream: ba.BlobAccess.FindMissingStreaming(ctx, reportMissing),
+	}
+}
+
+// emptyBlobInjectingFindMissingStream is returned by
+// emptyBlobInjectingBlobAccess.FindMissingStreaming(). It prevents the
+// empty blob from being forwarded to the underlying stream.
+type emptyBlobInjectingFindMissingStream struct {
+	FindMissingStream
+}
+
+func (s emptyBlobInjectingFindMissingStream) Add(blobDigest digest.Digest) error {
+	if blobDigest.GetSizeBytes() == 0 {
+		return nil
+	}
+	return s.FindMissingStream.Add(blobDigest)
+}
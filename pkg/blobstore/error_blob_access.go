@@ -43,6 +43,22 @@ func (ba *errorBlobAccess) FindMissing(ctx context.Context, digests digest.Set)
 	return digest.EmptySet, ba.err
 }
 
+func (ba *errorBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *errorBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	return ba.err
+}
+
+func (ba *errorBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return ba.err
+}
+
+func (ba *errorBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	return ba.err
+}
+
 func (ba *errorBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	return nil, ba.err
 }
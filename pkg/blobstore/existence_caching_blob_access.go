@@ -22,6 +22,10 @@ type existenceCachingBlobAccess struct {
 // This decorator may be useful to run on instances that act as
 // frontends for a mirrored/sharding storage pool, as it may reduce the
 // load observed on the storage pool.
+//
+// Objects that are invalidated through InvalidateInstanceNamePrefix()
+// may continue to be reported as present until their entries in the
+// cache expire.
 func NewExistenceCachingBlobAccess(base BlobAccess, existenceCache *digest.ExistenceCache) BlobAccess {
 	return &existenceCachingBlobAccess{
 		BlobAccess:     base,
@@ -45,3 +49,61 @@ func (ba *existenceCachingBlobAccess) FindMissing(ctx context.Context, digests d
 	ba.existenceCache.Add(present)
 	return missing, nil
 }
+
+func (ba *existenceCachingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Remove the digests from the cache before and after
+	// invalidation, so that concurrent FindMissing() calls don't
+	// reinsert them.
+	ba.existenceCache.Remove(digests)
+	err := ba.BlobAccess.Invalidate(ctx, digests)
+	ba.existenceCache.Remove(digests)
+	return err
+}
+
+func (ba *existenceCachingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	s := &existenceCachingFindMissingStream{
+		existenceCache: ba.existenceCache,
+		checked:        digest.NewSetBuilder(),
+		missing:        digest.NewSetBuilder(),
+	}
+	s.FindMissingStream = ba.BlobAccess.FindMissingStreaming(ctx, func(blobDigest digest.Digest) {
+		s.missing.Add(blobDigest)
+		reportMissing(blobDigest)
+	})
+	return s
+}
+
+// existenceCachingFindMissingStream is returned by
+// existenceCachingBlobAccess.FindMissingStreaming(). It discards
+// digests that have been requested recently before forwarding them to
+// the stream of the backend, so that the backend can gather them into
+// batches of a proper size regardless of the cache's hit rate.
+//
+// As the backend only reports digests of objects that are absent, the
+// cache can only be updated once all digests have been checked.
+type existenceCachingFindMissingStream struct {
+	FindMissingStream
+	existenceCache *digest.ExistenceCache
+
+	checked digest.SetBuilder
+	missing digest.SetBuilder
+}
+
+func (s *existenceCachingFindMissingStream) Add(blobDigest digest.Digest) error {
+	if s.existenceCache.RemoveExisting(blobDigest.ToSingletonSet()).Empty() {
+		return nil
+	}
+	s.checked.Add(blobDigest)
+	return s.FindMissingStream.Add(blobDigest)
+}
+
+func (s *existenceCachingFindMissingStream) Finish() error {
+	if err := s.FindMissingStream.Finish(); err != nil {
+		return err
+	}
+
+	// Insert the digests that were present for future calls.
+	present, _, _ := digest.GetDifferenceAndIntersection(s.checked.Build(), s.missing.Build())
+	s.existenceCache.Add(present)
+	return nil
+}
@@ -55,3 +55,68 @@ func TestExistenceCachingBlobAccessFindMissing(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, nonExistingDigests, missing)
 }
+
+func TestExistenceCachingBlobAccessFindMissingStreaming(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewExistenceCachingBlobAccess(
+		baseBlobAccess,
+		digest.NewExistenceCache(clock, digest.KeyWithoutInstance, 10, time.Minute, eviction.NewLRUSet[string]()))
+
+	existingDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	nonExistingDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 5)
+
+	// Digests should be forwarded to the stream of the backend.
+	// Digests that are not reported as missing should be added to
+	// the cache once the stream is finished.
+	stream1 := mock.NewMockFindMissingStream(ctrl)
+	var reportMissing1 blobstore.FindMissingReporter
+	baseBlobAccess.EXPECT().FindMissingStreaming(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+			reportMissing1 = reportMissing
+			return stream1
+		})
+	stream1.EXPECT().Add(existingDigest)
+	stream1.EXPECT().Add(nonExistingDigest)
+	stream1.EXPECT().Finish().DoAndReturn(func() error {
+		reportMissing1(nonExistingDigest)
+		return nil
+	})
+
+	var missing1 []digest.Digest
+	stream := blobAccess.FindMissingStreaming(ctx, func(blobDigest digest.Digest) {
+		missing1 = append(missing1, blobDigest)
+	})
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(3)
+	require.NoError(t, stream.Add(existingDigest))
+	require.NoError(t, stream.Add(nonExistingDigest))
+	require.NoError(t, stream.Finish())
+	require.Equal(t, []digest.Digest{nonExistingDigest}, missing1)
+
+	// Digests that are present in the cache should be discarded
+	// before being forwarded to the stream of the backend.
+	stream2 := mock.NewMockFindMissingStream(ctrl)
+	var reportMissing2 blobstore.FindMissingReporter
+	baseBlobAccess.EXPECT().FindMissingStreaming(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+			reportMissing2 = reportMissing
+			return stream2
+		})
+	stream2.EXPECT().Add(nonExistingDigest)
+	stream2.EXPECT().Finish().DoAndReturn(func() error {
+		reportMissing2(nonExistingDigest)
+		return nil
+	})
+
+	var missing2 []digest.Digest
+	stream = blobAccess.FindMissingStreaming(ctx, func(blobDigest digest.Digest) {
+		missing2 = append(missing2, blobDigest)
+	})
+	clock.EXPECT().Now().Return(time.Unix(1030, 0)).Times(3)
+	require.NoError(t, stream.Add(existingDigest))
+	require.NoError(t, stream.Add(nonExistingDigest))
+	require.NoError(t, stream.Finish())
+	require.Equal(t, []digest.Digest{nonExistingDigest}, missing2)
+}
@@ -0,0 +1,86 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+// FindMissingReporter is called by FindMissingStream to report the
+// digest of an object that is absent. Implementations of
+// FindMissingStream never call it concurrently.
+//
+// Digests that are provided to FindMissingStream.Add() multiple times
+// may also be reported multiple times.
+type FindMissingReporter func(digest digest.Digest)
+
+// FindMissingStream is returned by BlobAccess.FindMissingStreaming()
+// to check the existence of an arbitrary number of objects.
+type FindMissingStream interface {
+	// Add a digest of an object whose existence should be checked.
+	// This call may block to check the existence of a batch of
+	// objects that was provided previously.
+	Add(digest digest.Digest) error
+
+	// Finish checking the existence of all objects that were
+	// provided. This function must be called exactly once, even if
+	// Add() returned an error.
+	Finish() error
+}
+
+type batchingFindMissingStream struct {
+	context       context.Context
+	blobAccess    BlobAccess
+	reportMissing FindMissingReporter
+
+	pending digest.SetBuilder
+	err     error
+}
+
+// NewBatchingFindMissingStream creates a FindMissingStream that
+// gathers digests into batches of RecommendedFindMissingDigestsCount
+// digests, calling BlobAccess.FindMissing() for each of them. This is
+// the implementation of BlobAccess.FindMissingStreaming() that is used
+// by backends that don't provide a more efficient one.
+func NewBatchingFindMissingStream(ctx context.Context, blobAccess BlobAccess, reportMissing FindMissingReporter) FindMissingStream {
+	return &batchingFindMissingStream{
+		context:       ctx,
+		blobAccess:    blobAccess,
+		reportMissing: reportMissing,
+		pending:       digest.NewSetBuilder(),
+	}
+}
+
+func (s *batchingFindMissingStream) flush() error {
+	missing, err := s.blobAccess.FindMissing(s.context, s.pending.Build())
+	s.pending = digest.NewSetBuilder()
+	if err != nil {
+		s.err = err
+		return err
+	}
+	for _, missingDigest := range missing.Items() {
+		s.reportMissing(missingDigest)
+	}
+	return nil
+}
+
+func (s *batchingFindMissingStream) Add(blobDigest digest.Digest) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.pending.Add(blobDigest)
+	if s.pending.Length() >= RecommendedFindMissingDigestsCount {
+		return s.flush()
+	}
+	return nil
+}
+
+func (s *batchingFindMissingStream) Finish() error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.pending.Length() > 0 {
+		return s.flush()
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package blobstore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchingFindMissingStream(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+
+	firstBatch := digest.NewSetBuilder()
+	for i := 0; i < blobstore.RecommendedFindMissingDigestsCount; i++ {
+		firstBatch.Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), 123))
+	}
+	lastDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	firstMissingDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", 42), 123)
+
+	t.Run("Empty", func(t *testing.T) {
+		// Finishing an empty stream should not cause any calls
+		// against the backend.
+		stream := blobstore.NewBatchingFindMissingStream(ctx, blobAccess, func(digest.Digest) {
+			t.Fatal("No digests should be reported")
+		})
+		require.NoError(t, stream.Finish())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Digests should be sent to the backend in batches of
+		// RecommendedFindMissingDigestsCount digests.
+		var missing []digest.Digest
+		stream := blobstore.NewBatchingFindMissingStream(ctx, blobAccess, func(blobDigest digest.Digest) {
+			missing = append(missing, blobDigest)
+		})
+
+		blobAccess.EXPECT().FindMissing(ctx, firstBatch.Build()).Return(firstMissingDigest.ToSingletonSet(), nil)
+		for _, blobDigest := range firstBatch.Build().Items() {
+			require.NoError(t, stream.Add(blobDigest))
+		}
+		require.Equal(t, []digest.Digest{firstMissingDigest}, missing)
+
+		require.NoError(t, stream.Add(lastDigest))
+		blobAccess.EXPECT().FindMissing(ctx, lastDigest.ToSingletonSet()).Return(lastDigest.ToSingletonSet(), nil)
+		require.NoError(t, stream.Finish())
+		require.Equal(t, []digest.Digest{firstMissingDigest, lastDigest}, missing)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Errors should be returned by the call that caused the
+		// batch to be flushed, and by all calls after that.
+		stream := blobstore.NewBatchingFindMissingStream(ctx, blobAccess, func(digest.Digest) {
+			t.Fatal("No digests should be reported")
+		})
+
+		blobAccess.EXPECT().FindMissing(ctx, firstBatch.Build()).Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+		items := firstBatch.Build().Items()
+		for _, blobDigest := range items[:len(items)-1] {
+			require.NoError(t, stream.Add(blobDigest))
+		}
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), stream.Add(items[len(items)-1]))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), stream.Add(lastDigest))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), stream.Finish())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -28,6 +29,8 @@ func getCacheCapabilities(ctx context.Context, capabilitiesClient remoteexecutio
 }
 
 type acBlobAccess struct {
+	adminForwarder
+
 	actionCacheClient       remoteexecution.ActionCacheClient
 	capabilitiesClient      remoteexecution.CapabilitiesClient
 	maximumMessageSizeBytes int
@@ -39,6 +42,8 @@ type acBlobAccess struct {
 // stored in the Action Cache.
 func NewACBlobAccess(client grpc.ClientConnInterface, maximumMessageSizeBytes int) blobstore.BlobAccess {
 	return &acBlobAccess{
+		adminForwarder: newAdminForwarder(client, admin.StorageType_ACTION_CACHE),
+
 		actionCacheClient:       remoteexecution.NewActionCacheClient(client),
 		capabilitiesClient:      remoteexecution.NewCapabilitiesClient(client),
 		maximumMessageSizeBytes: maximumMessageSizeBytes,
@@ -82,6 +87,10 @@ func (ba *acBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (di
 	return digest.EmptySet, status.Error(codes.Unimplemented, "Bazel action cache does not support bulk existence checking")
 }
 
+func (ba *acBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 func (ba *acBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	cacheCapabilities, err := getCacheCapabilities(ctx, ba.capabilitiesClient, instanceName)
 	if err != nil {
@@ -0,0 +1,93 @@
+package grpcclients
+
+import (
+	"context"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// adminForwarder implements the Invalidate(),
+// InvalidateInstanceNamePrefix() and ListDigests() methods of
+// BlobAccess by calling into the Admin service of a remote bb_storage
+// instance. It is embedded
+// into all of the BlobAccess implementations in this package.
+type adminForwarder struct {
+	adminClient admin.AdminClient
+	storageType admin.StorageType
+}
+
+func newAdminForwarder(client grpc.ClientConnInterface, storageType admin.StorageType) adminForwarder {
+	return adminForwarder{
+		adminClient: admin.NewAdminClient(client),
+		storageType: storageType,
+	}
+}
+
+func (ai adminForwarder) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Partition all digests by digest function, as the
+	// InvalidateBlobs() RPC can only process digests for a single
+	// instance name and digest function.
+	perFunctionDigests := map[digest.Function][]*remoteexecution.Digest{}
+	for _, blobDigest := range digests.Items() {
+		digestFunction := blobDigest.GetDigestFunction()
+		perFunctionDigests[digestFunction] = append(perFunctionDigests[digestFunction], blobDigest.GetProto())
+	}
+
+	for digestFunction, blobDigests := range perFunctionDigests {
+		if _, err := ai.adminClient.InvalidateBlobs(ctx, &admin.InvalidateBlobsRequest{
+			StorageType:    ai.storageType,
+			InstanceName:   digestFunction.GetInstanceName().String(),
+			BlobDigests:    blobDigests,
+			DigestFunction: digestFunction.GetEnumValue(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ai adminForwarder) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	_, err := ai.adminClient.InvalidateInstanceNamePrefix(ctx, &admin.InvalidateInstanceNamePrefixRequest{
+		StorageType:        ai.storageType,
+		InstanceNamePrefix: instanceNamePrefix.String(),
+	})
+	return err
+}
+
+func (af adminForwarder) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client, err := af.adminClient.ListDigests(ctxWithCancel, &admin.ListDigestsRequest{
+		StorageType:    af.storageType,
+		InstanceName:   digestFunction.GetInstanceName().String(),
+		DigestFunction: digestFunction.GetEnumValue(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		for _, partialDigest := range response.BlobDigests {
+			blobDigest, err := digestFunction.NewDigestFromProto(partialDigest)
+			if err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Server returned an invalid digest")
+			}
+			if err := reportDigest(blobDigest); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -3,25 +3,53 @@ package grpcclients
 import (
 	"context"
 	"io"
+	"slices"
+	"sync"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/google/uuid"
 
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// capabilitiesFailureCacheDuration is the amount of time for which a
+// failure to obtain the capabilities for an instance name is cached.
+const capabilitiesFailureCacheDuration = time.Minute
+
+// compressorSupport indicates whether the service supports the
+// configured compressor for a given instance name.
+type compressorSupport struct {
+	supported bool
+	// If set, the capabilities could not be obtained, and should be
+	// requested again after this time.
+	expirationTime time.Time
+}
+
 type casBlobAccess struct {
+	adminForwarder
+
 	byteStreamClient                bytestream.ByteStreamClient
 	contentAddressableStorageClient remoteexecution.ContentAddressableStorageClient
 	capabilitiesClient              remoteexecution.CapabilitiesClient
 	uuidGenerator                   util.UUIDGenerator
+	clock                           clock.Clock
 	readChunkSize                   int
+	compressor                      remoteexecution.Compressor_Value
+
+	compressorSupportLock sync.RWMutex
+	compressorSupport     map[digest.InstanceName]compressorSupport
 }
 
 // NewCASBlobAccess creates a BlobAccess handle that relays any requests
@@ -29,27 +57,124 @@ type casBlobAccess struct {
 // remoteexecution.ContentAddressableStorage services. Those are the
 // services that Bazel uses to access blobs stored in the Content
 // Addressable Storage.
-func NewCASBlobAccess(client grpc.ClientConnInterface, uuidGenerator util.UUIDGenerator, readChunkSize int) blobstore.BlobAccess {
+//
+// If a compressor other than IDENTITY is provided, blobs are
+// transferred in compressed form, but only for instance names for
+// which the service announces support for the compressor through
+// GetCapabilities(). If the capabilities cannot be obtained, blobs
+// are transferred in uncompressed form for one minute, after which the
+// capabilities are requested again.
+//
+// Uncompressed reads that fail with UNAVAILABLE after data has been
+// received are resumed automatically, by reissuing the ByteStream
+// Read() call with the read offset set to the amount of data received.
+// Similarly, uncompressed uploads are resumed at the offset reported
+// by QueryWriteStatus().
+func NewCASBlobAccess(client grpc.ClientConnInterface, uuidGenerator util.UUIDGenerator, clock clock.Clock, readChunkSize int, compressor remoteexecution.Compressor_Value) blobstore.BlobAccess {
 	return &casBlobAccess{
+		adminForwarder: newAdminForwarder(client, admin.StorageType_CONTENT_ADDRESSABLE_STORAGE),
+
 		byteStreamClient:                bytestream.NewByteStreamClient(client),
 		contentAddressableStorageClient: remoteexecution.NewContentAddressableStorageClient(client),
 		capabilitiesClient:              remoteexecution.NewCapabilitiesClient(client),
 		uuidGenerator:                   uuidGenerator,
+		clock:                           clock,
 		readChunkSize:                   readChunkSize,
+		compressor:                      compressor,
+		compressorSupport:               map[digest.InstanceName]compressorSupport{},
 	}
 }
 
+// getCompressor returns the compressor that should be used to transfer
+// blobs belonging to a given instance name. Whether the service
+// supports the configured compressor is determined by calling
+// GetCapabilities() once for every instance name. If the capabilities
+// cannot be obtained, blobs are transferred in uncompressed form, and
+// GetCapabilities() is only called again after
+// capabilitiesFailureCacheDuration. This prevents every operation from
+// calling GetCapabilities() while the service is unable to report its
+// capabilities.
+func (ba *casBlobAccess) getCompressor(ctx context.Context, instanceName digest.InstanceName) remoteexecution.Compressor_Value {
+	if ba.compressor == remoteexecution.Compressor_IDENTITY {
+		return remoteexecution.Compressor_IDENTITY
+	}
+
+	ba.compressorSupportLock.RLock()
+	support, ok := ba.compressorSupport[instanceName]
+	ba.compressorSupportLock.RUnlock()
+	if !ok || (!support.expirationTime.IsZero() && !ba.clock.Now().Before(support.expirationTime)) {
+		if cacheCapabilities, err := getCacheCapabilities(ctx, ba.capabilitiesClient, instanceName); err == nil {
+			support = compressorSupport{
+				supported: slices.Contains(cacheCapabilities.SupportedCompressors, ba.compressor),
+			}
+		} else {
+			support = compressorSupport{
+				expirationTime: ba.clock.Now().Add(capabilitiesFailureCacheDuration),
+			}
+		}
+
+		ba.compressorSupportLock.Lock()
+		ba.compressorSupport[instanceName] = support
+		ba.compressorSupportLock.Unlock()
+	}
+	if !support.supported {
+		return remoteexecution.Compressor_IDENTITY
+	}
+	return ba.compressor
+}
+
 type byteStreamChunkReader struct {
-	client bytestream.ByteStream_ReadClient
-	cancel context.CancelFunc
+	ctx              context.Context
+	byteStreamClient bytestream.ByteStreamClient
+	resourceName     string
+	resumable        bool
+
+	client            bytestream.ByteStream_ReadClient
+	cancel            context.CancelFunc
+	readOffset        int64
+	receivedSinceOpen bool
 }
 
-func (r *byteStreamChunkReader) Read() ([]byte, error) {
-	chunk, err := r.client.Recv()
+// open a ByteStream Read() call, starting at the current read offset.
+func (r *byteStreamChunkReader) open() error {
+	ctxWithCancel, cancel := context.WithCancel(r.ctx)
+	client, err := r.byteStreamClient.Read(ctxWithCancel, &bytestream.ReadRequest{
+		ResourceName: r.resourceName,
+		ReadOffset:   r.readOffset,
+	})
 	if err != nil {
-		return nil, err
+		cancel()
+		return err
+	}
+	r.client = client
+	r.cancel = cancel
+	r.receivedSinceOpen = false
+	return nil
+}
+
+func (r *byteStreamChunkReader) Read() ([]byte, error) {
+	for {
+		chunk, err := r.client.Recv()
+		if err == nil {
+			r.readOffset += int64(len(chunk.Data))
+			r.receivedSinceOpen = true
+			return chunk.Data, nil
+		}
+
+		// If the stream fails after data has been received,
+		// attempt to resume the transfer at the current offset.
+		// Only do this if progress was made since the stream
+		// was last opened, so that we don't retry indefinitely.
+		if !r.resumable || !r.receivedSinceOpen || status.Code(err) != codes.Unavailable || r.ctx.Err() != nil {
+			return nil, err
+		}
+		r.cancel()
+		oldClient := r.client
+		if errOpen := r.open(); errOpen != nil {
+			r.client = oldClient
+			return nil, util.StatusWrapf(errOpen, "Failed to resume reading at offset %d", r.readOffset)
+		}
 	}
-	return chunk.Data, nil
 }
 
 func (r *byteStreamChunkReader) Close() {
@@ -62,18 +187,28 @@ func (r *byteStreamChunkReader) Close() {
 }
 
 func (ba *casBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
-	ctxWithCancel, cancel := context.WithCancel(ctx)
-	client, err := ba.byteStreamClient.Read(ctxWithCancel, &bytestream.ReadRequest{
-		ResourceName: digest.GetByteStreamReadPath(remoteexecution.Compressor_IDENTITY),
-	})
+	compressor := ba.getCompressor(ctx, digest.GetInstanceName())
+	r := &byteStreamChunkReader{
+		ctx:              ctx,
+		byteStreamClient: ba.byteStreamClient,
+		resourceName:     digest.GetByteStreamReadPath(compressor),
+		// Read offsets refer to the uncompressed form of the
+		// blob, meaning that compressed transfers can't be
+		// resumed without restarting decompression.
+		resumable: compressor == remoteexecution.Compressor_IDENTITY,
+	}
+	if err := r.open(); err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		return buffer.NewCASBufferFromChunkReader(digest, r, buffer.BackendProvided(buffer.Irreparable(digest)))
+	}
+
+	decompressedReader, err := compression.NewDecompressingReader(r, compressor)
 	if err != nil {
-		cancel()
 		return buffer.NewBufferFromError(err)
 	}
-	return buffer.NewCASBufferFromChunkReader(digest, &byteStreamChunkReader{
-		client: client,
-		cancel: cancel,
-	}, buffer.BackendProvided(buffer.Irreparable(digest)))
+	return buffer.NewCASBufferFromReader(digest, decompressedReader, buffer.BackendProvided(buffer.Irreparable(digest)))
 }
 
 func (ba *casBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
@@ -81,21 +216,135 @@ func (ba *casBlobAccess) GetFromComposite(ctx context.Context, parentDigest, chi
 	return b
 }
 
+// maximumResumptionWindowSizeBytes is the maximum amount of data that
+// has already been sent as part of a ByteStream Write() call that is
+// retained, so that it may be retransmitted when the upload is resumed.
+const maximumResumptionWindowSizeBytes = 4 * 1024 * 1024
+
+// resumptionWindow retains copies of the chunks of data most recently
+// sent as part of an upload. When an upload is resumed, the server may
+// have committed less data than was sent. This allows the remaining
+// data to be retransmitted.
+type resumptionWindow struct {
+	offsetBytes int64
+	chunks      [][]byte
+	sizeBytes   int64
+}
+
+func (w *resumptionWindow) add(data []byte) {
+	w.chunks = append(w.chunks, append([]byte(nil), data...))
+	w.sizeBytes += int64(len(data))
+	for len(w.chunks) > 1 && w.sizeBytes > maximumResumptionWindowSizeBytes {
+		firstSizeBytes := int64(len(w.chunks[0]))
+		w.chunks[0] = nil
+		w.chunks = w.chunks[1:]
+		w.offsetBytes += firstSizeBytes
+		w.sizeBytes -= firstSizeBytes
+	}
+}
+
+// getChunksFrom returns the chunks of data that need to be
+// retransmitted when resuming an upload at a given offset.
+func (w *resumptionWindow) getChunksFrom(offsetBytes int64) ([][]byte, bool) {
+	if offsetBytes < w.offsetBytes || offsetBytes > w.offsetBytes+w.sizeBytes {
+		return nil, false
+	}
+	skipBytes := offsetBytes - w.offsetBytes
+	for i, chunk := range w.chunks {
+		if skipBytes < int64(len(chunk)) {
+			chunks := append([][]byte{chunk[skipBytes:]}, w.chunks[i+1:]...)
+			return chunks, true
+		}
+		skipBytes -= int64(len(chunk))
+	}
+	return nil, true
+}
+
 func (ba *casBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	compressor := ba.getCompressor(ctx, digest.GetInstanceName())
 	r := b.ToChunkReader(0, ba.readChunkSize)
+	if compressor != remoteexecution.Compressor_IDENTITY {
+		compressingReader, err := compression.NewCompressingChunkReader(r, compressor, ba.readChunkSize)
+		if err != nil {
+			r.Close()
+			return err
+		}
+		r = compressingReader
+	}
 	defer r.Close()
 
+	// Only uncompressed uploads can be resumed, as REv2 requires
+	// that the initial write offset of compressed uploads refers
+	// to the uncompressed data.
+	var window *resumptionWindow
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		window = &resumptionWindow{}
+	}
+
+	resourceName := digest.GetByteStreamWritePath(uuid.Must(ba.uuidGenerator()), compressor)
+	writeOffset := int64(0)
+	var retransmitChunks [][]byte
+	for {
+		startOffset := writeOffset
+		var resumable bool
+		var err error
+		writeOffset, resumable, err = ba.write(ctx, resourceName, writeOffset, retransmitChunks, r, window)
+		if err == nil || !resumable || ctx.Err() != nil {
+			return err
+		}
+
+		// The stream failed after data was sent. Determine how
+		// much data was committed by the server, and resume
+		// the upload at that offset. Don't resume if no progress
+		// was made, as that could cause us to loop indefinitely.
+		response, errQuery := ba.byteStreamClient.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+			ResourceName: resourceName,
+		})
+		if errQuery != nil {
+			return err
+		}
+		if response.Complete {
+			return nil
+		}
+		if response.CommittedSize <= startOffset {
+			return err
+		}
+		chunks, ok := window.getChunksFrom(response.CommittedSize)
+		if !ok {
+			return err
+		}
+		writeOffset = response.CommittedSize
+		retransmitChunks = chunks
+	}
+}
+
+// write performs a single ByteStream Write() call, starting at a given
+// offset. Chunks that need to be retransmitted are sent first, followed
+// by chunks obtained from the ChunkReader. In addition to returning the
+// offset up to which data was sent, it returns whether the call may be
+// resumed in case of failure.
+func (ba *casBlobAccess) write(ctx context.Context, resourceName string, writeOffset int64, retransmitChunks [][]byte, r buffer.ChunkReader, window *resumptionWindow) (int64, bool, error) {
 	ctxWithCancel, cancel := context.WithCancel(ctx)
 	client, err := ba.byteStreamClient.Write(ctxWithCancel)
 	if err != nil {
 		cancel()
-		return err
+		return writeOffset, false, err
 	}
 
-	resourceName := digest.GetByteStreamWritePath(uuid.Must(ba.uuidGenerator()), remoteexecution.Compressor_IDENTITY)
-	writeOffset := int64(0)
+	sentData := false
+	streamFailed := func(err error) (int64, bool, error) {
+		return writeOffset, window != nil && sentData && status.Code(err) == codes.Unavailable, err
+	}
 	for {
-		if data, err := r.Read(); err == nil {
+		var data []byte
+		var err error
+		if len(retransmitChunks) > 0 {
+			data, retransmitChunks = retransmitChunks[0], retransmitChunks[1:]
+		} else if data, err = r.Read(); err == nil && window != nil {
+			window.add(data)
+		}
+
+		if err == nil {
 			// Non-terminating chunk.
 			if client.Send(&bytestream.WriteRequest{
 				ResourceName: resourceName,
@@ -104,10 +353,11 @@ func (ba *casBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer
 			}) != nil {
 				cancel()
 				_, err := client.CloseAndRecv()
-				return err
+				return streamFailed(err)
 			}
 			writeOffset += int64(len(data))
 			resourceName = ""
+			sentData = true
 		} else if err == io.EOF {
 			// Terminating chunk.
 			if client.Send(&bytestream.WriteRequest{
@@ -117,15 +367,18 @@ func (ba *casBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer
 			}) != nil {
 				cancel()
 				_, err := client.CloseAndRecv()
-				return err
+				return streamFailed(err)
 			}
 			_, err := client.CloseAndRecv()
 			cancel()
-			return err
-		} else if err != nil {
+			if err != nil {
+				return streamFailed(err)
+			}
+			return writeOffset, false, nil
+		} else {
 			cancel()
 			client.CloseAndRecv()
-			return err
+			return writeOffset, false, err
 		}
 	}
 }
@@ -165,6 +418,10 @@ func (ba *casBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (d
 	return missingDigests.Build(), nil
 }
 
+func (ba *casBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 func (ba *casBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	cacheCapabilities, err := getCacheCapabilities(ctx, ba.capabilitiesClient, instanceName)
 	if err != nil {
@@ -174,7 +431,8 @@ func (ba *casBlobAccess) GetCapabilities(ctx context.Context, instanceName diges
 	// Only return fields that pertain to the Content Addressable
 	// Storage. Don't set 'max_batch_total_size_bytes', as we don't
 	// issue batch operations. The same holds for fields related to
-	// compression support.
+	// compression support, as compression is applied by whatever
+	// service is exposed to clients.
 	return &remoteexecution.ServerCapabilities{
 		CacheCapabilities: &remoteexecution.CacheCapabilities{
 			DigestFunctions: digest.RemoveUnsupportedDigestFunctions(cacheCapabilities.DigestFunctions),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/bazelbuild/remote-apis/build/bazel/semver"
@@ -13,6 +14,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -24,18 +26,92 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func TestCASBlobAccessGet(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	client := mock.NewMockClientConnInterface(ctrl)
+	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, clock, 10, remoteexecution.Compressor_IDENTITY)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	expectRead := func(readOffset int64, chunks []string, err error) {
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Read").
+			Return(clientStream, nil)
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.ReadRequest{
+			ResourceName: "hello/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			ReadOffset:   readOffset,
+		}))
+		clientStream.EXPECT().CloseSend()
+		for _, chunk := range chunks {
+			data := chunk
+			clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+				proto.Merge(m.(proto.Message), &bytestream.ReadResponse{
+					Data: []byte(data),
+				})
+				return nil
+			})
+		}
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(err).MinTimes(1)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectRead(0, []string{"Hello"}, io.EOF)
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("ResumeAfterFailure", func(t *testing.T) {
+		// If the stream fails after data has been received,
+		// the transfer should be resumed at the current offset.
+		expectRead(0, []string{"Hel"}, status.Error(codes.Unavailable, "Lost connection to server"))
+		expectRead(3, []string{"l"}, status.Error(codes.Unavailable, "Lost connection to server"))
+		expectRead(4, []string{"o"}, io.EOF)
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("NoProgress", func(t *testing.T) {
+		// Resumed streams that fail without returning any
+		// data should not be retried, as that could cause us
+		// to loop indefinitely.
+		expectRead(0, []string{"Hel"}, status.Error(codes.Unavailable, "Lost connection to server"))
+		expectRead(3, nil, status.Error(codes.Unavailable, "Server is shutting down"))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server is shutting down"), err)
+	})
+
+	t.Run("NonRetriableFailure", func(t *testing.T) {
+		// Only failures that are caused by the server being
+		// unavailable should cause the transfer to be resumed.
+		expectRead(0, []string{"Hel"}, status.Error(codes.Internal, "Disk on fire"))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+	})
+}
+
 func TestCASBlobAccessPut(t *testing.T) {
 	ctrl, ctx := gomock.WithContext(context.Background(), t)
 
 	client := mock.NewMockClientConnInterface(ctrl)
 	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
-	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, clock, 10, remoteexecution.Compressor_IDENTITY)
 
 	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
 	uuid := uuid.Must(uuid.Parse("7d659e5f-0e4b-48f0-ad9f-3489db6e103b"))
 
 	t.Run("InitialFailure", func(t *testing.T) {
 		// Failure to create the outgoing connection.
+		uuidGenerator.EXPECT().Call().Return(uuid, nil)
 		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
 			Return(nil, status.Error(codes.Internal, "Failed to create outgoing connection"))
 		r := mock.NewMockFileReader(ctrl)
@@ -124,6 +200,18 @@ func TestCASBlobAccessPut(t *testing.T) {
 		})).Return(io.EOF)
 		clientStream.EXPECT().CloseSend().Return(status.Error(codes.Unavailable, "Lost connection to server"))
 
+		// As data was sent successfully, an attempt is made to
+		// resume the upload. If the server is unable to report
+		// the write status, the original error is returned.
+		client.EXPECT().Invoke(
+			gomock.Any(),
+			"/google.bytestream.ByteStream/QueryWriteStatus",
+			testutil.EqProto(t, &bytestream.QueryWriteStatusRequest{
+				ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			}),
+			gomock.Any(),
+		).Return(status.Error(codes.Unimplemented, "This service does not support querying write status"))
+
 		testutil.RequireEqualStatus(t,
 			status.Error(codes.Unavailable, "Lost connection to server"),
 			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromReaderAt(r, 5)))
@@ -155,10 +243,85 @@ func TestCASBlobAccessPut(t *testing.T) {
 		}))
 		clientStream.EXPECT().CloseSend().Return(status.Error(codes.Unavailable, "Lost connection to server"))
 
+		// If the server reports that no data was committed, the
+		// upload should not be resumed.
+		client.EXPECT().Invoke(
+			gomock.Any(),
+			"/google.bytestream.ByteStream/QueryWriteStatus",
+			testutil.EqProto(t, &bytestream.QueryWriteStatusRequest{
+				ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			}),
+			gomock.Any(),
+		).Return(nil)
+
 		testutil.RequireEqualStatus(t,
 			status.Error(codes.Unavailable, "Lost connection to server"),
 			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromReaderAt(r, 5)))
 	})
+
+	t.Run("ResumeAfterFailure", func(t *testing.T) {
+		// If the stream fails after data has been sent and the
+		// server reports that part of the data was committed,
+		// the upload should be resumed at that offset.
+		clientStream1 := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
+			Return(clientStream1, nil)
+		uuidGenerator.EXPECT().Call().Return(uuid, nil)
+		r := mock.NewMockFileReader(ctrl)
+		r.EXPECT().ReadAt(gomock.Len(5), int64(0)).DoAndReturn(func(p []byte, off int64) (int, error) {
+			copy(p, "Hello")
+			return 5, nil
+		})
+		r.EXPECT().Close()
+		clientStream1.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			WriteOffset:  0,
+			Data:         []byte("Hello"),
+		}))
+		clientStream1.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			WriteOffset: 5,
+			FinishWrite: true,
+		})).Return(io.EOF)
+		clientStream1.EXPECT().CloseSend().Return(status.Error(codes.Unavailable, "Lost connection to server"))
+
+		client.EXPECT().Invoke(
+			gomock.Any(),
+			"/google.bytestream.ByteStream/QueryWriteStatus",
+			testutil.EqProto(t, &bytestream.QueryWriteStatusRequest{
+				ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			}),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+			proto.Merge(reply.(proto.Message), &bytestream.QueryWriteStatusResponse{
+				CommittedSize: 3,
+			})
+			return nil
+		})
+
+		// The data that was not committed should be
+		// retransmitted, using the same resource name.
+		clientStream2 := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
+			Return(clientStream2, nil)
+		clientStream2.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			ResourceName: "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			WriteOffset:  3,
+			Data:         []byte("lo"),
+		}))
+		clientStream2.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.WriteRequest{
+			WriteOffset: 5,
+			FinishWrite: true,
+		}))
+		clientStream2.EXPECT().CloseSend()
+		clientStream2.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			proto.Merge(m.(proto.Message), &bytestream.WriteResponse{
+				CommittedSize: 5,
+			})
+			return nil
+		})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromReaderAt(r, 5)))
+	})
 }
 
 func TestCASBlobAccessGetCapabilities(t *testing.T) {
@@ -166,7 +329,8 @@ func TestCASBlobAccessGetCapabilities(t *testing.T) {
 
 	client := mock.NewMockClientConnInterface(ctrl)
 	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
-	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, 10)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, clock, 10, remoteexecution.Compressor_IDENTITY)
 
 	t.Run("BackendFailure", func(t *testing.T) {
 		client.EXPECT().Invoke(
@@ -253,3 +417,193 @@ func TestCASBlobAccessGetCapabilities(t *testing.T) {
 		}, serverCapabilities)
 	})
 }
+
+func TestCASBlobAccessCompression(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	client := mock.NewMockClientConnInterface(ctrl)
+	uuidGenerator := mock.NewMockUUIDGenerator(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := grpcclients.NewCASBlobAccess(client, uuidGenerator.Call, clock, 10, remoteexecution.Compressor_ZSTD)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressedData := encoder.EncodeAll([]byte("Hello"), nil)
+	require.NoError(t, encoder.Close())
+
+	// The first operation against an instance name should cause
+	// the capabilities of the server to be requested. The results
+	// should be cached, meaning that subsequent operations don't
+	// call GetCapabilities() again.
+	client.EXPECT().Invoke(
+		ctx,
+		"/build.bazel.remote.execution.v2.Capabilities/GetCapabilities",
+		testutil.EqProto(t, &remoteexecution.GetCapabilitiesRequest{
+			InstanceName: "hello",
+		}),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+		proto.Merge(reply.(proto.Message), &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{
+				DigestFunctions: digest.SupportedDigestFunctions,
+				SupportedCompressors: []remoteexecution.Compressor_Value{
+					remoteexecution.Compressor_ZSTD,
+				},
+			},
+		})
+		return nil
+	})
+
+	t.Run("GetSuccess", func(t *testing.T) {
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Read").
+			Return(clientStream, nil)
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.ReadRequest{
+			ResourceName: "hello/compressed-blobs/zstd/8b1a9953c4611296a827abf8c47804d7/5",
+		}))
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			proto.Merge(m.(proto.Message), &bytestream.ReadResponse{
+				Data: compressedData,
+			})
+			return nil
+		})
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(io.EOF).MinTimes(1)
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("PutSuccess", func(t *testing.T) {
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Write").
+			Return(clientStream, nil)
+		uuidGenerator.EXPECT().Call().Return(uuid.Must(uuid.Parse("7d659e5f-0e4b-48f0-ad9f-3489db6e103b")), nil)
+		var uploadedData []byte
+		clientStream.EXPECT().SendMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			request := m.(*bytestream.WriteRequest)
+			if len(uploadedData) == 0 {
+				require.Equal(t, "hello/uploads/7d659e5f-0e4b-48f0-ad9f-3489db6e103b/compressed-blobs/zstd/8b1a9953c4611296a827abf8c47804d7/5", request.ResourceName)
+			} else {
+				require.Empty(t, request.ResourceName)
+			}
+			require.Equal(t, int64(len(uploadedData)), request.WriteOffset)
+			require.LessOrEqual(t, len(request.Data), 10)
+			uploadedData = append(uploadedData, request.Data...)
+			return nil
+		}).MinTimes(2)
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			proto.Merge(m.(proto.Message), &bytestream.WriteResponse{
+				CommittedSize: int64(len(uploadedData)),
+			})
+			return nil
+		})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+		data, err := decoder.DecodeAll(uploadedData, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("UnsupportedByServer", func(t *testing.T) {
+		// Instance names for which the server doesn't announce
+		// support for the compressor should use uncompressed
+		// transfers.
+		client.EXPECT().Invoke(
+			ctx,
+			"/build.bazel.remote.execution.v2.Capabilities/GetCapabilities",
+			testutil.EqProto(t, &remoteexecution.GetCapabilitiesRequest{
+				InstanceName: "other",
+			}),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+			proto.Merge(reply.(proto.Message), &remoteexecution.ServerCapabilities{
+				CacheCapabilities: &remoteexecution.CacheCapabilities{
+					DigestFunctions: digest.SupportedDigestFunctions,
+				},
+			})
+			return nil
+		})
+		clientStream := mock.NewMockClientStream(ctrl)
+		client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Read").
+			Return(clientStream, nil)
+		clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.ReadRequest{
+			ResourceName: "other/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+		}))
+		clientStream.EXPECT().CloseSend()
+		clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			proto.Merge(m.(proto.Message), &bytestream.ReadResponse{
+				Data: []byte("Hello"),
+			})
+			return nil
+		})
+		clientStream.EXPECT().RecvMsg(gomock.Any()).Return(io.EOF).MinTimes(1)
+
+		data, err := blobAccess.Get(ctx, digest.MustNewDigest("other", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("CapabilitiesFailure", func(t *testing.T) {
+		// If the capabilities cannot be obtained, uncompressed
+		// transfers should be used. The failure should be
+		// cached for some time, so that GetCapabilities() isn't
+		// called for every operation.
+		failingDigest := digest.MustNewDigest("failing", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+		expectGetCapabilities := func() {
+			client.EXPECT().Invoke(
+				ctx,
+				"/build.bazel.remote.execution.v2.Capabilities/GetCapabilities",
+				testutil.EqProto(t, &remoteexecution.GetCapabilitiesRequest{
+					InstanceName: "failing",
+				}),
+				gomock.Any(),
+			).Return(status.Error(codes.Unavailable, "Server offline"))
+		}
+		expectUncompressedRead := func() {
+			clientStream := mock.NewMockClientStream(ctrl)
+			client.EXPECT().NewStream(gomock.Any(), gomock.Any(), "/google.bytestream.ByteStream/Read").
+				Return(clientStream, nil)
+			clientStream.EXPECT().SendMsg(testutil.EqProto(t, &bytestream.ReadRequest{
+				ResourceName: "failing/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			}))
+			clientStream.EXPECT().CloseSend()
+			clientStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+				proto.Merge(m.(proto.Message), &bytestream.ReadResponse{
+					Data: []byte("Hello"),
+				})
+				return nil
+			})
+			clientStream.EXPECT().RecvMsg(gomock.Any()).Return(io.EOF).MinTimes(1)
+		}
+
+		expectGetCapabilities()
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		expectUncompressedRead()
+		data, err := blobAccess.Get(ctx, failingDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+
+		clock.EXPECT().Now().Return(time.Unix(1059, 0))
+		expectUncompressedRead()
+		data, err = blobAccess.Get(ctx, failingDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+
+		// Once the failure expires, the capabilities should be
+		// requested again.
+		clock.EXPECT().Now().Return(time.Unix(1060, 0)).Times(2)
+		expectGetCapabilities()
+		expectUncompressedRead()
+		data, err = blobAccess.Get(ctx, failingDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+}
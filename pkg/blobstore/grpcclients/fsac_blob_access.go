@@ -8,6 +8,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
 
 	"google.golang.org/grpc"
@@ -16,6 +17,8 @@ import (
 )
 
 type fsacBlobAccess struct {
+	adminForwarder
+
 	filesystemAccessCacheClient fsac.FileSystemAccessCacheClient
 	maximumMessageSizeBytes     int
 }
@@ -29,6 +32,8 @@ type fsacBlobAccess struct {
 // action's input root.
 func NewFSACBlobAccess(client grpc.ClientConnInterface, maximumMessageSizeBytes int) blobstore.BlobAccess {
 	return &fsacBlobAccess{
+		adminForwarder: newAdminForwarder(client, admin.StorageType_FILE_SYSTEM_ACCESS_CACHE),
+
 		filesystemAccessCacheClient: fsac.NewFileSystemAccessCacheClient(client),
 		maximumMessageSizeBytes:     maximumMessageSizeBytes,
 	}
@@ -71,6 +76,10 @@ func (ba *fsacBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	return digest.EmptySet, status.Error(codes.Unimplemented, "File System Access Cache does not support bulk existence checking")
 }
 
+func (ba *fsacBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 func (ba *fsacBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	panic("GetCapabilities() should only be called against BlobAccess instances for the Content Addressable Storage and Action Cache")
 }
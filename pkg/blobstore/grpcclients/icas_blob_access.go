@@ -8,12 +8,15 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 	"github.com/buildbarn/bb-storage/pkg/proto/icas"
 
 	"google.golang.org/grpc"
 )
 
 type icasBlobAccess struct {
+	adminForwarder
+
 	icasClient              icas.IndirectContentAddressableStorageClient
 	maximumMessageSizeBytes int
 }
@@ -24,6 +27,8 @@ type icasBlobAccess struct {
 // track references to objects stored in external corpora.
 func NewICASBlobAccess(client grpc.ClientConnInterface, maximumMessageSizeBytes int) blobstore.BlobAccess {
 	return &icasBlobAccess{
+		adminForwarder: newAdminForwarder(client, admin.StorageType_INDIRECT_CONTENT_ADDRESSABLE_STORAGE),
+
 		icasClient:              icas.NewIndirectContentAddressableStorageClient(client),
 		maximumMessageSizeBytes: maximumMessageSizeBytes,
 	}
@@ -104,6 +109,10 @@ func (ba *icasBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	return missingDigests.Build(), nil
 }
 
+func (ba *icasBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 func (ba *icasBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	panic("GetCapabilities() should only be called against BlobAccess instances for the Content Addressable Storage and Action Cache")
 }
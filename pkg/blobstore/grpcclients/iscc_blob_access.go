@@ -8,6 +8,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
 	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
 
 	"google.golang.org/grpc"
@@ -16,6 +17,8 @@ import (
 )
 
 type isccBlobAccess struct {
+	adminForwarder
+
 	initialSizeClassCacheClient iscc.InitialSizeClassCacheClient
 	maximumMessageSizeBytes     int
 }
@@ -28,6 +31,8 @@ type isccBlobAccess struct {
 // invocations of similar actions.
 func NewISCCBlobAccess(client grpc.ClientConnInterface, maximumMessageSizeBytes int) blobstore.BlobAccess {
 	return &isccBlobAccess{
+		adminForwarder: newAdminForwarder(client, admin.StorageType_INITIAL_SIZE_CLASS_CACHE),
+
 		initialSizeClassCacheClient: iscc.NewInitialSizeClassCacheClient(client),
 		maximumMessageSizeBytes:     maximumMessageSizeBytes,
 	}
@@ -70,6 +75,10 @@ func (ba *isccBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	return digest.EmptySet, status.Error(codes.Unimplemented, "Initial Size Class Cache does not support bulk existence checking")
 }
 
+func (ba *isccBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 func (ba *isccBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	panic("GetCapabilities() should only be called against BlobAccess instances for the Content Addressable Storage and Action Cache")
 }
@@ -0,0 +1,172 @@
+package grpcservers
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/audit"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// adminServerListDigestsBatchSize is the maximum number of digests
+// that are returned as part of a single ListDigestsResponse.
+const adminServerListDigestsBatchSize = 1000
+
+type adminServer struct {
+	blobAccesses map[admin.StorageType]blobstore.BlobAccess
+	authorizer   auth.Authorizer
+	auditLogger  audit.Logger
+}
+
+// NewAdminServer creates a gRPC service for performing administrative
+// operations against storage, such as invalidating corrupted objects
+// in the Content Addressable Storage (CAS) and poisoned entries in the
+// Action Cache (AC).
+//
+// The BlobAccess instances that are provided should not perform any
+// authorization of their own, as access to this service is controlled
+// by a separate authorizer.
+//
+// All requests that invalidate objects are recorded in the audit log,
+// including ones that are denied.
+func NewAdminServer(blobAccesses map[admin.StorageType]blobstore.BlobAccess, authorizer auth.Authorizer, auditLogger audit.Logger) admin.AdminServer {
+	return &adminServer{
+		blobAccesses: blobAccesses,
+		authorizer:   authorizer,
+		auditLogger:  auditLogger,
+	}
+}
+
+func (s *adminServer) getBlobAccess(storageType admin.StorageType) (blobstore.BlobAccess, error) {
+	blobAccess, ok := s.blobAccesses[storageType]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Storage type %s is not configured", storageType)
+	}
+	return blobAccess, nil
+}
+
+func (s *adminServer) InvalidateBlobs(ctx context.Context, in *admin.InvalidateBlobsRequest) (*emptypb.Empty, error) {
+	blobAccess, err := s.getBlobAccess(in.StorageType)
+	if err != nil {
+		return nil, err
+	}
+	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
+	}
+	if err := auth.AuthorizeSingleInstanceName(ctx, s.authorizer, instanceName); err != nil {
+		s.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "InvalidateBlobs",
+			InstanceName: instanceName,
+			Digest:       digest.BadDigest,
+			Error:        err,
+		})
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	digestsBuilder := digest.NewSetBuilder()
+	for _, partialDigest := range in.BlobDigests {
+		digest, err := digestFunction.NewDigestFromProto(partialDigest)
+		if err != nil {
+			return nil, err
+		}
+		digestsBuilder.Add(digest)
+	}
+	digests := digestsBuilder.Build()
+	err = blobAccess.Invalidate(ctx, digests)
+	for _, blobDigest := range digests.Items() {
+		s.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "InvalidateBlobs",
+			InstanceName: instanceName,
+			Digest:       blobDigest,
+			Performed:    true,
+			Result:       err,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *adminServer) InvalidateInstanceNamePrefix(ctx context.Context, in *admin.InvalidateInstanceNamePrefixRequest) (*emptypb.Empty, error) {
+	blobAccess, err := s.getBlobAccess(in.StorageType)
+	if err != nil {
+		return nil, err
+	}
+	instanceNamePrefix, err := digest.NewInstanceName(in.InstanceNamePrefix)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name prefix %#v", in.InstanceNamePrefix)
+	}
+	if err := auth.AuthorizeSingleInstanceName(ctx, s.authorizer, instanceNamePrefix); err != nil {
+		s.auditLogger.Log(ctx, &audit.Event{
+			Operation:    "InvalidateInstanceNamePrefix",
+			InstanceName: instanceNamePrefix,
+			Digest:       digest.BadDigest,
+			Error:        err,
+		})
+		return nil, util.StatusWrap(err, "Authorization")
+	}
+	err = blobAccess.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix)
+	s.auditLogger.Log(ctx, &audit.Event{
+		Operation:    "InvalidateInstanceNamePrefix",
+		InstanceName: instanceNamePrefix,
+		Digest:       digest.BadDigest,
+		Performed:    true,
+		Result:       err,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *adminServer) ListDigests(in *admin.ListDigestsRequest, out admin.Admin_ListDigestsServer) error {
+	blobAccess, err := s.getBlobAccess(in.StorageType)
+	if err != nil {
+		return err
+	}
+	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	if err != nil {
+		return util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
+	}
+	ctx := out.Context()
+	if err := auth.AuthorizeSingleInstanceName(ctx, s.authorizer, instanceName); err != nil {
+		return util.StatusWrap(err, "Authorization")
+	}
+	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, 0)
+	if err != nil {
+		return err
+	}
+
+	// Send digests back to the client in batches, so that the
+	// overhead of sending individual messages is amortized.
+	blobDigests := make([]*remoteexecution.Digest, 0, adminServerListDigestsBatchSize)
+	if err := blobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+		blobDigests = append(blobDigests, blobDigest.GetProto())
+		if len(blobDigests) < adminServerListDigestsBatchSize {
+			return nil
+		}
+		err := out.Send(&admin.ListDigestsResponse{BlobDigests: blobDigests})
+		blobDigests = make([]*remoteexecution.Digest, 0, adminServerListDigestsBatchSize)
+		return err
+	}); err != nil {
+		return err
+	}
+	if len(blobDigests) > 0 {
+		return out.Send(&admin.ListDigestsResponse{BlobDigests: blobDigests})
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package grpcservers_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/audit"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/admin"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminServerInvalidateBlobs(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	actionCache := mock.NewMockBlobAccess(ctrl)
+	authorizer := mock.NewMockAuthorizer(ctrl)
+	auditLogger := mock.NewMockLogger(ctrl)
+	adminServer := grpcservers.NewAdminServer(map[admin.StorageType]blobstore.BlobAccess{
+		admin.StorageType_ACTION_CACHE: actionCache,
+	}, authorizer, auditLogger)
+
+	t.Run("UnconfiguredStorageType", func(t *testing.T) {
+		_, err := adminServer.InvalidateBlobs(ctx, &admin.InvalidateBlobsRequest{
+			StorageType:  admin.StorageType_CONTENT_ADDRESSABLE_STORAGE,
+			InstanceName: "hello",
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Storage type CONTENT_ADDRESSABLE_STORAGE is not configured"), err)
+	})
+
+	t.Run("InvalidInstanceName", func(t *testing.T) {
+		_, err := adminServer.InvalidateBlobs(ctx, &admin.InvalidateBlobsRequest{
+			StorageType:  admin.StorageType_ACTION_CACHE,
+			InstanceName: "blobs",
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid instance name \"blobs\": Instance name contains reserved keyword \"blobs\""), err)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello")}).
+			Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "InvalidateBlobs",
+			InstanceName: digest.MustNewInstanceName("hello"),
+			Digest:       digest.BadDigest,
+			Error:        status.Error(codes.PermissionDenied, "You shall not pass"),
+		})
+
+		_, err := adminServer.InvalidateBlobs(ctx, &admin.InvalidateBlobsRequest{
+			StorageType:  admin.StorageType_ACTION_CACHE,
+			InstanceName: "hello",
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello")}).
+			Return([]error{nil})
+		blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+		actionCache.EXPECT().Invalidate(ctx, blobDigest.ToSingletonSet())
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "InvalidateBlobs",
+			InstanceName: digest.MustNewInstanceName("hello"),
+			Digest:       blobDigest,
+			Performed:    true,
+		})
+
+		_, err := adminServer.InvalidateBlobs(ctx, &admin.InvalidateBlobsRequest{
+			StorageType:  admin.StorageType_ACTION_CACHE,
+			InstanceName: "hello",
+			BlobDigests: []*remoteexecution.Digest{
+				{
+					Hash:      "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969",
+					SizeBytes: 5,
+				},
+			},
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminServerInvalidateInstanceNamePrefix(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	actionCache := mock.NewMockBlobAccess(ctrl)
+	authorizer := mock.NewMockAuthorizer(ctrl)
+	auditLogger := mock.NewMockLogger(ctrl)
+	adminServer := grpcservers.NewAdminServer(map[admin.StorageType]blobstore.BlobAccess{
+		admin.StorageType_ACTION_CACHE: actionCache,
+	}, authorizer, auditLogger)
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{nil})
+		actionCache.EXPECT().InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("hello/world")).
+			Return(status.Error(codes.InvalidArgument, "This storage backend does not partition objects by instance name"))
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "InvalidateInstanceNamePrefix",
+			InstanceName: digest.MustNewInstanceName("hello/world"),
+			Digest:       digest.BadDigest,
+			Performed:    true,
+			Result:       status.Error(codes.InvalidArgument, "This storage backend does not partition objects by instance name"),
+		})
+
+		_, err := adminServer.InvalidateInstanceNamePrefix(ctx, &admin.InvalidateInstanceNamePrefixRequest{
+			StorageType:        admin.StorageType_ACTION_CACHE,
+			InstanceNamePrefix: "hello/world",
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "This storage backend does not partition objects by instance name"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).
+			Return([]error{nil})
+		actionCache.EXPECT().InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("hello/world"))
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "InvalidateInstanceNamePrefix",
+			InstanceName: digest.MustNewInstanceName("hello/world"),
+			Digest:       digest.BadDigest,
+			Performed:    true,
+		})
+
+		_, err := adminServer.InvalidateInstanceNamePrefix(ctx, &admin.InvalidateInstanceNamePrefixRequest{
+			StorageType:        admin.StorageType_ACTION_CACHE,
+			InstanceNamePrefix: "hello/world",
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestAdminServerListDigests(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	authorizer := mock.NewMockAuthorizer(ctrl)
+
+	l := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	admin.RegisterAdminServer(server, grpcservers.NewAdminServer(map[admin.StorageType]blobstore.BlobAccess{
+		admin.StorageType_CONTENT_ADDRESSABLE_STORAGE: contentAddressableStorage,
+	}, authorizer, audit.DiscardingLogger))
+	go func() {
+		require.NoError(t, server.Serve(l))
+	}()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return l.Dial()
+	}), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer server.Stop()
+	defer conn.Close()
+	client := admin.NewAdminClient(conn)
+
+	digestFunction := digest.MustNewFunction("hello", remoteexecution.DigestFunction_MD5)
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		// Errors returned by the backend should be propagated,
+		// even if some digests have already been reported.
+		authorizer.EXPECT().Authorize(gomock.Any(), []digest.InstanceName{digest.MustNewInstanceName("hello")}).
+			Return([]error{nil})
+		contentAddressableStorage.EXPECT().ListDigests(gomock.Any(), digestFunction, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+				require.NoError(t, reportDigest(digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)))
+				return status.Error(codes.Unimplemented, "This backend cannot list its contents")
+			})
+
+		stream, err := client.ListDigests(ctx, &admin.ListDigestsRequest{
+			StorageType:    admin.StorageType_CONTENT_ADDRESSABLE_STORAGE,
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+		})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "This backend cannot list its contents"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Digests should be returned in batches.
+		authorizer.EXPECT().Authorize(gomock.Any(), []digest.InstanceName{digest.MustNewInstanceName("hello")}).
+			Return([]error{nil})
+		contentAddressableStorage.EXPECT().ListDigests(gomock.Any(), digestFunction, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+				for i := int64(0); i < 1001; i++ {
+					if err := reportDigest(digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", i)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+		stream, err := client.ListDigests(ctx, &admin.ListDigestsRequest{
+			StorageType:    admin.StorageType_CONTENT_ADDRESSABLE_STORAGE,
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+		})
+		require.NoError(t, err)
+		response, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, response.BlobDigests, 1000)
+		response, err = stream.Recv()
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &admin.ListDigestsResponse{
+			BlobDigests: []*remoteexecution.Digest{
+				{
+					Hash:      "8b1a9953c4611296a827abf8c47804d7",
+					SizeBytes: 1000,
+				},
+			},
+		}, response)
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+}
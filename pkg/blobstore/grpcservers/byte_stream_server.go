@@ -7,6 +7,7 @@ import (
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -15,33 +16,88 @@ import (
 )
 
 type byteStreamServer struct {
-	blobAccess    blobstore.BlobAccess
-	readChunkSize int
+	blobAccess        blobstore.BlobAccess
+	readChunkSize     int
+	uploadStagingArea *UploadStagingArea
 }
 
 // NewByteStreamServer creates a GRPC service for reading blobs from and
 // writing blobs to a BlobAccess. It is used by Bazel to access the
 // Content Addressable Storage (CAS).
-func NewByteStreamServer(blobAccess blobstore.BlobAccess, readChunkSize int) bytestream.ByteStreamServer {
+//
+// In addition to transferring blobs in literal form, this service
+// permits transferring blobs using any of the compression algorithms
+// listed in compression.SupportedCompressors. Compression and
+// decompression are performed on the fly, meaning that the underlying
+// BlobAccess only ever processes uncompressed data.
+//
+// If an UploadStagingArea is provided, uncompressed uploads that
+// terminate prematurely are retained, allowing clients to resume them
+// at the offset returned by QueryWriteStatus().
+func NewByteStreamServer(blobAccess blobstore.BlobAccess, readChunkSize int, uploadStagingArea *UploadStagingArea) bytestream.ByteStreamServer {
 	return &byteStreamServer{
-		blobAccess:    blobAccess,
-		readChunkSize: readChunkSize,
+		blobAccess:        blobAccess,
+		readChunkSize:     readChunkSize,
+		uploadStagingArea: uploadStagingArea,
 	}
 }
 
-func (s *byteStreamServer) Read(in *bytestream.ReadRequest, out bytestream.ByteStream_ReadServer) error {
-	if in.ReadLimit != 0 {
-		return status.Error(codes.Unimplemented, "This service does not support downloading partial files")
+// limitingChunkReader is a decorator for ChunkReader that causes it to
+// return at most a fixed number of bytes. It is used to implement the
+// read_limit field of ByteStream read requests.
+type limitingChunkReader struct {
+	buffer.ChunkReader
+	bytesRemaining int64
+}
+
+func (r *limitingChunkReader) Read() ([]byte, error) {
+	if r.bytesRemaining == 0 {
+		return nil, io.EOF
+	}
+	data, err := r.ChunkReader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > r.bytesRemaining {
+		data = data[:r.bytesRemaining]
 	}
+	r.bytesRemaining -= int64(len(data))
+	return data, nil
+}
+
+func (s *byteStreamServer) Read(in *bytestream.ReadRequest, out bytestream.ByteStream_ReadServer) error {
 	digest, compressor, err := digest.NewDigestFromByteStreamReadPath(in.ResourceName)
 	if err != nil {
 		return err
 	}
-	if compressor != remoteexecution.Compressor_IDENTITY {
-		return status.Error(codes.Unimplemented, "This service does not support downloading compressed files")
+	if in.ReadLimit < 0 {
+		return status.Errorf(codes.InvalidArgument, "Negative read limit: %d", in.ReadLimit)
+	}
+	if in.ReadLimit != 0 && compressor != remoteexecution.Compressor_IDENTITY {
+		// REv2 prohibits the use of read limits when
+		// requesting compressed data, as the size of the
+		// compressed data is not known up front.
+		return status.Error(codes.InvalidArgument, "Read limits cannot be used in combination with compression")
 	}
 
 	r := s.blobAccess.Get(out.Context(), digest).ToChunkReader(in.ReadOffset, s.readChunkSize)
+	if in.ReadLimit != 0 {
+		r = &limitingChunkReader{
+			ChunkReader:    r,
+			bytesRemaining: in.ReadLimit,
+		}
+	}
+	if compressor != remoteexecution.Compressor_IDENTITY {
+		// REv2 requires that the read offset refers to the
+		// uncompressed form of the blob. This means that data
+		// can be compressed after the offset has been applied.
+		compressingReader, err := compression.NewCompressingChunkReader(r, compressor, s.readChunkSize)
+		if err != nil {
+			r.Close()
+			return err
+		}
+		r = compressingReader
+	}
 	defer r.Close()
 
 	for {
@@ -63,6 +119,13 @@ type byteStreamWriteServerChunkReader struct {
 	writeOffset   int64
 	data          []byte
 	finishedWrite bool
+	streamFailed  bool
+
+	// Fields that are used to retain the data of the upload, so
+	// that it may be resumed if the stream fails.
+	uploadStagingArea *UploadStagingArea
+	upload            *partialUpload
+	resumedChunks     [][]byte
 }
 
 func (r *byteStreamWriteServerChunkReader) setRequest(request *bytestream.WriteRequest) error {
@@ -80,12 +143,24 @@ func (r *byteStreamWriteServerChunkReader) setRequest(request *bytestream.WriteR
 }
 
 func (r *byteStreamWriteServerChunkReader) Read() ([]byte, error) {
+	// Return data that was received by a previous call that
+	// has been resumed.
+	if len(r.resumedChunks) > 0 {
+		data := r.resumedChunks[0]
+		r.resumedChunks = r.resumedChunks[1:]
+		return data, nil
+	}
+
 	// Read next chunk if no data is present.
 	if len(r.data) == 0 {
 		request, err := r.stream.Recv()
 		if err != nil {
-			if err == io.EOF && !r.finishedWrite {
-				return nil, status.Error(codes.InvalidArgument, "Client closed stream without finishing write")
+			if err == io.EOF {
+				if !r.finishedWrite {
+					return nil, status.Error(codes.InvalidArgument, "Client closed stream without finishing write")
+				}
+			} else {
+				r.streamFailed = true
 			}
 			return nil, err
 		}
@@ -96,9 +171,29 @@ func (r *byteStreamWriteServerChunkReader) Read() ([]byte, error) {
 
 	data := r.data
 	r.data = nil
+	if r.upload != nil && !r.uploadStagingArea.recordChunk(r.upload, data) {
+		// Insufficient space to retain the data of this
+		// upload, meaning it cannot be resumed.
+		r.uploadStagingArea.releaseUpload(r.upload)
+		r.upload = nil
+	}
 	return data, nil
 }
 
+// finalize the upload after the data has been written into the
+// BlobAccess. If the stream failed, the data received is staged, so
+// that the client may resume the upload.
+func (r *byteStreamWriteServerChunkReader) finalize(resourceName string, err error) {
+	if r.upload != nil {
+		if err != nil && r.streamFailed && len(r.resumedChunks) == 0 {
+			r.uploadStagingArea.stageUpload(resourceName, r.upload)
+		} else {
+			r.uploadStagingArea.releaseUpload(r.upload)
+		}
+		r.upload = nil
+	}
+}
+
 func (r *byteStreamWriteServerChunkReader) Close() {}
 
 func (s *byteStreamServer) Write(stream bytestream.ByteStream_WriteServer) error {
@@ -110,25 +205,95 @@ func (s *byteStreamServer) Write(stream bytestream.ByteStream_WriteServer) error
 	if err != nil {
 		return err
 	}
-	if compressor != remoteexecution.Compressor_IDENTITY {
-		return status.Error(codes.Unimplemented, "This service does not support uploading compressed files")
-	}
 
 	r := &byteStreamWriteServerChunkReader{stream: stream}
+	if compressor == remoteexecution.Compressor_IDENTITY {
+		if s.uploadStagingArea != nil {
+			// Uncompressed uploads can be resumed. If data
+			// of a previous attempt is present, continue
+			// where it left off.
+			upload := s.uploadStagingArea.takeUpload(request.ResourceName)
+			if request.WriteOffset != 0 && request.WriteOffset == upload.sizeBytes {
+				r.writeOffset = upload.sizeBytes
+				r.resumedChunks = upload.chunks
+			} else {
+				s.uploadStagingArea.releaseUpload(upload)
+				upload = &partialUpload{}
+			}
+			r.uploadStagingArea = s.uploadStagingArea
+			r.upload = upload
+		}
+		if err := r.setRequest(request); err != nil {
+			r.finalize(request.ResourceName, err)
+			return err
+		}
+
+		err := s.blobAccess.Put(
+			stream.Context(),
+			digest,
+			buffer.NewCASBufferFromChunkReader(digest, r, buffer.UserProvided))
+		r.finalize(request.ResourceName, err)
+		if err != nil {
+			return err
+		}
+		return stream.SendAndClose(&bytestream.WriteResponse{
+			CommittedSize: digest.GetSizeBytes(),
+		})
+	}
+
+	// Decompress the data before storing it, so that the digest
+	// can be validated against the uncompressed contents. For
+	// compressed uploads, the committed size is expressed in terms
+	// of the compressed data received.
 	if err := r.setRequest(request); err != nil {
 		return err
 	}
+	decompressedReader, err := compression.NewDecompressingReader(r, compressor)
+	if err != nil {
+		return err
+	}
 	if err := s.blobAccess.Put(
 		stream.Context(),
 		digest,
-		buffer.NewCASBufferFromChunkReader(digest, r, buffer.UserProvided)); err != nil {
+		buffer.NewCASBufferFromReader(digest, decompressedReader, buffer.UserProvided)); err != nil {
 		return err
 	}
 	return stream.SendAndClose(&bytestream.WriteResponse{
-		CommittedSize: digest.GetSizeBytes(),
+		CommittedSize: r.writeOffset,
 	})
 }
 
 func (s *byteStreamServer) QueryWriteStatus(ctx context.Context, in *bytestream.QueryWriteStatusRequest) (*bytestream.QueryWriteStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This service does not support querying write status")
+	digest, compressor, err := digest.NewDigestFromByteStreamWritePath(in.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report the amount of data of the upload that has been staged.
+	if s.uploadStagingArea != nil && compressor == remoteexecution.Compressor_IDENTITY {
+		if sizeBytes, ok := s.uploadStagingArea.getUploadSize(in.ResourceName); ok {
+			return &bytestream.QueryWriteStatusResponse{
+				CommittedSize: sizeBytes,
+			}, nil
+		}
+	}
+
+	// No data has been staged. Report whether the upload has
+	// already been completed by checking for the blob's existence.
+	missing, err := s.blobAccess.FindMissing(ctx, digest.ToSingletonSet())
+	if err != nil {
+		return nil, err
+	}
+	if !missing.Empty() {
+		return &bytestream.QueryWriteStatusResponse{}, nil
+	}
+	committedSize := digest.GetSizeBytes()
+	if compressor != remoteexecution.Compressor_IDENTITY {
+		// The size of the compressed data is not known.
+		committedSize = -1
+	}
+	return &bytestream.QueryWriteStatusResponse{
+		CommittedSize: committedSize,
+		Complete:      true,
+	}, nil
 }
@@ -1,6 +1,8 @@
 package grpcservers_test
 
 import (
+	"bytes"
+	"compress/flate"
 	"context"
 	"io"
 	"net"
@@ -11,8 +13,10 @@ import (
 	"github.com/buildbarn/bb-storage/internal/mock"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/genproto/googleapis/bytestream"
@@ -31,7 +35,7 @@ func TestByteStreamServer(t *testing.T) {
 	l := bufconn.Listen(1 << 20)
 	server := grpc.NewServer()
 	blobAccess := mock.NewMockBlobAccess(ctrl)
-	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10))
+	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10, nil))
 	go func() {
 		require.NoError(t, server.Serve(l))
 	}()
@@ -180,6 +184,73 @@ func TestByteStreamServer(t *testing.T) {
 		require.Equal(t, io.EOF, err)
 	})
 
+	t.Run("ReadSuccessWithOffsetAndLimit", func(t *testing.T) {
+		// Attempt to fetch a part of a blob by providing both
+		// an offset and a limit.
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "da39a3ee5e6b4b0d3255bfef95601890", 19),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("This offset message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/blobs/da39a3ee5e6b4b0d3255bfef95601890/19",
+			ReadOffset:   4,
+			ReadLimit:    12,
+		})
+		require.NoError(t, err)
+		readResponse, err := req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte(" offset me"), readResponse.Data)
+		readResponse, err = req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("ss"), readResponse.Data)
+		_, err = req.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("ReadLimitBeyondEnd", func(t *testing.T) {
+		// Read limits that extend beyond the end of the blob
+		// should cause the remainder of the blob to be returned.
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "ad3c8ac9eef32188da352082244b3598", 13),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("short message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/blobs/ad3c8ac9eef32188da352082244b3598/13",
+			ReadOffset:   6,
+			ReadLimit:    100,
+		})
+		require.NoError(t, err)
+		readResponse, err := req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("message"), readResponse.Data)
+		_, err = req.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("ReadNegativeReadLimit", func(t *testing.T) {
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/blobs/ad3c8ac9eef32188da352082244b3598/13",
+			ReadLimit:    -1,
+		})
+		require.NoError(t, err)
+		_, err = req.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Negative read limit: -1"), err)
+	})
+
+	t.Run("ReadLimitCompressed", func(t *testing.T) {
+		// REv2 does not permit read limits to be used when
+		// requesting compressed data.
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/zstd/ad3c8ac9eef32188da352082244b3598/13",
+			ReadLimit:    5,
+		})
+		require.NoError(t, err)
+		_, err = req.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Read limits cannot be used in combination with compression"), err)
+	})
+
 	t.Run("ReadNonexistentBlob", func(t *testing.T) {
 		// Attempt to fetch a nonexistent blob.
 		blobAccess.EXPECT().Get(
@@ -195,6 +266,54 @@ func TestByteStreamServer(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
 	})
 
+	t.Run("ReadSuccessCompressed", func(t *testing.T) {
+		// Blobs may be requested in compressed form. The data
+		// should be compressed after applying the read offset,
+		// as REv2 requires that the read offset refers to the
+		// uncompressed form of the blob.
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "da39a3ee5e6b4b0d3255bfef95601890", 19),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("This offset message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/zstd/da39a3ee5e6b4b0d3255bfef95601890/19",
+			ReadOffset:   4,
+		})
+		require.NoError(t, err)
+		var compressedData []byte
+		for {
+			readResponse, err := req.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(readResponse.Data), 10)
+			compressedData = append(compressedData, readResponse.Data...)
+		}
+
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+		data, err := decoder.DecodeAll(compressedData, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte(" offset message"), data)
+	})
+
+	t.Run("ReadUnsupportedCompressor", func(t *testing.T) {
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_MD5, "da39a3ee5e6b4b0d3255bfef95601890", 19),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("This offset message")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "ubuntu1804/compressed-blobs/brotli/da39a3ee5e6b4b0d3255bfef95601890/19",
+		})
+		require.NoError(t, err)
+		_, err = req.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "Unsupported compressor BROTLI"), err)
+	})
+
 	t.Run("WriteBadResourceName", func(t *testing.T) {
 		// Attempt to write to a bad resource name.
 		stream, err := client.Write(ctx)
@@ -313,10 +432,326 @@ func TestByteStreamServer(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to write at offset 4, while 5 was expected"), err)
 	})
 
-	t.Run("QueryWriteStatus", func(t *testing.T) {
-		_, err := client.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+	t.Run("WriteSuccessCompressed", func(t *testing.T) {
+		// Attempt to write a blob in compressed form. The data
+		// should be decompressed prior to storing it.
+		var compressedData bytes.Buffer
+		encoder, err := flate.NewWriter(&compressedData, flate.DefaultCompression)
+		require.NoError(t, err)
+		_, err = encoder.Write([]byte("LaputanMachine"))
+		require.NoError(t, err)
+		require.NoError(t, encoder.Close())
+
+		blobAccess.EXPECT().Put(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "581c1053f832a1c719fb6528a588ccfd", 14),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			data, err := b.ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("LaputanMachine"), data)
+			return nil
+		})
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/7de747e0-ab6b-4d83-90cb-11989f84c473/compressed-blobs/deflate/581c1053f832a1c719fb6528a588ccfd/14",
+			Data:         compressedData.Bytes()[:5],
+		}))
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			Data:        compressedData.Bytes()[5:],
+			WriteOffset: 5,
+			FinishWrite: true,
+		}))
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(compressedData.Len()), response.CommittedSize)
+	})
+
+	t.Run("WriteCompressedChecksumMismatch", func(t *testing.T) {
+		// Digests of compressed uploads should be validated
+		// against the uncompressed data.
+		var compressedData bytes.Buffer
+		encoder, err := flate.NewWriter(&compressedData, flate.DefaultCompression)
+		require.NoError(t, err)
+		_, err = encoder.Write([]byte("LaputanMachinf"))
+		require.NoError(t, err)
+		require.NoError(t, encoder.Close())
+
+		blobAccess.EXPECT().Put(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "581c1053f832a1c719fb6528a588ccfd", 14),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			_, err := b.ToByteSlice(100)
+			return err
+		})
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/7de747e0-ab6b-4d83-90cb-11989f84c473/compressed-blobs/deflate/581c1053f832a1c719fb6528a588ccfd/14",
+			Data:         compressedData.Bytes(),
+			FinishWrite:  true,
+		}))
+		_, err = stream.CloseAndRecv()
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Buffer has checksum ac923a93dec4aa9b7213cf5e22ffe697, while 581c1053f832a1c719fb6528a588ccfd was expected"), err)
+	})
+
+	t.Run("QueryWriteStatusNotStarted", func(t *testing.T) {
+		// If no data is staged and the blob is absent, the
+		// upload has not made any progress.
+		blobDigest := digest.MustNewDigest("windows10", remoteexecution.DigestFunction_MD5, "68e109f0f40ca72a15e05cc22786f8e6", 10)
+		blobAccess.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).
+			Return(blobDigest.ToSingletonSet(), nil)
+
+		response, err := client.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+			ResourceName: "windows10/uploads/d834d9c2-f3c9-4f30-a698-75fd4be9470d/blobs/68e109f0f40ca72a15e05cc22786f8e6/10",
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &bytestream.QueryWriteStatusResponse{}, response)
+	})
+
+	t.Run("QueryWriteStatusComplete", func(t *testing.T) {
+		// If the blob is present, the upload should be
+		// reported as being complete.
+		blobDigest := digest.MustNewDigest("windows10", remoteexecution.DigestFunction_MD5, "68e109f0f40ca72a15e05cc22786f8e6", 10)
+		blobAccess.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).
+			Return(digest.EmptySet, nil)
+
+		response, err := client.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
 			ResourceName: "windows10/uploads/d834d9c2-f3c9-4f30-a698-75fd4be9470d/blobs/68e109f0f40ca72a15e05cc22786f8e6/10",
 		})
-		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "This service does not support querying write status"), err)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &bytestream.QueryWriteStatusResponse{
+			CommittedSize: 10,
+			Complete:      true,
+		}, response)
 	})
 }
+
+func TestByteStreamServerResumableUploads(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create an RPC server/client pair that has an upload staging
+	// area enabled.
+	l := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(
+		blobAccess,
+		10,
+		grpcservers.NewUploadStagingArea(clock.SystemClock, 1<<20, time.Hour)))
+	go func() {
+		require.NoError(t, server.Serve(l))
+	}()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return l.Dial()
+	}), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer server.Stop()
+	defer conn.Close()
+	client := bytestream.NewByteStreamClient(conn)
+
+	blobDigest := digest.MustNewDigest("windows10", remoteexecution.DigestFunction_MD5, "68e109f0f40ca72a15e05cc22786f8e6", 10)
+	resourceName := "windows10/uploads/d834d9c2-f3c9-4f30-a698-75fd4be9470d/blobs/68e109f0f40ca72a15e05cc22786f8e6/10"
+	blobAccess.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).
+		Return(blobDigest.ToSingletonSet(), nil).
+		AnyTimes()
+
+	t.Run("ResumeWithoutStagedData", func(t *testing.T) {
+		// Attempting to resume an upload for which no data is
+		// staged should fail.
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: resourceName,
+			WriteOffset:  5,
+			Data:         []byte("World"),
+			FinishWrite:  true,
+		}))
+		_, err = stream.CloseAndRecv()
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to write at offset 5, while 0 was expected"), err)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		// Start an upload, but terminate the stream after the
+		// first chunk of data has been received.
+		received := make(chan struct{})
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				r := b.ToChunkReader(0, 100)
+				defer r.Close()
+				data, err := r.Read()
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				close(received)
+				_, err = r.Read()
+				require.Error(t, err)
+				return err
+			})
+
+		ctxWithCancel, cancel := context.WithCancel(ctx)
+		stream, err := client.Write(ctxWithCancel)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: resourceName,
+			Data:         []byte("Hello"),
+		}))
+		<-received
+		cancel()
+
+		// The data received should be staged, meaning that
+		// QueryWriteStatus() should eventually report it.
+		require.Eventually(t, func() bool {
+			response, err := client.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+				ResourceName: resourceName,
+			})
+			require.NoError(t, err)
+			require.False(t, response.Complete)
+			return response.CommittedSize == 5
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// Resuming the upload should cause the staged data to
+		// be prepended to the data provided by the client.
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("HelloWorld"), data)
+				return nil
+			})
+
+		stream, err = client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: resourceName,
+			WriteOffset:  5,
+			Data:         []byte("World"),
+			FinishWrite:  true,
+		}))
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(10), response.CommittedSize)
+
+		// Staged data should be discarded upon completion.
+		response2, err := client.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+			ResourceName: resourceName,
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &bytestream.QueryWriteStatusResponse{}, response2)
+	})
+}
+
+// fakeByteStreamWriteServer is a ByteStream_WriteServer that returns a
+// fixed sequence of requests, followed by an error. It permits calling
+// into ByteStreamServer.Write() synchronously.
+type fakeByteStreamWriteServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*bytestream.WriteRequest
+	err      error
+}
+
+func (s *fakeByteStreamWriteServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeByteStreamWriteServer) Recv() (*bytestream.WriteRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, s.err
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *fakeByteStreamWriteServer) SendAndClose(*bytestream.WriteResponse) error {
+	return nil
+}
+
+func TestByteStreamServerResumableUploadsExpiration(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	byteStreamServer := grpcservers.NewByteStreamServer(
+		blobAccess,
+		10,
+		grpcservers.NewUploadStagingArea(clock, 1<<20, time.Minute))
+
+	blobDigest := digest.MustNewDigest("windows10", remoteexecution.DigestFunction_MD5, "68e109f0f40ca72a15e05cc22786f8e6", 10)
+	resourceNameA := "windows10/uploads/d834d9c2-f3c9-4f30-a698-75fd4be9470d/blobs/68e109f0f40ca72a15e05cc22786f8e6/10"
+	resourceNameB := "windows10/uploads/5a2e6a5c-1a4e-4c43-9e0c-0e9d40e5d3b6/blobs/68e109f0f40ca72a15e05cc22786f8e6/10"
+
+	stageUpload := func(resourceName string) {
+		blobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				_, err := b.ToByteSlice(100)
+				return err
+			})
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Connection reset"),
+			byteStreamServer.Write(&fakeByteStreamWriteServer{
+				ctx: ctx,
+				requests: []*bytestream.WriteRequest{{
+					ResourceName: resourceName,
+					Data:         []byte("Hello"),
+				}},
+				err: status.Error(codes.Unavailable, "Connection reset"),
+			}))
+	}
+
+	// Stage upload A, resume and complete it, and stage upload B.
+	// This leaves a stale entry for upload A at the head of the
+	// staging order.
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(3)
+	stageUpload(resourceNameA)
+
+	clock.EXPECT().Now().Return(time.Unix(1010, 0)).Times(2)
+	blobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			data, err := b.ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("HelloWorld"), data)
+			return nil
+		})
+	require.NoError(t, byteStreamServer.Write(&fakeByteStreamWriteServer{
+		ctx: ctx,
+		requests: []*bytestream.WriteRequest{{
+			ResourceName: resourceNameA,
+			WriteOffset:  5,
+			Data:         []byte("World"),
+			FinishWrite:  true,
+		}},
+		err: io.EOF,
+	}))
+
+	clock.EXPECT().Now().Return(time.Unix(1030, 0)).Times(3)
+	stageUpload(resourceNameB)
+
+	// Once the retention time of upload A has passed, its stale
+	// entry should be discarded without affecting upload B, which
+	// has not expired yet.
+	clock.EXPECT().Now().Return(time.Unix(1070, 0))
+	response, err := byteStreamServer.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+		ResourceName: resourceNameB,
+	})
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &bytestream.QueryWriteStatusResponse{
+		CommittedSize: 5,
+	}, response)
+
+	// Once the retention time of upload B has passed, it should
+	// be discarded.
+	clock.EXPECT().Now().Return(time.Unix(1090, 0))
+	blobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).
+		Return(blobDigest.ToSingletonSet(), nil)
+	response, err = byteStreamServer.QueryWriteStatus(ctx, &bytestream.QueryWriteStatusRequest{
+		ResourceName: resourceNameB,
+	})
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &bytestream.QueryWriteStatusResponse{}, response)
+}
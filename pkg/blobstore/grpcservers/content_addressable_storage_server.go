@@ -2,6 +2,7 @@ package grpcservers
 
 import (
 	"context"
+	"strconv"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -11,6 +12,8 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 )
 
 type contentAddressableStorageServer struct {
@@ -40,22 +43,31 @@ func (s *contentAddressableStorageServer) FindMissingBlobs(ctx context.Context,
 		return nil, err
 	}
 
-	inDigests := digest.NewSetBuilder()
+	// Stream digests into the backend, so that requests of
+	// arbitrary size can be processed without gathering all digests
+	// into a single set. Duplicate digests are only reported once.
+	var partialDigests []*remoteexecution.Digest
+	reportedDigests := map[digest.Digest]struct{}{}
+	stream := s.contentAddressableStorage.FindMissingStreaming(ctx, func(missingDigest digest.Digest) {
+		if _, ok := reportedDigests[missingDigest]; !ok {
+			reportedDigests[missingDigest] = struct{}{}
+			partialDigests = append(partialDigests, missingDigest.GetProto())
+		}
+	})
 	for _, partialDigest := range in.BlobDigests {
-		digest, err := digestFunction.NewDigestFromProto(partialDigest)
+		blobDigest, err := digestFunction.NewDigestFromProto(partialDigest)
 		if err != nil {
+			stream.Finish()
+			return nil, err
+		}
+		if err := stream.Add(blobDigest); err != nil {
+			stream.Finish()
 			return nil, err
 		}
-		inDigests.Add(digest)
 	}
-	outDigests, err := s.contentAddressableStorage.FindMissing(ctx, inDigests.Build())
-	if err != nil {
+	if err := stream.Finish(); err != nil {
 		return nil, err
 	}
-	partialDigests := make([]*remoteexecution.Digest, 0, outDigests.Length())
-	for _, outDigest := range outDigests.Items() {
-		partialDigests = append(partialDigests, outDigest.GetProto())
-	}
 	return &remoteexecution.FindMissingBlobsResponse{
 		MissingBlobDigests: partialDigests,
 	}, nil
@@ -143,6 +155,106 @@ func (s *contentAddressableStorageServer) BatchUpdateBlobs(ctx context.Context,
 	return response, nil
 }
 
+// getTreeResponseOverheadBytes is the number of bytes that needs to be
+// reserved in every GetTreeResponse for storing the next_page_token
+// field. Page tokens are decimal integers, meaning they are at most 19
+// bytes in size.
+var getTreeResponseOverheadBytes = protowire.SizeTag(2) + protowire.SizeBytes(19)
+
+// GetTree returns all Directory messages that are part of a directory
+// hierarchy. Directories are traversed in breadth-first order, with
+// duplicate directories only being returned once. Directories are
+// streamed back to the client across one or more responses, each
+// containing at most page_size directories and remaining within the
+// configured maximum message size.
+//
+// The page tokens returned by this implementation contain the number
+// of directories that have already been returned. When resuming, the
+// directory hierarchy is traversed from the root once more, omitting
+// directories that have already been returned. This means that the
+// implementation is stateless, at the cost of having to reload
+// directories that were returned previously.
 func (s *contentAddressableStorageServer) GetTree(in *remoteexecution.GetTreeRequest, stream remoteexecution.ContentAddressableStorage_GetTreeServer) error {
-	return status.Error(codes.Unimplemented, "This service does not support downloading directory trees")
+	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	if err != nil {
+		return util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.RootDigest.GetHash()))
+	if err != nil {
+		return err
+	}
+	rootDigest, err := digestFunction.NewDigestFromProto(in.RootDigest)
+	if err != nil {
+		return util.StatusWrap(err, "Invalid root digest")
+	}
+	if in.PageSize < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid page size %d", in.PageSize)
+	}
+	directoriesToSkip := int64(0)
+	if in.PageToken != "" {
+		directoriesToSkip, err = strconv.ParseInt(in.PageToken, 10, 64)
+		if err != nil || directoriesToSkip < 0 {
+			return status.Errorf(codes.InvalidArgument, "Invalid page token %#v", in.PageToken)
+		}
+	}
+
+	ctx := stream.Context()
+	maximumResponseSizeBytes := int(s.maximumMessageSizeBytes) - getTreeResponseOverheadBytes
+	directoriesSeen := map[digest.Digest]struct{}{rootDigest: {}}
+	directoriesToVisit := []digest.Digest{rootDigest}
+	directoriesReturned := int64(0)
+	response := &remoteexecution.GetTreeResponse{}
+	responseSizeBytes := 0
+	for len(directoriesToVisit) > 0 {
+		directoryDigest := directoriesToVisit[0]
+		directoriesToVisit = directoriesToVisit[1:]
+		directoryMessage, err := s.contentAddressableStorage.Get(ctx, directoryDigest).
+			ToProto(&remoteexecution.Directory{}, int(s.maximumMessageSizeBytes))
+		if err != nil {
+			if directoryDigest != rootDigest && status.Code(err) == codes.NotFound {
+				// The REv2 specification requires that
+				// missing parts of the tree are omitted.
+				continue
+			}
+			return util.StatusWrapf(err, "Failed to obtain directory %#v", directoryDigest.String())
+		}
+		directory := directoryMessage.(*remoteexecution.Directory)
+
+		for _, child := range directory.Directories {
+			childDigest, err := digestFunction.NewDigestFromProto(child.Digest)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid digest for child directory %#v in directory %#v", child.Name, directoryDigest.String())
+			}
+			if _, ok := directoriesSeen[childDigest]; !ok {
+				directoriesSeen[childDigest] = struct{}{}
+				directoriesToVisit = append(directoriesToVisit, childDigest)
+			}
+		}
+
+		directoriesReturned++
+		if directoriesReturned <= directoriesToSkip {
+			continue
+		}
+
+		// Flush the current response if adding this directory
+		// would cause it to exceed the page size or the maximum
+		// message size.
+		directorySizeBytes := protowire.SizeTag(1) + protowire.SizeBytes(proto.Size(directory))
+		if directorySizeBytes > maximumResponseSizeBytes {
+			return status.Errorf(codes.InvalidArgument, "Directory %#v is %d bytes in size, which exceeds the maximum permitted response size of %d bytes", directoryDigest.String(), directorySizeBytes, maximumResponseSizeBytes)
+		}
+		if len(response.Directories) > 0 &&
+			(responseSizeBytes+directorySizeBytes > maximumResponseSizeBytes ||
+				(in.PageSize > 0 && len(response.Directories) >= int(in.PageSize))) {
+			response.NextPageToken = strconv.FormatInt(directoriesReturned-1, 10)
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+			response = &remoteexecution.GetTreeResponse{}
+			responseSizeBytes = 0
+		}
+		response.Directories = append(response.Directories, directory)
+		responseSizeBytes += directorySizeBytes
+	}
+	return stream.Send(response)
 }
@@ -2,10 +2,14 @@ package grpcservers_test
 
 import (
 	"context"
+	"io"
+	"net"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
 	"github.com/buildbarn/bb-storage/pkg/digest"
@@ -13,8 +17,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	status_pb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 
 	"go.uber.org/mock/gomock"
 )
@@ -113,3 +120,248 @@ func TestContentAddressableStorageServerBatchReadBlobsFailure(t *testing.T) {
 	_, err := contentAddressableStorageServer.BatchReadBlobs(ctx, request)
 	testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to read a total of at least 357 bytes, while a maximum of 200 bytes is permitted"), err)
 }
+
+func TestContentAddressableStorageServerFindMissingBlobs(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, 1<<16)
+
+	digest1 := digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_SHA256, "409a7f83ac6b31dc8c77e3ec18038f209bd2f545e0f4177c2e2381aa4e067b49", 123)
+	digest2 := digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_SHA256, "0479688f99e8cbc70291ce272876ff8e0db71a0889daf2752884b0996056b4a0", 234)
+	request := &remoteexecution.FindMissingBlobsRequest{
+		InstanceName: "ubuntu1804",
+		BlobDigests: []*remoteexecution.Digest{
+			{Hash: "409a7f83ac6b31dc8c77e3ec18038f209bd2f545e0f4177c2e2381aa4e067b49", SizeBytes: 123},
+			{Hash: "0479688f99e8cbc70291ce272876ff8e0db71a0889daf2752884b0996056b4a0", SizeBytes: 234},
+			{Hash: "409a7f83ac6b31dc8c77e3ec18038f209bd2f545e0f4177c2e2381aa4e067b49", SizeBytes: 123},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Digests should be streamed into the backend. Digests
+		// that are reported multiple times should only be
+		// returned once.
+		stream := mock.NewMockFindMissingStream(ctrl)
+		var reportMissing blobstore.FindMissingReporter
+		contentAddressableStorage.EXPECT().FindMissingStreaming(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r blobstore.FindMissingReporter) blobstore.FindMissingStream {
+				reportMissing = r
+				return stream
+			})
+		stream.EXPECT().Add(digest1).Times(2)
+		stream.EXPECT().Add(digest2)
+		stream.EXPECT().Finish().DoAndReturn(func() error {
+			reportMissing(digest1)
+			reportMissing(digest1)
+			return nil
+		})
+
+		response, err := contentAddressableStorageServer.FindMissingBlobs(ctx, request)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.FindMissingBlobsResponse{
+			MissingBlobDigests: []*remoteexecution.Digest{
+				{Hash: "409a7f83ac6b31dc8c77e3ec18038f209bd2f545e0f4177c2e2381aa4e067b49", SizeBytes: 123},
+			},
+		}, response)
+	})
+
+	t.Run("AddFailure", func(t *testing.T) {
+		// Errors returned by Add() should be propagated, while
+		// still finishing the stream.
+		stream := mock.NewMockFindMissingStream(ctrl)
+		contentAddressableStorage.EXPECT().FindMissingStreaming(ctx, gomock.Any()).Return(stream)
+		stream.EXPECT().Add(digest1).Return(status.Error(codes.Unavailable, "Server offline"))
+		stream.EXPECT().Finish().Return(status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := contentAddressableStorageServer.FindMissingBlobs(ctx, request)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+}
+
+func TestContentAddressableStorageServerGetTree(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create an RPC server/client pair.
+	l := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	remoteexecution.RegisterContentAddressableStorageServer(server, grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, 1<<16))
+	go func() {
+		require.NoError(t, server.Serve(l))
+	}()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return l.Dial()
+	}), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer server.Stop()
+	defer conn.Close()
+	client := remoteexecution.NewContentAddressableStorageClient(conn)
+
+	// Create a directory hierarchy of the following shape, where
+	// both "a" and "b" refer to the same child directory "c", and
+	// "missing" is absent from the Content Addressable Storage.
+	//
+	// root
+	// ├── a
+	// │   └── c
+	// ├── b
+	// │   └── c
+	// └── missing
+	digestFunction := digest.MustNewFunction("hello", remoteexecution.DigestFunction_SHA256)
+	getDigest := func(m proto.Message) digest.Digest {
+		data, err := proto.Marshal(m)
+		require.NoError(t, err)
+		generator := digestFunction.NewGenerator(int64(len(data)))
+		generator.Write(data)
+		return generator.Sum()
+	}
+	directoryC := &remoteexecution.Directory{
+		Files: []*remoteexecution.FileNode{{
+			Name: "file",
+			Digest: &remoteexecution.Digest{
+				Hash:      "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb",
+				SizeBytes: 1,
+			},
+		}},
+	}
+	digestC := getDigest(directoryC)
+	directoryA := &remoteexecution.Directory{
+		Directories: []*remoteexecution.DirectoryNode{{Name: "c", Digest: digestC.GetProto()}},
+	}
+	digestA := getDigest(directoryA)
+	directoryB := &remoteexecution.Directory{
+		Directories: []*remoteexecution.DirectoryNode{{Name: "c", Digest: digestC.GetProto()}},
+		Symlinks:    []*remoteexecution.SymlinkNode{{Name: "link", Target: "c"}},
+	}
+	digestB := getDigest(directoryB)
+	digestMissing := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "3e25960a79dbc69b674cd4ec67a72c62c72c62c72c62c72c62c72c62c72c62c7", 42)
+	directoryRoot := &remoteexecution.Directory{
+		Directories: []*remoteexecution.DirectoryNode{
+			{Name: "a", Digest: digestA.GetProto()},
+			{Name: "b", Digest: digestB.GetProto()},
+			{Name: "missing", Digest: digestMissing.GetProto()},
+		},
+	}
+	digestRoot := getDigest(directoryRoot)
+
+	expectDirectory := func(blobDigest digest.Digest, directory *remoteexecution.Directory) {
+		contentAddressableStorage.EXPECT().Get(gomock.Any(), blobDigest).
+			Return(buffer.NewProtoBufferFromProto(directory, buffer.UserProvided))
+	}
+	expectMissing := func(blobDigest digest.Digest) {
+		contentAddressableStorage.EXPECT().Get(gomock.Any(), blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+	}
+	receiveAll := func(stream remoteexecution.ContentAddressableStorage_GetTreeClient) ([]*remoteexecution.GetTreeResponse, error) {
+		var responses []*remoteexecution.GetTreeResponse
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return responses, nil
+			} else if err != nil {
+				return responses, err
+			}
+			responses = append(responses, response)
+		}
+	}
+
+	t.Run("InvalidPageToken", func(t *testing.T) {
+		stream, err := client.GetTree(ctx, &remoteexecution.GetTreeRequest{
+			InstanceName: "hello",
+			RootDigest:   digestRoot.GetProto(),
+			PageToken:    "foo",
+		})
+		require.NoError(t, err)
+		_, err = receiveAll(stream)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid page token \"foo\""), err)
+	})
+
+	t.Run("RootMissing", func(t *testing.T) {
+		expectMissing(digestRoot)
+
+		stream, err := client.GetTree(ctx, &remoteexecution.GetTreeRequest{
+			InstanceName: "hello",
+			RootDigest:   digestRoot.GetProto(),
+		})
+		require.NoError(t, err)
+		_, err = receiveAll(stream)
+		testutil.RequireEqualStatus(t, status.Errorf(codes.NotFound, "Failed to obtain directory \"%s\": Object not found", digestRoot.String()), err)
+	})
+
+	t.Run("SinglePage", func(t *testing.T) {
+		// Without a page size, all directories should be
+		// returned in a single response. Directory "c" should
+		// only be returned once, and the missing directory
+		// should be omitted.
+		expectDirectory(digestRoot, directoryRoot)
+		expectDirectory(digestA, directoryA)
+		expectDirectory(digestB, directoryB)
+		expectMissing(digestMissing)
+		expectDirectory(digestC, directoryC)
+
+		stream, err := client.GetTree(ctx, &remoteexecution.GetTreeRequest{
+			InstanceName: "hello",
+			RootDigest:   digestRoot.GetProto(),
+		})
+		require.NoError(t, err)
+		responses, err := receiveAll(stream)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.GetTreeResponse{
+			Directories: []*remoteexecution.Directory{directoryRoot, directoryA, directoryB, directoryC},
+		}, responses[0])
+		require.Len(t, responses, 1)
+	})
+
+	t.Run("MultiplePages", func(t *testing.T) {
+		// With a page size of three, the directories should be
+		// split up across two responses.
+		expectDirectory(digestRoot, directoryRoot)
+		expectDirectory(digestA, directoryA)
+		expectDirectory(digestB, directoryB)
+		expectMissing(digestMissing)
+		expectDirectory(digestC, directoryC)
+
+		stream, err := client.GetTree(ctx, &remoteexecution.GetTreeRequest{
+			InstanceName: "hello",
+			RootDigest:   digestRoot.GetProto(),
+			PageSize:     3,
+		})
+		require.NoError(t, err)
+		responses, err := receiveAll(stream)
+		require.NoError(t, err)
+		require.Len(t, responses, 2)
+		testutil.RequireEqualProto(t, &remoteexecution.GetTreeResponse{
+			Directories:   []*remoteexecution.Directory{directoryRoot, directoryA, directoryB},
+			NextPageToken: "3",
+		}, responses[0])
+		testutil.RequireEqualProto(t, &remoteexecution.GetTreeResponse{
+			Directories: []*remoteexecution.Directory{directoryC},
+		}, responses[1])
+	})
+
+	t.Run("ResumeFromPageToken", func(t *testing.T) {
+		// When resuming, the directory hierarchy needs to be
+		// traversed from the root, but only directories past
+		// the page token should be returned.
+		expectDirectory(digestRoot, directoryRoot)
+		expectDirectory(digestA, directoryA)
+		expectDirectory(digestB, directoryB)
+		expectMissing(digestMissing)
+		expectDirectory(digestC, directoryC)
+
+		stream, err := client.GetTree(ctx, &remoteexecution.GetTreeRequest{
+			InstanceName: "hello",
+			RootDigest:   digestRoot.GetProto(),
+			PageSize:     3,
+			PageToken:    "2",
+		})
+		require.NoError(t, err)
+		responses, err := receiveAll(stream)
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		testutil.RequireEqualProto(t, &remoteexecution.GetTreeResponse{
+			Directories: []*remoteexecution.Directory{directoryB, directoryC},
+		}, responses[0])
+	})
+}
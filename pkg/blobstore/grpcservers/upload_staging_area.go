@@ -0,0 +1,202 @@
+package grpcservers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+)
+
+// partialUpload holds the data of a ByteStream Write() call that has
+// been received so far.
+type partialUpload struct {
+	chunks         [][]byte
+	sizeBytes      int64
+	expirationTime time.Time
+}
+
+type stagedUploadKey struct {
+	resourceName string
+	upload       *partialUpload
+}
+
+// UploadStagingArea keeps track of the data of ByteStream Write() calls
+// that terminated prematurely. This permits clients to resume such
+// uploads by calling QueryWriteStatus(), followed by issuing a Write()
+// call that starts at the offset that was committed.
+//
+// All data is stored in memory. The total amount of data held by the
+// staging area, including data of uploads that are still in progress,
+// is bounded. When space is exhausted, the oldest staged uploads are
+// discarded first. Uploads that cannot be staged due to lack of space
+// will not be resumable.
+type UploadStagingArea struct {
+	clock            clock.Clock
+	maximumSizeBytes int64
+	retention        time.Duration
+
+	lock           sync.Mutex
+	totalSizeBytes int64
+	// The amount of data held by staged uploads, which is the
+	// amount of space that can be reclaimed by discarding them.
+	stagedSizeBytes int64
+	uploads         map[string]*partialUpload
+	// Staged uploads in the order in which they were staged. As
+	// all uploads are retained for the same amount of time, this
+	// is also the order in which they expire. Entries for uploads
+	// that have been removed from the map are skipped.
+	stagingOrder []stagedUploadKey
+}
+
+// NewUploadStagingArea creates an UploadStagingArea that is initially
+// empty. Uploads are retained for a fixed amount of time after they
+// terminated prematurely.
+func NewUploadStagingArea(clock clock.Clock, maximumSizeBytes int64, retention time.Duration) *UploadStagingArea {
+	return &UploadStagingArea{
+		clock:            clock,
+		maximumSizeBytes: maximumSizeBytes,
+		retention:        retention,
+
+		uploads: map[string]*partialUpload{},
+	}
+}
+
+// removeOldestLocked removes the staged upload that was staged first.
+// It returns false if no staged uploads are present.
+func (sa *UploadStagingArea) removeOldestLocked() bool {
+	for len(sa.stagingOrder) > 0 {
+		key := sa.stagingOrder[0]
+		sa.stagingOrder[0] = stagedUploadKey{}
+		sa.stagingOrder = sa.stagingOrder[1:]
+		if sa.uploads[key.resourceName] == key.upload {
+			delete(sa.uploads, key.resourceName)
+			sa.totalSizeBytes -= key.upload.sizeBytes
+			sa.stagedSizeBytes -= key.upload.sizeBytes
+			return true
+		}
+	}
+	return false
+}
+
+// removeExpiredLocked removes all staged uploads whose retention time
+// has passed.
+func (sa *UploadStagingArea) removeExpiredLocked() {
+	now := sa.clock.Now()
+	for len(sa.stagingOrder) > 0 {
+		key := sa.stagingOrder[0]
+		if sa.uploads[key.resourceName] == key.upload {
+			// Entry of an upload that is still staged. As
+			// uploads expire in the order in which they are
+			// staged, we can stop at the first one that has
+			// not expired.
+			if now.Before(key.upload.expirationTime) {
+				return
+			}
+			delete(sa.uploads, key.resourceName)
+			sa.totalSizeBytes -= key.upload.sizeBytes
+			sa.stagedSizeBytes -= key.upload.sizeBytes
+		}
+		// Entries of uploads that have been taken or
+		// replaced in the meantime are discarded without
+		// removing anything.
+		sa.stagingOrder[0] = stagedUploadKey{}
+		sa.stagingOrder = sa.stagingOrder[1:]
+	}
+}
+
+// getUploadSize returns the amount of data that has been staged for a
+// given resource name.
+func (sa *UploadStagingArea) getUploadSize(resourceName string) (int64, bool) {
+	sa.lock.Lock()
+	defer sa.lock.Unlock()
+
+	sa.removeExpiredLocked()
+	if upload, ok := sa.uploads[resourceName]; ok {
+		return upload.sizeBytes, true
+	}
+	return 0, false
+}
+
+// takeUpload removes the staged upload for a given resource name from
+// the staging area, so that it may be resumed. If no upload is staged,
+// an empty upload is returned. The caller is responsible for either
+// staging or releasing the upload afterwards.
+func (sa *UploadStagingArea) takeUpload(resourceName string) *partialUpload {
+	sa.lock.Lock()
+	defer sa.lock.Unlock()
+
+	sa.removeExpiredLocked()
+	if upload, ok := sa.uploads[resourceName]; ok {
+		delete(sa.uploads, resourceName)
+		sa.stagedSizeBytes -= upload.sizeBytes
+		return upload
+	}
+	return &partialUpload{}
+}
+
+// recordChunk appends a chunk of data to an upload that is in
+// progress. It returns false if the staging area has insufficient
+// space to hold the data.
+func (sa *UploadStagingArea) recordChunk(upload *partialUpload, data []byte) bool {
+	sizeBytes := int64(len(data))
+	if sizeBytes == 0 {
+		return true
+	}
+
+	sa.lock.Lock()
+	defer sa.lock.Unlock()
+
+	sa.removeExpiredLocked()
+	if sa.totalSizeBytes-sa.stagedSizeBytes+sizeBytes > sa.maximumSizeBytes {
+		// Even discarding all staged uploads won't free up
+		// enough space, as the space is used by uploads that
+		// are still in progress. Don't discard anything.
+		return false
+	}
+	for sa.totalSizeBytes+sizeBytes > sa.maximumSizeBytes {
+		sa.removeOldestLocked()
+	}
+	sa.totalSizeBytes += sizeBytes
+	upload.chunks = append(upload.chunks, data)
+	upload.sizeBytes += sizeBytes
+	return true
+}
+
+// releaseUpload discards the data of an upload that is in progress.
+func (sa *UploadStagingArea) releaseUpload(upload *partialUpload) {
+	sa.lock.Lock()
+	sa.totalSizeBytes -= upload.sizeBytes
+	sa.lock.Unlock()
+}
+
+// stageUpload inserts an upload that terminated prematurely into the
+// staging area, so that it may be resumed later on.
+func (sa *UploadStagingArea) stageUpload(resourceName string, upload *partialUpload) {
+	sa.lock.Lock()
+	defer sa.lock.Unlock()
+
+	if upload.sizeBytes == 0 {
+		return
+	}
+	if oldUpload, ok := sa.uploads[resourceName]; ok {
+		// Another call for the same resource name has staged
+		// data in the meantime. Discard it in favor of ours.
+		delete(sa.uploads, resourceName)
+		sa.totalSizeBytes -= oldUpload.sizeBytes
+		sa.stagedSizeBytes -= oldUpload.sizeBytes
+	}
+	// Create a new object, so that entries in stagingOrder
+	// belonging to earlier attempts of the same upload can be
+	// distinguished from this one.
+	stagedUpload := &partialUpload{
+		chunks:         upload.chunks,
+		sizeBytes:      upload.sizeBytes,
+		expirationTime: sa.clock.Now().Add(sa.retention),
+	}
+	sa.uploads[resourceName] = stagedUpload
+	sa.stagedSizeBytes += stagedUpload.sizeBytes
+	sa.stagingOrder = append(sa.stagingOrder, stagedUploadKey{
+		resourceName: resourceName,
+		upload:       stagedUpload,
+	})
+}
@@ -132,6 +132,10 @@ func (ba *hierarchicalInstanceNamesBlobAccess) FindMissing(ctx context.Context,
 	return finallyMissing.Build(), nil
 }
 
+func (ba *hierarchicalInstanceNamesBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 type hierarchicalInstanceNamesGetErrorHandler struct {
 	blobAccess BlobAccess
 	context    context.Context
@@ -22,9 +22,15 @@ type BlockPutWriter func(b buffer.Buffer) BlockPutFinalizer
 type BlockPutFinalizer func() (int64, error)
 
 // Block of storage that contains a sequence of blobs. Buffers returned
-// by Get() must remain valid, even if Release() is called.
+// by Get() and GetUnvalidated() must remain valid, even if Release() is
+// called.
 type Block interface {
 	Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer
+	// GetUnvalidated is similar to Get(), except that the data is
+	// returned as it is stored, without performing any checksum
+	// validation or decompression. This is used to enumerate the
+	// contents of storage, where the digests of blobs are not known.
+	GetUnvalidated(offsetBytes, sizeBytes int64) buffer.Buffer
 	HasSpace(sizeBytes int64) bool
 	Put(sizeBytes int64) BlockPutWriter
 	Release()
@@ -15,7 +15,6 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -60,6 +59,8 @@ type blockDeviceBackedBlockAllocator struct {
 	readBufferFactory blobstore.ReadBufferFactory
 	sectorSizeBytes   int
 	blockSectorCount  int64
+	blockCount        int64
+	encryptionKeys    *EncryptionKeys
 
 	blockAllocatorAllocations   prometheus.Counter
 	blockAllocatorReleases      prometheus.Counter
@@ -68,6 +69,13 @@ type blockDeviceBackedBlockAllocator struct {
 
 	lock        sync.Mutex
 	freeOffsets []int64
+
+	// Blocks restored from persistent state that was created with
+	// a different geometry, and the number of such blocks that
+	// overlap with each of the regularly sized blocks. Regularly
+	// sized blocks are only handed out when no longer overlapping.
+	foreignBlocks        map[*blockDeviceBackedBlock]struct{}
+	foreignBlockOverlaps map[int64]int
 }
 
 // NewBlockDeviceBackedBlockAllocator implements a BlockAllocator that
@@ -83,7 +91,19 @@ type blockDeviceBackedBlockAllocator struct {
 // This implementation also ensures that writes against underlying
 // storage are all performed at sector boundaries and sizes. This
 // ensures that no unnecessary reads are performed.
-func NewBlockDeviceBackedBlockAllocator(blockDevice blockdevice.BlockDevice, readBufferFactory blobstore.ReadBufferFactory, sectorSizeBytes int, blockSectorCount int64, blockCount int, storageType string) BlockAllocator {
+//
+// NewBlockAtLocation() also accepts blocks that do not correspond with
+// the current partitioning of the BlockDevice, as long as they are
+// sector aligned and don't overlap with other blocks. This allows the
+// number of blocks or the size of the BlockDevice to be changed without
+// discarding all data. The space occupied by such blocks only becomes
+// available for allocation after they have been released.
+//
+// If encryptionKeys is not nil, the contents of newly allocated blocks
+// are encrypted using the current key. The identifier of this key is
+// stored in the BlockLocation, so that blocks that were encrypted using
+// older keys can still be read after restarts.
+func NewBlockDeviceBackedBlockAllocator(blockDevice blockdevice.BlockDevice, readBufferFactory blobstore.ReadBufferFactory, sectorSizeBytes int, blockSectorCount int64, blockCount int, encryptionKeys *EncryptionKeys, storageType string) BlockAllocator {
 	blockDeviceBackedBlockAllocatorPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(blockDeviceBackedBlockAllocatorAllocations)
 		prometheus.MustRegister(blockDeviceBackedBlockAllocatorReleases)
@@ -97,11 +117,16 @@ func NewBlockDeviceBackedBlockAllocator(blockDevice blockdevice.BlockDevice, rea
 		readBufferFactory: readBufferFactory,
 		sectorSizeBytes:   sectorSizeBytes,
 		blockSectorCount:  blockSectorCount,
+		blockCount:        int64(blockCount),
+		encryptionKeys:    encryptionKeys,
 
 		blockAllocatorAllocations:   blockDeviceBackedBlockAllocatorAllocations.WithLabelValues(storageType),
 		blockAllocatorReleases:      blockDeviceBackedBlockAllocatorReleases.WithLabelValues(storageType),
 		blockAllocatorGetsStarted:   blockDeviceBackedBlockAllocatorGetsStarted.WithLabelValues(storageType),
 		blockAllocatorGetsCompleted: blockDeviceBackedBlockAllocatorGetsCompleted.WithLabelValues(storageType),
+
+		foreignBlocks:        map[*blockDeviceBackedBlock]struct{}{},
+		foreignBlockOverlaps: map[int64]int{},
 	}
 	for i := 0; i < blockCount; i++ {
 		pa.freeOffsets = append(pa.freeOffsets, int64(i)*blockSectorCount)
@@ -109,11 +134,13 @@ func NewBlockDeviceBackedBlockAllocator(blockDevice blockdevice.BlockDevice, rea
 	return pa
 }
 
-func (pa *blockDeviceBackedBlockAllocator) newBlockObject(deviceOffsetSectors, writeOffsetSectors int64) Block {
+func (pa *blockDeviceBackedBlockAllocator) newBlockObject(device sectorDevice, deviceOffsetSectors, sizeSectors, writeOffsetSectors int64) *blockDeviceBackedBlock {
 	pa.blockAllocatorAllocations.Inc()
 	pb := &blockDeviceBackedBlock{
 		blockAllocator:      pa,
+		device:              device,
 		deviceOffsetSectors: deviceOffsetSectors,
+		sizeSectors:         sizeSectors,
 		writeOffsetSectors:  writeOffsetSectors,
 	}
 	pb.usecount.Store(1)
@@ -127,6 +154,27 @@ func (pa *blockDeviceBackedBlockAllocator) getBlockLocationMessage(deviceOffsetS
 	}
 }
 
+// getDevice returns the device against which reads and writes of a
+// block need to be performed, given the identifier of the key that is
+// used to encrypt its contents.
+func (pa *blockDeviceBackedBlockAllocator) getDevice(encryptionKeyID uint32) (sectorDevice, bool) {
+	if encryptionKeyID == 0 {
+		return pa.blockDevice, true
+	}
+	if pa.encryptionKeys == nil {
+		return nil, false
+	}
+	cipher, ok := pa.encryptionKeys.getCipher(encryptionKeyID)
+	if !ok {
+		return nil, false
+	}
+	return &encryptedSectorDevice{
+		base:            pa.blockDevice,
+		cipher:          cipher,
+		sectorSizeBytes: pa.sectorSizeBytes,
+	}, true
+}
+
 func (pa *blockDeviceBackedBlockAllocator) NewBlock() (Block, *pb.BlockLocation, error) {
 	pa.lock.Lock()
 	defer pa.lock.Unlock()
@@ -134,26 +182,123 @@ func (pa *blockDeviceBackedBlockAllocator) NewBlock() (Block, *pb.BlockLocation,
 	if len(pa.freeOffsets) == 0 {
 		return nil, nil, status.Error(codes.Unavailable, "No unused blocks available")
 	}
+	var encryptionKeyID uint32
+	if pa.encryptionKeys != nil {
+		encryptionKeyID, _ = pa.encryptionKeys.getCurrentCipher()
+	}
+	device, _ := pa.getDevice(encryptionKeyID)
 	deviceOffsetSectors := pa.freeOffsets[0]
 	pa.freeOffsets = pa.freeOffsets[1:]
-	return pa.newBlockObject(deviceOffsetSectors, 0), pa.getBlockLocationMessage(deviceOffsetSectors), nil
+	location := pa.getBlockLocationMessage(deviceOffsetSectors)
+	location.EncryptionKeyId = encryptionKeyID
+	return pa.newBlockObject(device, deviceOffsetSectors, pa.blockSectorCount, 0), location, nil
 }
 
 func (pa *blockDeviceBackedBlockAllocator) NewBlockAtLocation(location *pb.BlockLocation, writeOffsetBytes int64) (Block, bool) {
 	pa.lock.Lock()
 	defer pa.lock.Unlock()
 
+	// Blocks stored on other block devices or encrypted using keys
+	// that are no longer available cannot be restored.
+	if location.GetDeviceIndex() != 0 {
+		return nil, false
+	}
+	device, ok := pa.getDevice(location.GetEncryptionKeyId())
+	if !ok {
+		return nil, false
+	}
+
 	for i, deviceOffsetSectors := range pa.freeOffsets {
-		if proto.Equal(pa.getBlockLocationMessage(deviceOffsetSectors), location) {
+		if expectedLocation := pa.getBlockLocationMessage(deviceOffsetSectors); expectedLocation.OffsetBytes == location.GetOffsetBytes() && expectedLocation.SizeBytes == location.GetSizeBytes() {
 			pa.freeOffsets[i] = pa.freeOffsets[len(pa.freeOffsets)-1]
 			pa.freeOffsets = pa.freeOffsets[:len(pa.freeOffsets)-1]
 			return pa.newBlockObject(
+				device,
 				deviceOffsetSectors,
+				pa.blockSectorCount,
 				(writeOffsetBytes+int64(pa.sectorSizeBytes)-1)/int64(pa.sectorSizeBytes),
 			), true
 		}
 	}
-	return nil, false
+	return pa.newForeignBlockLocked(device, location, writeOffsetBytes)
+}
+
+// newForeignBlockLocked attempts to restore a block whose location
+// does not correspond with any of the regularly sized blocks, due to
+// the geometry of the BlockDevice having changed.
+func (pa *blockDeviceBackedBlockAllocator) newForeignBlockLocked(device sectorDevice, location *pb.BlockLocation, writeOffsetBytes int64) (Block, bool) {
+	sectorSizeBytes := int64(pa.sectorSizeBytes)
+	offsetBytes, sizeBytes := location.GetOffsetBytes(), location.GetSizeBytes()
+	if offsetBytes < 0 || offsetBytes%sectorSizeBytes != 0 || sizeBytes <= 0 || sizeBytes%sectorSizeBytes != 0 || writeOffsetBytes < 0 || writeOffsetBytes > sizeBytes {
+		return nil, false
+	}
+	deviceOffsetSectors, sizeSectors := offsetBytes/sectorSizeBytes, sizeBytes/sectorSizeBytes
+	if deviceOffsetSectors+sizeSectors > pa.blockCount*pa.blockSectorCount {
+		return nil, false
+	}
+
+	// The block may not overlap with any of the other foreign
+	// blocks that have been restored.
+	for foreignBlock := range pa.foreignBlocks {
+		if deviceOffsetSectors < foreignBlock.deviceOffsetSectors+foreignBlock.sizeSectors && foreignBlock.deviceOffsetSectors < deviceOffsetSectors+sizeSectors {
+			return nil, false
+		}
+	}
+
+	// The block may also not overlap with regularly sized blocks
+	// that are in use. Those are neither free, nor overlapping
+	// with other foreign blocks.
+	firstOffsetSectors := deviceOffsetSectors / pa.blockSectorCount * pa.blockSectorCount
+	freeOffsets := make(map[int64]int, len(pa.freeOffsets))
+	for i, freeOffsetSectors := range pa.freeOffsets {
+		freeOffsets[freeOffsetSectors] = i
+	}
+	for overlapOffsetSectors := firstOffsetSectors; overlapOffsetSectors < deviceOffsetSectors+sizeSectors; overlapOffsetSectors += pa.blockSectorCount {
+		if _, ok := freeOffsets[overlapOffsetSectors]; !ok && pa.foreignBlockOverlaps[overlapOffsetSectors] == 0 {
+			return nil, false
+		}
+	}
+
+	// Prevent the overlapping regularly sized blocks from being
+	// allocated until the foreign block is released.
+	for overlapOffsetSectors := firstOffsetSectors; overlapOffsetSectors < deviceOffsetSectors+sizeSectors; overlapOffsetSectors += pa.blockSectorCount {
+		pa.foreignBlockOverlaps[overlapOffsetSectors]++
+	}
+	newFreeOffsets := pa.freeOffsets[:0]
+	for _, freeOffsetSectors := range pa.freeOffsets {
+		if pa.foreignBlockOverlaps[freeOffsetSectors] == 0 {
+			newFreeOffsets = append(newFreeOffsets, freeOffsetSectors)
+		}
+	}
+	pa.freeOffsets = newFreeOffsets
+
+	pb := pa.newBlockObject(
+		device,
+		deviceOffsetSectors,
+		sizeSectors,
+		(writeOffsetBytes+sectorSizeBytes-1)/sectorSizeBytes)
+	pa.foreignBlocks[pb] = struct{}{}
+	return pb, true
+}
+
+// releaseLocked makes the space occupied by a block available for
+// allocation once again.
+func (pa *blockDeviceBackedBlockAllocator) releaseLocked(pb *blockDeviceBackedBlock) {
+	if _, ok := pa.foreignBlocks[pb]; !ok {
+		pa.freeOffsets = append(pa.freeOffsets, pb.deviceOffsetSectors)
+		return
+	}
+
+	// Only release the regularly sized blocks that no longer
+	// overlap with any foreign blocks.
+	delete(pa.foreignBlocks, pb)
+	firstOffsetSectors := pb.deviceOffsetSectors / pa.blockSectorCount * pa.blockSectorCount
+	for overlapOffsetSectors := firstOffsetSectors; overlapOffsetSectors < pb.deviceOffsetSectors+pb.sizeSectors; overlapOffsetSectors += pa.blockSectorCount {
+		if pa.foreignBlockOverlaps[overlapOffsetSectors]--; pa.foreignBlockOverlaps[overlapOffsetSectors] == 0 {
+			delete(pa.foreignBlockOverlaps, overlapOffsetSectors)
+			pa.freeOffsets = append(pa.freeOffsets, overlapOffsetSectors)
+		}
+	}
 }
 
 // sharedSector contains the bookkeeping of a single sector of storage
@@ -170,7 +315,9 @@ type sharedSector struct {
 type blockDeviceBackedBlock struct {
 	usecount            atomic.Int64
 	blockAllocator      *blockDeviceBackedBlockAllocator
+	device              sectorDevice
 	deviceOffsetSectors int64
+	sizeSectors         int64
 	writeOffsetSectors  int64
 	sharedSector        *sharedSector
 }
@@ -183,34 +330,44 @@ func (pb *blockDeviceBackedBlock) Release() {
 		// storage to be reused for new data.
 		pa := pb.blockAllocator
 		pa.lock.Lock()
-		pa.freeOffsets = append(pa.freeOffsets, pb.deviceOffsetSectors)
+		pa.releaseLocked(pb)
 		pa.lock.Unlock()
 		pa.blockAllocatorReleases.Inc()
 	}
 }
 
-func (pb *blockDeviceBackedBlock) Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+// newReader creates a reader for a blob stored in the block. The
+// reader holds a reference to the block, which is dropped when closed.
+func (pb *blockDeviceBackedBlock) newReader(offsetBytes, sizeBytes int64) *blockDeviceBackedBlockReader {
 	if c := pb.usecount.Add(1); c <= 1 {
 		panic(fmt.Sprintf("Get(): Block has invalid reference count %d", c))
 	}
 	pb.blockAllocator.blockAllocatorGetsStarted.Inc()
 
+	return &blockDeviceBackedBlockReader{
+		SectionReader: *io.NewSectionReader(
+			pb.device,
+			pb.deviceOffsetSectors*int64(pb.blockAllocator.sectorSizeBytes)+offsetBytes,
+			sizeBytes),
+		block: pb,
+	}
+}
+
+func (pb *blockDeviceBackedBlock) Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
 	return pb.blockAllocator.readBufferFactory.NewBufferFromReaderAt(
 		digest,
-		&blockDeviceBackedBlockReader{
-			SectionReader: *io.NewSectionReader(
-				pb.blockAllocator.blockDevice,
-				pb.deviceOffsetSectors*int64(pb.blockAllocator.sectorSizeBytes)+offsetBytes,
-				sizeBytes),
-			block: pb,
-		},
+		pb.newReader(offsetBytes, sizeBytes),
 		sizeBytes,
 		dataIntegrityCallback)
 }
 
+func (pb *blockDeviceBackedBlock) GetUnvalidated(offsetBytes, sizeBytes int64) buffer.Buffer {
+	return buffer.NewValidatedBufferFromReaderAt(pb.newReader(offsetBytes, sizeBytes), sizeBytes)
+}
+
 func (pb *blockDeviceBackedBlock) HasSpace(sizeBytes int64) bool {
 	pa := pb.blockAllocator
-	remainingSizeBytes := (pa.blockSectorCount - pb.writeOffsetSectors) * int64(pa.sectorSizeBytes)
+	remainingSizeBytes := (pb.sizeSectors - pb.writeOffsetSectors) * int64(pa.sectorSizeBytes)
 	if pb.sharedSector != nil {
 		// Don't allow overwriting the leading space of the
 		// first sector that has already been handed out to the
@@ -229,6 +386,7 @@ func (pb *blockDeviceBackedBlock) Put(sizeBytes int64) BlockPutWriter {
 	pa := pb.blockAllocator
 	w := &blockDeviceBackedBlockWriter{
 		blockAllocator: pa,
+		device:         pb.device,
 		offsetSectors:  pb.deviceOffsetSectors + pb.writeOffsetSectors,
 		firstSector:    pb.sharedSector,
 	}
@@ -298,6 +456,7 @@ func (r *blockDeviceBackedBlockReader) Close() error {
 // that had existed.
 type blockDeviceBackedBlockWriter struct {
 	blockAllocator *blockDeviceBackedBlockAllocator
+	device         sectorDevice
 
 	// Sector on the block device against which the next WriteAt()
 	// operation needs to be performed.
@@ -334,7 +493,7 @@ func (w *blockDeviceBackedBlockWriter) Write(p []byte) (int, error) {
 		}
 
 		// First sector completed.
-		_, err := pa.blockDevice.WriteAt(firstSector.data, w.offsetSectors*int64(pa.sectorSizeBytes))
+		_, err := w.device.WriteAt(firstSector.data, w.offsetSectors*int64(pa.sectorSizeBytes))
 		firstSector.lock.Unlock()
 		if err != nil {
 			return pOriginalSizeBytes - len(p), err
@@ -360,7 +519,7 @@ func (w *blockDeviceBackedBlockWriter) Write(p []byte) (int, error) {
 		}
 
 		// Partial sector completed.
-		if _, err := pa.blockDevice.WriteAt(w.partialSector, w.offsetSectors*int64(pa.sectorSizeBytes)); err != nil {
+		if _, err := w.device.WriteAt(w.partialSector, w.offsetSectors*int64(pa.sectorSizeBytes)); err != nil {
 			return pOriginalSizeBytes - len(p), err
 		}
 		w.partialSector = w.partialSector[:0]
@@ -370,7 +529,7 @@ func (w *blockDeviceBackedBlockWriter) Write(p []byte) (int, error) {
 	if alignedSize := len(p) / pa.sectorSizeBytes * pa.sectorSizeBytes; alignedSize > 0 {
 		// Write as many sectors as possible to storage directly,
 		// without copying into a partial sector.
-		nWritten, err := pa.blockDevice.WriteAt(p[:alignedSize], w.offsetSectors*int64(pa.sectorSizeBytes))
+		nWritten, err := w.device.WriteAt(p[:alignedSize], w.offsetSectors*int64(pa.sectorSizeBytes))
 		writtenSectors := nWritten / pa.sectorSizeBytes
 		writtenSizeBytes := writtenSectors * pa.sectorSizeBytes
 		p = p[writtenSizeBytes:]
@@ -403,7 +562,6 @@ func (w *blockDeviceBackedBlockWriter) flush() error {
 	// Combine trailing data with the sector that contains the start
 	// of the next object.
 	copy(lastSector.data, w.partialSector)
-	pa := w.blockAllocator
-	_, err := pa.blockDevice.WriteAt(lastSector.data, w.offsetSectors*int64(len(w.lastSector.data)))
+	_, err := w.device.WriteAt(lastSector.data, w.offsetSectors*int64(len(w.lastSector.data)))
 	return err
 }
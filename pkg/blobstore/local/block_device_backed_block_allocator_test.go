@@ -23,7 +23,7 @@ func TestBlockDeviceBackedBlockAllocator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockDevice := mock.NewMockBlockDevice(ctrl)
-	pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 1, 100, 10, "cas")
+	pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 1, 100, 10, nil, "cas")
 
 	// Based on the size of the allocator, it should be possible to
 	// create ten blocks.
@@ -145,7 +145,7 @@ func TestBlockDeviceBackedBlockAllocatorSectorSize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockDevice := mock.NewMockBlockDevice(ctrl)
-	pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 16, 100, 1, "cas")
+	pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 16, 100, 1, nil, "cas")
 
 	block, location, err := pa.NewBlock()
 	require.NoError(t, err)
@@ -190,3 +190,165 @@ func TestBlockDeviceBackedBlockAllocatorSectorSize(t *testing.T) {
 	require.True(t, block.HasSpace(1552))
 	require.False(t, block.HasSpace(1553))
 }
+
+// Blocks stored in persistent state that was written while the block
+// device had a different geometry should be restored, as long as they
+// don't overlap. The space they occupy should only become available
+// for allocation after they are released.
+func TestBlockDeviceBackedBlockAllocatorForeignGeometry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockDevice := mock.NewMockBlockDevice(ctrl)
+	pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 1, 100, 4, nil, "cas")
+
+	t.Run("Invalid", func(t *testing.T) {
+		// Blocks extending beyond the end of the block device.
+		_, found := pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 300, SizeBytes: 150}, 0)
+		require.False(t, found)
+
+		// Write offsets lying beyond the end of the block.
+		_, found = pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 0, SizeBytes: 150}, 151)
+		require.False(t, found)
+	})
+
+	// Restore two blocks of 150 bytes in size, which overlap with
+	// the first three regularly sized blocks. A block with the
+	// regular size should also be restorable at offset 300.
+	block1, found := pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 0, SizeBytes: 150}, 120)
+	require.True(t, found)
+	block2, found := pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 150, SizeBytes: 150}, 0)
+	require.True(t, found)
+	block3, found := pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 300, SizeBytes: 100}, 0)
+	require.True(t, found)
+
+	t.Run("Overlapping", func(t *testing.T) {
+		_, found := pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100}, 0)
+		require.False(t, found)
+		_, found = pa.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 250, SizeBytes: 100}, 0)
+		require.False(t, found)
+	})
+
+	// Restored blocks should respect their own size.
+	require.True(t, block1.HasSpace(30))
+	require.False(t, block1.HasSpace(31))
+	require.True(t, block2.HasSpace(150))
+	require.False(t, block2.HasSpace(151))
+
+	// All space is in use, so no blocks can be allocated.
+	_, _, err := pa.NewBlock()
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "No unused blocks available"), err)
+
+	// Releasing the first block should make the regularly sized
+	// block at offset 0 available, but not the one at offset 100,
+	// as it still overlaps with the second block.
+	block1.Release()
+	block4, location, err := pa.NewBlock()
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 100}, location)
+	_, _, err = pa.NewBlock()
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "No unused blocks available"), err)
+
+	// Releasing the second block frees up the remaining space.
+	block2.Release()
+	block5, location, err := pa.NewBlock()
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100}, location)
+	block6, location, err := pa.NewBlock()
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &pb.BlockLocation{OffsetBytes: 200, SizeBytes: 100}, location)
+	_, _, err = pa.NewBlock()
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "No unused blocks available"), err)
+
+	block3.Release()
+	block4.Release()
+	block5.Release()
+	block6.Release()
+}
+
+// Blocks should be encrypted using the current key upon allocation.
+// Blocks encrypted using older keys should remain readable, as long as
+// those keys are still provided.
+func TestBlockDeviceBackedBlockAllocatorEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Back the block device by a byte slice, so that the
+	// ciphertext can be inspected.
+	var storage [128]byte
+	blockDevice := mock.NewMockBlockDevice(ctrl)
+	blockDevice.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+		return copy(p, storage[off:]), nil
+	}).AnyTimes()
+	blockDevice.EXPECT().WriteAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+		return copy(storage[off:], p), nil
+	}).AnyTimes()
+
+	key1 := make([]byte, 32)
+	key2 := make([]byte, 64)
+	for i := range key2 {
+		key2[i] = byte(i)
+	}
+	encryptionKeys1, err := local.NewEncryptionKeys(map[uint32][]byte{1: key1}, 1)
+	require.NoError(t, err)
+	encryptionKeys2, err := local.NewEncryptionKeys(map[uint32][]byte{1: key1, 2: key2}, 2)
+	require.NoError(t, err)
+	helloDigest := digest.MustNewDigest("some-instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	worldDigest := digest.MustNewDigest("some-instance", remoteexecution.DigestFunction_MD5, "f5a7924e621e84c9280a9a27e1bcb7f6", 5)
+
+	// Store two objects in a block that is encrypted using the
+	// first key. The objects share a sector.
+	pa1 := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 16, 4, 2, encryptionKeys1, "cas")
+	block1, location1, err := pa1.NewBlock()
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &pb.BlockLocation{
+		OffsetBytes:     0,
+		SizeBytes:       64,
+		EncryptionKeyId: 1,
+	}, location1)
+	offsetBytes, err := block1.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offsetBytes)
+	offsetBytes, err = block1.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("World")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), offsetBytes)
+	require.NotContains(t, string(storage[:]), "Hello")
+	require.NotContains(t, string(storage[:]), "World")
+
+	data, err := block1.Get(worldDigest, 5, 5, buffer.Irreparable(worldDigest)).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("World"), data)
+	block1.Release()
+
+	t.Run("MissingKey", func(t *testing.T) {
+		// Without the key, the block cannot be restored.
+		pa := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 16, 4, 2, nil, "cas")
+		_, found := pa.NewBlockAtLocation(location1, 10)
+		require.False(t, found)
+	})
+
+	// After rotating to the second key, the existing block should
+	// still be readable. New blocks should use the second key.
+	pa2 := local.NewBlockDeviceBackedBlockAllocator(blockDevice, blobstore.CASReadBufferFactory, 16, 4, 2, encryptionKeys2, "cas")
+	block1, found := pa2.NewBlockAtLocation(location1, 10)
+	require.True(t, found)
+	data, err = block1.Get(helloDigest, 0, 5, buffer.Irreparable(helloDigest)).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	block2, location2, err := pa2.NewBlock()
+	require.NoError(t, err)
+	testutil.RequireEqualProto(t, &pb.BlockLocation{
+		OffsetBytes:     64,
+		SizeBytes:       64,
+		EncryptionKeyId: 2,
+	}, location2)
+	offsetBytes, err = block2.Put(5)(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offsetBytes)
+	require.NotEqual(t, storage[:16], storage[64:80])
+	data, err = block2.Get(helloDigest, 0, 5, buffer.Irreparable(helloDigest)).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	block1.Release()
+	block2.Release()
+}
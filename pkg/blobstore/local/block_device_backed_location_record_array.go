@@ -21,22 +21,59 @@ const (
 	// - Record checksum              8 bytes
 	//                        Total: 66 bytes
 	BlockDeviceBackedLocationRecordSize = 4 + 2 + sha256.Size + 4 + 8 + 8 + 8
+
+	// encryptedLocationRecordPayloadSize is the size of a serialized
+	// LocationRecord, padded to a multiple of the AES block size.
+	encryptedLocationRecordPayloadSize = (BlockDeviceBackedLocationRecordSize + 15) / 16 * 16
+
+	// EncryptedBlockDeviceBackedLocationRecordSize is the size of a
+	// single serialized LocationRecord in bytes if encryption is
+	// enabled. In that case a record consists of the identifier of
+	// the key that was used to encrypt it (4 bytes), followed by the
+	// serialized LocationRecord, padded to 80 bytes and encrypted
+	// using AES-XTS.
+	EncryptedBlockDeviceBackedLocationRecordSize = 4 + encryptedLocationRecordPayloadSize
 )
 
 type blockDeviceBackedLocationRecordArray struct {
-	device   blockdevice.BlockDevice
-	resolver BlockReferenceResolver
+	device         blockdevice.BlockDevice
+	resolver       BlockReferenceResolver
+	encryptionKeys *EncryptionKeys
 }
 
 // NewBlockDeviceBackedLocationRecordArray creates a persistent
 // LocationRecordArray. It works by using a block device as an
 // array-like structure, writing serialized LocationRecords next to each
 // other.
-func NewBlockDeviceBackedLocationRecordArray(device blockdevice.BlockDevice, resolver BlockReferenceResolver) LocationRecordArray {
+//
+// If encryptionKeys is not nil, records are encrypted using the current
+// key, using the index of the record as the tweak. Records that were
+// encrypted using keys that are no longer provided are treated as
+// being invalid.
+func NewBlockDeviceBackedLocationRecordArray(device blockdevice.BlockDevice, resolver BlockReferenceResolver, encryptionKeys *EncryptionKeys) LocationRecordArray {
 	return &blockDeviceBackedLocationRecordArray{
-		device:   device,
-		resolver: resolver,
+		device:         device,
+		resolver:       resolver,
+		encryptionKeys: encryptionKeys,
+	}
+}
+
+// GetBlockDeviceBackedLocationRecordSize returns the size of a single
+// serialized LocationRecord stored by the LocationRecordArray returned
+// by NewBlockDeviceBackedLocationRecordArray().
+func GetBlockDeviceBackedLocationRecordSize(encryptionKeys *EncryptionKeys) int64 {
+	if encryptionKeys == nil {
+		return BlockDeviceBackedLocationRecordSize
 	}
+	return EncryptedBlockDeviceBackedLocationRecordSize
+}
+
+// GetBlockDeviceBackedLocationRecordArraySize returns the number of
+// LocationRecords that can be stored on a block device of a given
+// size by the LocationRecordArray returned by
+// NewBlockDeviceBackedLocationRecordArray().
+func GetBlockDeviceBackedLocationRecordArraySize(sectorSizeBytes int, sectorCount int64, encryptionKeys *EncryptionKeys) int {
+	return int((int64(sectorSizeBytes) * sectorCount) / GetBlockDeviceBackedLocationRecordSize(encryptionKeys))
 }
 
 // computeChecksumForRecord computes an FNV-1a hash of all the fields in
@@ -52,8 +89,25 @@ func computeChecksumForRecord(record *[BlockDeviceBackedLocationRecordSize]byte,
 
 func (lra *blockDeviceBackedLocationRecordArray) Get(index int) (LocationRecord, error) {
 	var record [BlockDeviceBackedLocationRecordSize]byte
-	if _, err := lra.device.ReadAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize); err != nil {
-		return LocationRecord{}, err
+	if lra.encryptionKeys == nil {
+		if _, err := lra.device.ReadAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize); err != nil {
+			return LocationRecord{}, err
+		}
+	} else {
+		var encryptedRecord [EncryptedBlockDeviceBackedLocationRecordSize]byte
+		if _, err := lra.device.ReadAt(encryptedRecord[:], int64(index)*EncryptedBlockDeviceBackedLocationRecordSize); err != nil {
+			return LocationRecord{}, err
+		}
+
+		// Records that have never been written have key ID
+		// zero, which is never valid.
+		cipher, ok := lra.encryptionKeys.getCipher(binary.LittleEndian.Uint32(encryptedRecord[:]))
+		if !ok {
+			return LocationRecord{}, ErrLocationRecordInvalid
+		}
+		var payload [encryptedLocationRecordPayloadSize]byte
+		cipher.Decrypt(payload[:], encryptedRecord[4:], uint64(index))
+		copy(record[:], payload[:])
 	}
 
 	// Reobtain the index of the block in the BlockList. This may
@@ -102,6 +156,17 @@ func (lra *blockDeviceBackedLocationRecordArray) Put(index int, locationRecord L
 	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8:], uint64(locationRecord.Location.SizeBytes))
 	binary.LittleEndian.PutUint64(record[4+2+sha256.Size+4+8+8:], computeChecksumForRecord(&record, hashSeed))
 
-	_, err := lra.device.WriteAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize)
+	if lra.encryptionKeys == nil {
+		_, err := lra.device.WriteAt(record[:], int64(index)*BlockDeviceBackedLocationRecordSize)
+		return err
+	}
+
+	keyID, cipher := lra.encryptionKeys.getCurrentCipher()
+	var payload [encryptedLocationRecordPayloadSize]byte
+	copy(payload[:], record[:])
+	var encryptedRecord [EncryptedBlockDeviceBackedLocationRecordSize]byte
+	binary.LittleEndian.PutUint32(encryptedRecord[:], keyID)
+	cipher.Encrypt(encryptedRecord[4:], payload[:], uint64(index))
+	_, err := lra.device.WriteAt(encryptedRecord[:], int64(index)*EncryptedBlockDeviceBackedLocationRecordSize)
 	return err
 }
@@ -57,7 +57,7 @@ func TestBlockDeviceBackedLocationRecordArrayGet(t *testing.T) {
 
 	blockDevice := mock.NewMockBlockDevice(ctrl)
 	blockIndexResolver := mock.NewMockBlockReferenceResolver(ctrl)
-	lra := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver)
+	lra := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver, nil)
 
 	t.Run("IOError", func(t *testing.T) {
 		// I/O errors should be propagated.
@@ -120,7 +120,7 @@ func TestBlockDeviceBackedLocationRecordArrayPut(t *testing.T) {
 
 	blockDevice := mock.NewMockBlockDevice(ctrl)
 	blockIndexResolver := mock.NewMockBlockReferenceResolver(ctrl)
-	lra := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver)
+	lra := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver, nil)
 
 	blockIndexResolver.EXPECT().BlockIndexToBlockReference(12).Return(local.BlockReference{
 		EpochID:        851212842,
@@ -148,3 +148,57 @@ func TestBlockDeviceBackedLocationRecordArrayPut(t *testing.T) {
 			lra.Put(100, exampleBlockDeviceBackedLocationRecord))
 	})
 }
+
+func TestBlockDeviceBackedLocationRecordArrayEncryption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var storage [200 * local.EncryptedBlockDeviceBackedLocationRecordSize]byte
+	blockDevice := mock.NewMockBlockDevice(ctrl)
+	blockDevice.EXPECT().ReadAt(gomock.Len(local.EncryptedBlockDeviceBackedLocationRecordSize), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+		return copy(p, storage[off:]), nil
+	}).AnyTimes()
+	blockDevice.EXPECT().WriteAt(gomock.Len(local.EncryptedBlockDeviceBackedLocationRecordSize), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+		return copy(storage[off:], p), nil
+	}).AnyTimes()
+	blockIndexResolver := mock.NewMockBlockReferenceResolver(ctrl)
+	blockIndexResolver.EXPECT().BlockIndexToBlockReference(12).Return(local.BlockReference{
+		EpochID:        851212842,
+		BlocksFromLast: 9271,
+	}, uint64(90384039284213)).AnyTimes()
+	blockIndexResolver.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+		EpochID:        851212842,
+		BlocksFromLast: 9271,
+	}).Return(12, uint64(90384039284213), true).AnyTimes()
+
+	encryptionKeys1, err := local.NewEncryptionKeys(map[uint32][]byte{7: make([]byte, 64)}, 7)
+	require.NoError(t, err)
+	encryptionKeys2, err := local.NewEncryptionKeys(map[uint32][]byte{8: make([]byte, 32)}, 8)
+	require.NoError(t, err)
+	lra1 := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver, encryptionKeys1)
+	lra2 := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockIndexResolver, encryptionKeys2)
+
+	t.Run("Empty", func(t *testing.T) {
+		// Records that have never been written should be
+		// treated as invalid.
+		_, err := lra1.Get(100)
+		require.Equal(t, local.ErrLocationRecordInvalid, err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Records should be stored in encrypted form, and be
+		// readable afterwards.
+		require.NoError(t, lra1.Put(100, exampleBlockDeviceBackedLocationRecord))
+		require.NotContains(t, string(storage[:]), string(exampleBlockDeviceBackedLocationRecord.RecordKey.Key[:8]))
+
+		record, err := lra1.Get(100)
+		require.NoError(t, err)
+		require.Equal(t, exampleBlockDeviceBackedLocationRecord, record)
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		// Records encrypted using a key that is not provided
+		// should be treated as invalid.
+		_, err := lra2.Get(100)
+		require.Equal(t, local.ErrLocationRecordInvalid, err)
+	})
+}
@@ -0,0 +1,200 @@
+package local
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// rehashRecordSize is the size of a record stored in the file
+	// that is used by RehashBlockDeviceBackedLocationRecords(),
+	// consisting of a key, followed by a block index, offset and
+	// size.
+	rehashRecordSize = len(Key{}) + 3*8
+	// rehashBatchSize is the number of records that are read and
+	// written at once when rehashing, bounding memory usage.
+	rehashBatchSize = 16384
+)
+
+var (
+	componentKeyLocationMapRehash    = path.MustNewComponent("key_location_map_rehash")
+	componentKeyLocationMapRehashNew = path.MustNewComponent("key_location_map_rehash.new")
+)
+
+// RehashBlockDeviceBackedLocationRecords can be used at startup to
+// migrate the records of a key-location map stored on a block device
+// to a new size. As HashingKeyLocationMap determines the slot of a
+// record by taking its hash modulo the number of records, records
+// would otherwise become inaccessible.
+//
+// All valid records in the first deviceRecordsCount slots of the block
+// device are copied to a file named "key_location_map_rehash" in the
+// provided directory. The slots are then cleared, after which all
+// records are reinserted into keyLocationMap. The number of records
+// that was reinserted is returned. Records stored beyond the end of
+// the block device are lost if the block device has shrunk.
+//
+// The file is only created after all records have been copied into it
+// and synchronized to disk. If the process is interrupted before then,
+// the block device has not been modified yet. If the process is
+// interrupted afterwards, calling this function again uses the
+// existing file, as the block device may have been partially cleared.
+// The caller must call RemoveBlockDeviceBackedLocationRecordsRehashFile()
+// after the new size of the key-location map has been persisted.
+func RehashBlockDeviceBackedLocationRecords(directory filesystem.Directory, device blockdevice.BlockDevice, resolver BlockReferenceResolver, encryptionKeys *EncryptionKeys, deviceRecordsCount int, keyLocationMap KeyLocationMap) (int, error) {
+	f, err := directory.OpenRead(componentKeyLocationMapRehash)
+	if os.IsNotExist(err) {
+		if err := saveBlockDeviceBackedLocationRecords(directory, device, resolver, encryptionKeys, deviceRecordsCount); err != nil {
+			return 0, err
+		}
+		f, err = directory.OpenRead(componentKeyLocationMapRehash)
+	}
+	if err != nil {
+		return 0, util.StatusWrapWithCode(err, codes.Internal, "Failed to open rehash file")
+	}
+	defer f.Close()
+
+	// Clear all slots, so that no stale copies of records remain.
+	zeroes := make([]byte, 1<<20)
+	sizeBytes := int64(deviceRecordsCount) * GetBlockDeviceBackedLocationRecordSize(encryptionKeys)
+	for offsetBytes := int64(0); offsetBytes < sizeBytes; offsetBytes += int64(len(zeroes)) {
+		chunk := zeroes
+		if remaining := sizeBytes - offsetBytes; remaining < int64(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+		if _, err := device.WriteAt(chunk, offsetBytes); err != nil {
+			return 0, util.StatusWrapf(err, "Failed to clear records at offset %d", offsetBytes)
+		}
+	}
+
+	// Reinsert all records, letting the key-location map
+	// determine their new slots.
+	recordsCount := 0
+	batch := make([]byte, rehashBatchSize*rehashRecordSize)
+	for offsetBytes := int64(0); ; offsetBytes += int64(len(batch)) {
+		n, err := f.ReadAt(batch, offsetBytes)
+		if err != nil && err != io.EOF {
+			return 0, util.StatusWrapWithCode(err, codes.Internal, "Failed to read from rehash file")
+		}
+		if n%rehashRecordSize != 0 {
+			return 0, status.Errorf(codes.Internal, "Rehash file has a size that is not a multiple of %d bytes", rehashRecordSize)
+		}
+		for data := batch[:n]; len(data) > 0; data = data[rehashRecordSize:] {
+			var key Key
+			copy(key[:], data)
+			location := Location{
+				BlockIndex:  int(int64(binary.LittleEndian.Uint64(data[len(key):]))),
+				OffsetBytes: int64(binary.LittleEndian.Uint64(data[len(key)+8:])),
+				SizeBytes:   int64(binary.LittleEndian.Uint64(data[len(key)+16:])),
+			}
+			if err := keyLocationMap.Put(key, location); err != nil {
+				return 0, util.StatusWrap(err, "Failed to reinsert record")
+			}
+			recordsCount++
+		}
+		if err == io.EOF {
+			return recordsCount, nil
+		}
+	}
+}
+
+// saveBlockDeviceBackedLocationRecords writes all valid records stored
+// on a block device into the file used by
+// RehashBlockDeviceBackedLocationRecords(). The file is written under a
+// temporary name, and only renamed once fully synchronized.
+func saveBlockDeviceBackedLocationRecords(directory filesystem.Directory, device blockdevice.BlockDevice, resolver BlockReferenceResolver, encryptionKeys *EncryptionKeys, deviceRecordsCount int) error {
+	if err := directory.Remove(componentKeyLocationMapRehashNew); err != nil && !os.IsNotExist(err) {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary rehash file")
+	}
+	f, err := directory.OpenAppend(componentKeyLocationMapRehashNew, filesystem.CreateExcl(0o666))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary rehash file")
+	}
+
+	// Load all valid records. Records are protected by checksums,
+	// meaning it is safe to also consider slots that were not in
+	// use by the previous key-location map.
+	recordArray := NewBlockDeviceBackedLocationRecordArray(device, resolver, encryptionKeys)
+	batch := make([]byte, 0, rehashBatchSize*rehashRecordSize)
+	for slot := 0; slot < deviceRecordsCount; slot++ {
+		record, err := recordArray.Get(slot)
+		if err == ErrLocationRecordInvalid {
+			continue
+		} else if err != nil {
+			f.Close()
+			return util.StatusWrapf(err, "Failed to read record in slot %d", slot)
+		}
+		batch = append(batch, record.RecordKey.Key[:]...)
+		batch = binary.LittleEndian.AppendUint64(batch, uint64(record.Location.BlockIndex))
+		batch = binary.LittleEndian.AppendUint64(batch, uint64(record.Location.OffsetBytes))
+		batch = binary.LittleEndian.AppendUint64(batch, uint64(record.Location.SizeBytes))
+		if len(batch) == cap(batch) {
+			if _, err := f.Write(batch); err != nil {
+				f.Close()
+				return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary rehash file")
+			}
+			batch = batch[:0]
+		}
+	}
+	if _, err := f.Write(batch); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to temporary rehash file")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize temporary rehash file")
+	}
+	if err := f.Close(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to close temporary rehash file")
+	}
+
+	if err := directory.Rename(componentKeyLocationMapRehashNew, directory, componentKeyLocationMapRehash); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary rehash file")
+	}
+	if err := directory.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+	}
+	return nil
+}
+
+// HasBlockDeviceBackedLocationRecordsRehashFile returns whether the
+// file created by RehashBlockDeviceBackedLocationRecords() is present.
+// If so, a previous invocation was interrupted, and needs to be
+// repeated before the key-location map can be used.
+func HasBlockDeviceBackedLocationRecordsRehashFile(directory filesystem.Directory) (bool, error) {
+	f, err := directory.OpenRead(componentKeyLocationMapRehash)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, util.StatusWrapWithCode(err, codes.Internal, "Failed to open rehash file")
+	}
+	f.Close()
+	return true, nil
+}
+
+// RemoveBlockDeviceBackedLocationRecordsRehashFile removes the file
+// that is created by RehashBlockDeviceBackedLocationRecords(). It
+// should be called after the new size of the key-location map has been
+// persisted, or at startup if no rehashing is needed, so that a file
+// left behind by an interrupted run is not used by future migrations.
+func RemoveBlockDeviceBackedLocationRecordsRehashFile(directory filesystem.Directory) error {
+	if err := directory.Remove(componentKeyLocationMapRehash); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove rehash file")
+	}
+	if err := directory.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package local_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRehashBlockDeviceBackedLocationRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	const deviceRecordsCount = 100
+	device, _, _, err := blockdevice.NewBlockDeviceFromFile(filepath.Join(t.TempDir(), "key_location_map"), deviceRecordsCount*local.BlockDeviceBackedLocationRecordSize, true)
+	require.NoError(t, err)
+	directory, err := filesystem.NewLocalDirectory(path.NewLocalParser(t.TempDir()))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	// Let all records refer to a single block.
+	resolver := mock.NewMockBlockReferenceResolver(ctrl)
+	resolver.EXPECT().BlockIndexToBlockReference(0).
+		Return(local.BlockReference{EpochID: 3}, uint64(0x2b5cba3e95ac7f44)).AnyTimes()
+	resolver.EXPECT().BlockReferenceToBlockIndex(gomock.Any()).
+		DoAndReturn(func(blockReference local.BlockReference) (int, uint64, bool) {
+			if blockReference == (local.BlockReference{EpochID: 3}) {
+				return 0, 0x2b5cba3e95ac7f44, true
+			}
+			return 0, 0, false
+		}).AnyTimes()
+	recordArray := local.NewBlockDeviceBackedLocationRecordArray(device, resolver, nil)
+
+	// Populate a key-location map with 31 records.
+	oldKeyLocationMap := local.NewHashingKeyLocationMap(recordArray, 31, 0x6f4ccb9ea1ae6e7a, 8, 32, "cas")
+	keys := []local.Key{
+		local.NewKeyFromString("a"),
+		local.NewKeyFromString("b"),
+		local.NewKeyFromString("c"),
+		local.NewKeyFromString("d"),
+		local.NewKeyFromString("e"),
+	}
+	for i, key := range keys {
+		require.NoError(t, oldKeyLocationMap.Put(key, local.Location{
+			BlockIndex:  0,
+			OffsetBytes: int64(i) * 10,
+			SizeBytes:   10,
+		}))
+	}
+
+	// Grow the key-location map to 97 records. All records should
+	// be accessible afterwards.
+	newKeyLocationMap := local.NewHashingKeyLocationMap(recordArray, 97, 0x6f4ccb9ea1ae6e7a, 8, 32, "cas")
+	migratedRecords, err := local.RehashBlockDeviceBackedLocationRecords(directory, device, resolver, nil, deviceRecordsCount, newKeyLocationMap)
+	require.NoError(t, err)
+	require.Equal(t, len(keys), migratedRecords)
+
+	for i, key := range keys {
+		location, err := newKeyLocationMap.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, local.Location{
+			BlockIndex:  0,
+			OffsetBytes: int64(i) * 10,
+			SizeBytes:   10,
+		}, location)
+	}
+
+	// No stale copies of records should remain.
+	validRecords := 0
+	for slot := 0; slot < deviceRecordsCount; slot++ {
+		if _, err := recordArray.Get(slot); err == nil {
+			validRecords++
+		}
+	}
+	require.Equal(t, len(keys), validRecords)
+
+	// The records should have been saved in a file, which is
+	// retained until explicitly removed.
+	rehashInterrupted, err := local.HasBlockDeviceBackedLocationRecordsRehashFile(directory)
+	require.NoError(t, err)
+	require.True(t, rehashInterrupted)
+
+	// If the process is interrupted after slots have been
+	// cleared, rehashing again should restore all records from
+	// the saved file.
+	_, err = device.WriteAt(make([]byte, deviceRecordsCount*local.BlockDeviceBackedLocationRecordSize), 0)
+	require.NoError(t, err)
+	migratedRecords, err = local.RehashBlockDeviceBackedLocationRecords(directory, device, resolver, nil, deviceRecordsCount, newKeyLocationMap)
+	require.NoError(t, err)
+	require.Equal(t, len(keys), migratedRecords)
+	for _, key := range keys {
+		_, err := newKeyLocationMap.Get(key)
+		require.NoError(t, err)
+	}
+
+	// Once the new size has been persisted, the file can be
+	// removed. Removing it again should be a no-op.
+	require.NoError(t, local.RemoveBlockDeviceBackedLocationRecordsRehashFile(directory))
+	require.NoError(t, local.RemoveBlockDeviceBackedLocationRecordsRehashFile(directory))
+	rehashInterrupted, err = local.HasBlockDeviceBackedLocationRecordsRehashFile(directory)
+	require.NoError(t, err)
+	require.False(t, rehashInterrupted)
+}
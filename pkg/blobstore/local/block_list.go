@@ -27,8 +27,9 @@ type BlockListPutFinalizer = BlockPutFinalizer
 // space in the block is consumed.
 //
 // BlockList is only partially thread-safe. The BlockReferenceResolver
-// methods and BlockList.Get() can be invoked in parallel (e.g., under a
-// read lock), while BlockList.PopFront(), BlockList.PushBack(),
+// methods, BlockList.Get() and BlockList.GetUnvalidated() can be
+// invoked in parallel (e.g., under a read lock), while
+// BlockList.PopFront(), BlockList.PushBack(),
 // BlockList.HasSpace(), BlockList.Put() and BlockListPutFinalizer must
 // run exclusively (e.g., under a write lock). BlockListPutWriter is
 // safe to call without holding any locks.
@@ -45,6 +46,11 @@ type BlockList interface {
 	// Get a blob from a given block in the BlockList.
 	Get(blockIndex int, digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer
 
+	// GetUnvalidated obtains the data of a blob from a given block
+	// in the BlockList, without performing any checksum validation
+	// or decompression.
+	GetUnvalidated(blockIndex int, offsetBytes, sizeBytes int64) buffer.Buffer
+
 	// HasSpace returns whether a given block in the BlockList is
 	// capable of storing an additional blob of a given size.
 	HasSpace(blockIndex int, sizeBytes int64) bool
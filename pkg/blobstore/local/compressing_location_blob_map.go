@@ -0,0 +1,161 @@
+package local
+
+import (
+	"io"
+	"sync"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// compressingLocationBlobMapChunkSizeBytes is the size of the chunks
+// of data that are fed into the compressor.
+const compressingLocationBlobMapChunkSizeBytes = 64 * 1024
+
+type compressingLocationBlobMap struct {
+	LocationBlobMap
+	lock       *sync.RWMutex
+	compressor remoteexecution.Compressor_Value
+}
+
+// NewCompressingLocationBlobMap creates a decorator for LocationBlobMap
+// that compresses blobs before they are written to storage. Locations
+// returned by this LocationBlobMap refer to the compressed data,
+// meaning that the underlying LocationBlobMap needs to be backed by
+// blocks that decompress data when read. This can be achieved by
+// creating these blocks with a ReadBufferFactory that is obtained
+// through blobstore.NewDecompressingReadBufferFactory().
+//
+// As the size of the compressed data is only known after compression
+// has completed, allocation of space in the underlying LocationBlobMap
+// is deferred until LocationBlobPutWriter is called. Blobs are
+// compressed in memory, and the provided lock is acquired exclusively
+// to perform the allocation. Offsets of slices of blobs don't
+// correspond to offsets within the compressed data, meaning that this
+// decorator should not be used in combination with
+// BlobAccess.GetFromComposite().
+//
+// Blobs for which compression does not yield any savings are stored
+// without being compressed, using the framing of the compression
+// algorithm. This means that the amount of space consumed by a blob
+// never exceeds its original size by more than a few bytes per block
+// of the compression algorithm. The amount of memory used during
+// compression is bounded by roughly twice the size of the blob.
+func NewCompressingLocationBlobMap(base LocationBlobMap, lock *sync.RWMutex, compressor remoteexecution.Compressor_Value) LocationBlobMap {
+	return &compressingLocationBlobMap{
+		LocationBlobMap: base,
+		lock:            lock,
+		compressor:      compressor,
+	}
+}
+
+func (lbm *compressingLocationBlobMap) compress(b buffer.Buffer, sizeBytes int64) ([]byte, error) {
+	// Retain the original data, so that it can be stored as is if
+	// compression turns out to be ineffective.
+	data, err := b.ToByteSlice(int(sizeBytes))
+	if err != nil {
+		return nil, err
+	}
+	r, err := compression.NewCompressingChunkReader(
+		buffer.NewValidatedBufferFromByteSlice(data).ToChunkReader(0, compressingLocationBlobMapChunkSizeBytes),
+		lbm.compressor,
+		compressingLocationBlobMapChunkSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var compressedData []byte
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			return compressedData, nil
+		} else if err != nil {
+			return nil, err
+		}
+		compressedData = append(compressedData, chunk...)
+		if len(compressedData) >= len(data) {
+			// Compression does not reduce the size of the
+			// data. Stop compressing and store it as is.
+			return compression.AppendUncompressedStream(compressedData[:0], data, lbm.compressor)
+		}
+	}
+}
+
+func (lbm *compressingLocationBlobMap) GetUnvalidated(location Location) buffer.ChunkReader {
+	return &decompressingChunkReader{
+		compressedReader: lbm.LocationBlobMap.GetUnvalidated(location),
+		compressor:       lbm.compressor,
+	}
+}
+
+func (lbm *compressingLocationBlobMap) Put(sizeBytes int64) (LocationBlobPutWriter, error) {
+	return func(b buffer.Buffer) LocationBlobPutFinalizer {
+		compressedData, err := lbm.compress(b, sizeBytes)
+		if err != nil {
+			return func() (Location, error) {
+				return Location{}, util.StatusWrap(err, "Failed to compress blob")
+			}
+		}
+
+		lbm.lock.Lock()
+		putWriter, err := lbm.LocationBlobMap.Put(int64(len(compressedData)))
+		lbm.lock.Unlock()
+		if err != nil {
+			return func() (Location, error) {
+				return Location{}, err
+			}
+		}
+		return putWriter(buffer.NewValidatedBufferFromByteSlice(compressedData))
+	}, nil
+}
+
+// decompressingChunkReader is returned by
+// compressingLocationBlobMap.GetUnvalidated(). It decompresses the data
+// returned by the underlying LocationBlobMap. The decompressor is only
+// created when data is read, so that callers may obtain many readers
+// at once without allocating decompression state for each of them.
+type decompressingChunkReader struct {
+	compressedReader buffer.ChunkReader
+	compressor       remoteexecution.Compressor_Value
+	r                io.ReadCloser
+	err              error
+}
+
+func (r *decompressingChunkReader) Read() ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.r == nil {
+		// NewDecompressingReader() closes the underlying
+		// reader upon failure.
+		decompressedReader, err := compression.NewDecompressingReader(r.compressedReader, r.compressor)
+		r.compressedReader = nil
+		if err != nil {
+			r.err = err
+			return nil, err
+		}
+		r.r = decompressedReader
+	}
+
+	chunk := make([]byte, compressingLocationBlobMapChunkSizeBytes)
+	for {
+		n, err := r.r.Read(chunk)
+		if n > 0 {
+			return chunk[:n], nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *decompressingChunkReader) Close() {
+	if r.r != nil {
+		r.r.Close()
+	} else if r.compressedReader != nil {
+		r.compressedReader.Close()
+	}
+}
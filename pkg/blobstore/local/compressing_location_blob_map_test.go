@@ -0,0 +1,120 @@
+package local_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/compression"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompressingLocationBlobMap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseLocationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+	var lock sync.RWMutex
+	locationBlobMap := local.NewCompressingLocationBlobMap(baseLocationBlobMap, &lock, remoteexecution.Compressor_ZSTD)
+	data := bytes.Repeat([]byte("Hello world "), 1000)
+
+	t.Run("Success", func(t *testing.T) {
+		// Allocation of space in the underlying LocationBlobMap
+		// should be deferred until the size of the compressed
+		// data is known.
+		putWriter, err := locationBlobMap.Put(int64(len(data)))
+		require.NoError(t, err)
+
+		var compressedData []byte
+		baseLocationBlobMap.EXPECT().Put(gomock.Any()).DoAndReturn(func(sizeBytes int64) (local.LocationBlobPutWriter, error) {
+			require.Less(t, sizeBytes, int64(len(data)))
+			return func(b buffer.Buffer) local.LocationBlobPutFinalizer {
+				var err error
+				compressedData, err = b.ToByteSlice(int(sizeBytes))
+				require.NoError(t, err)
+				require.Len(t, compressedData, int(sizeBytes))
+				return func() (local.Location, error) {
+					return local.Location{BlockIndex: 3, OffsetBytes: 100, SizeBytes: sizeBytes}, nil
+				}
+			}, nil
+		})
+
+		location, err := putWriter(buffer.NewValidatedBufferFromByteSlice(data))()
+		require.NoError(t, err)
+		require.Equal(t, local.Location{BlockIndex: 3, OffsetBytes: 100, SizeBytes: int64(len(compressedData))}, location)
+
+		// The data that was written should be decompressible.
+		r, err := compression.NewDecompressingReader(
+			buffer.NewValidatedBufferFromByteSlice(compressedData).ToChunkReader(0, 1000),
+			remoteexecution.Compressor_ZSTD)
+		require.NoError(t, err)
+		decompressedData, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, data, decompressedData)
+	})
+
+	t.Run("Incompressible", func(t *testing.T) {
+		// Data that does not compress should be stored as is,
+		// using the framing of the compression algorithm. It
+		// should remain readable through the decompressor.
+		incompressibleData := make([]byte, 200000)
+		rand.New(rand.NewSource(1)).Read(incompressibleData)
+		putWriter, err := locationBlobMap.Put(int64(len(incompressibleData)))
+		require.NoError(t, err)
+
+		var storedData []byte
+		baseLocationBlobMap.EXPECT().Put(int64(len(incompressibleData)) + 12).DoAndReturn(func(sizeBytes int64) (local.LocationBlobPutWriter, error) {
+			return func(b buffer.Buffer) local.LocationBlobPutFinalizer {
+				var err error
+				storedData, err = b.ToByteSlice(int(sizeBytes))
+				require.NoError(t, err)
+				return func() (local.Location, error) {
+					return local.Location{BlockIndex: 3, OffsetBytes: 100, SizeBytes: sizeBytes}, nil
+				}
+			}, nil
+		})
+
+		_, err = putWriter(buffer.NewValidatedBufferFromByteSlice(incompressibleData))()
+		require.NoError(t, err)
+
+		r, err := compression.NewDecompressingReader(
+			buffer.NewValidatedBufferFromByteSlice(storedData).ToChunkReader(0, 1000),
+			remoteexecution.Compressor_ZSTD)
+		require.NoError(t, err)
+		decompressedData, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, incompressibleData, decompressedData)
+	})
+
+	t.Run("ReadFailure", func(t *testing.T) {
+		// Errors reading the data to be stored should be
+		// propagated. No space should be allocated.
+		putWriter, err := locationBlobMap.Put(int64(len(data)))
+		require.NoError(t, err)
+
+		_, err = putWriter(buffer.NewBufferFromError(status.Error(codes.Internal, "Disk on fire")))()
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to compress blob: Disk on fire"), err)
+	})
+
+	t.Run("AllocationFailure", func(t *testing.T) {
+		putWriter, err := locationBlobMap.Put(int64(len(data)))
+		require.NoError(t, err)
+
+		baseLocationBlobMap.EXPECT().Put(gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "Blob is too large"))
+		_, err = putWriter(buffer.NewValidatedBufferFromByteSlice(data))()
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Blob is too large"), err)
+	})
+}
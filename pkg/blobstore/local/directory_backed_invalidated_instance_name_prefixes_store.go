@@ -0,0 +1,61 @@
+package local
+
+import (
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	componentInvalidatedInstanceNamePrefixes    = path.MustNewComponent("invalidated_instance_name_prefixes")
+	componentInvalidatedInstanceNamePrefixesNew = path.MustNewComponent("invalidated_instance_name_prefixes.new")
+)
+
+type directoryBackedInvalidatedInstanceNamePrefixesStore struct {
+	directory filesystem.Directory
+}
+
+// NewDirectoryBackedInvalidatedInstanceNamePrefixesStore creates an
+// InvalidatedInstanceNamePrefixesStore that writes
+// InvalidatedInstanceNamePrefixes Protobuf messages to a file named
+// "invalidated_instance_name_prefixes" stored inside a
+// filesystem.Directory.
+func NewDirectoryBackedInvalidatedInstanceNamePrefixesStore(directory filesystem.Directory) InvalidatedInstanceNamePrefixesStore {
+	return directoryBackedInvalidatedInstanceNamePrefixesStore{
+		directory: directory,
+	}
+}
+
+func (s directoryBackedInvalidatedInstanceNamePrefixesStore) ReadInvalidatedInstanceNamePrefixes() (*pb.InvalidatedInstanceNamePrefixes, error) {
+	data, err := readFile(s.directory, componentInvalidatedInstanceNamePrefixes)
+	if os.IsNotExist(err) {
+		// No instance name prefixes have been invalidated.
+		return &pb.InvalidatedInstanceNamePrefixes{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike the persistent state, this file cannot be discarded
+	// if it is corrupted, as that would cause invalidated objects
+	// to become accessible again.
+	var invalidatedPrefixes pb.InvalidatedInstanceNamePrefixes
+	if err := proto.Unmarshal(data, &invalidatedPrefixes); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal data")
+	}
+	return &invalidatedPrefixes, nil
+}
+
+func (s directoryBackedInvalidatedInstanceNamePrefixesStore) WriteInvalidatedInstanceNamePrefixes(invalidatedPrefixes *pb.InvalidatedInstanceNamePrefixes) error {
+	data, err := proto.Marshal(invalidatedPrefixes)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal data")
+	}
+	return writeFileAtomically(s.directory, componentInvalidatedInstanceNamePrefixesNew, componentInvalidatedInstanceNamePrefixes, data)
+}
@@ -0,0 +1,86 @@
+package local_test
+
+import (
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDirectoryBackedInvalidatedInstanceNamePrefixesStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	directory := mock.NewMockDirectory(ctrl)
+	store := local.NewDirectoryBackedInvalidatedInstanceNamePrefixesStore(directory)
+
+	exampleInvalidatedPrefixes := pb.InvalidatedInstanceNamePrefixes{
+		Generations: map[string]uint64{
+			"hello/world": 3,
+		},
+	}
+	exampleInvalidatedPrefixesBytes, err := proto.Marshal(&exampleInvalidatedPrefixes)
+	require.NoError(t, err)
+
+	t.Run("ReadNotFound", func(t *testing.T) {
+		// If no instance name prefixes have been invalidated,
+		// the file does not exist.
+		directory.EXPECT().OpenRead(path.MustNewComponent("invalidated_instance_name_prefixes")).Return(nil, syscall.ENOENT)
+
+		invalidatedPrefixes, err := store.ReadInvalidatedInstanceNamePrefixes()
+		require.NoError(t, err)
+		require.Empty(t, invalidatedPrefixes.Generations)
+	})
+
+	t.Run("ReadCorrupted", func(t *testing.T) {
+		// Corrupted files must not be discarded, as that would
+		// cause invalidated objects to become accessible again.
+		f := mock.NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("invalidated_instance_name_prefixes")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "This is not a valid protobuf"), io.EOF
+		})
+		f.EXPECT().Close()
+
+		_, err := store.ReadInvalidatedInstanceNamePrefixes()
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("ReadSuccess", func(t *testing.T) {
+		f := mock.NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("invalidated_instance_name_prefixes")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, exampleInvalidatedPrefixesBytes), io.EOF
+		})
+		f.EXPECT().Close()
+
+		invalidatedPrefixes, err := store.ReadInvalidatedInstanceNamePrefixes()
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &exampleInvalidatedPrefixes, invalidatedPrefixes)
+	})
+
+	t.Run("WriteSuccess", func(t *testing.T) {
+		directory.EXPECT().Remove(path.MustNewComponent("invalidated_instance_name_prefixes.new"))
+		f := mock.NewMockFileAppender(ctrl)
+		directory.EXPECT().OpenAppend(path.MustNewComponent("invalidated_instance_name_prefixes.new"), filesystem.CreateExcl(0o666)).Return(f, nil)
+		f.EXPECT().Write(exampleInvalidatedPrefixesBytes).Return(len(exampleInvalidatedPrefixesBytes), nil)
+		f.EXPECT().Sync()
+		f.EXPECT().Close()
+		directory.EXPECT().Rename(path.MustNewComponent("invalidated_instance_name_prefixes.new"), directory, path.MustNewComponent("invalidated_instance_name_prefixes"))
+		directory.EXPECT().Sync()
+
+		require.NoError(t, store.WriteInvalidatedInstanceNamePrefixes(&exampleInvalidatedPrefixes))
+	})
+}
@@ -0,0 +1,273 @@
+package local
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// journalSegmentPrefix is the prefix of the names of files in which
+// segments of the journal are stored. The prefix is followed by the
+// identifier of the segment.
+const journalSegmentPrefix = "journal."
+
+var journalChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+type directoryBackedJournal struct {
+	directory   filesystem.Directory
+	dataSyncer  DataSyncer
+	errorLogger util.ErrorLogger
+
+	// Records that have been appended, but not written yet.
+	lock             sync.Mutex
+	pendingRecords   []byte
+	pendingSegmentID uint64
+	firstSegmentID   uint64
+
+	// The segment that is currently opened for writing. If an
+	// error occurs while writing records, the journal can no
+	// longer be used, as the segment may contain partially written
+	// records.
+	syncLock      sync.Mutex
+	file          filesystem.FileAppender
+	fileSegmentID uint64
+	err           error
+}
+
+// NewDirectoryBackedJournal creates a Journal that stores segments as
+// files named "journal.${id}" inside a filesystem.Directory. Records in
+// these files are prefixed with their size and followed by a CRC-32C
+// checksum, so that records that are only partially written due to a
+// crash can be detected.
+//
+// The records contained in segments that were written previously are
+// returned, so that they may be replayed using ReplayJournal(). These
+// segments are retained until RemoveSegmentsBefore() is called. As
+// PersistentBlockList starts a new segment upon creation, the returned
+// Journal will not write any records prior to StartSegment() being
+// called.
+func NewDirectoryBackedJournal(directory filesystem.Directory, dataSyncer DataSyncer, errorLogger util.ErrorLogger) (Journal, []*pb.JournalRecord, error) {
+	segmentIDs, err := getJournalSegmentIDs(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []*pb.JournalRecord
+	for i, segmentID := range segmentIDs {
+		data, err := readFile(directory, getJournalSegmentName(segmentID))
+		if err != nil {
+			return nil, nil, util.StatusWrapf(err, "Failed to read journal segment %d", segmentID)
+		}
+		segmentRecords, complete := parseJournalSegment(data)
+		records = append(records, segmentRecords...)
+		if !complete {
+			// Records following a corrupted record cannot be
+			// replayed, as they may depend on it.
+			log.Printf("Journal segment %d contains a partially written record, ignoring %d successive segments", segmentID, len(segmentIDs)-i-1)
+			break
+		}
+	}
+
+	// Never reuse the identifiers of existing segments, as new
+	// segments are created exclusively.
+	firstSegmentID, lastSegmentID := uint64(1), uint64(0)
+	if len(segmentIDs) > 0 {
+		firstSegmentID, lastSegmentID = segmentIDs[0], segmentIDs[len(segmentIDs)-1]
+	}
+
+	return &directoryBackedJournal{
+		directory:        directory,
+		dataSyncer:       dataSyncer,
+		errorLogger:      errorLogger,
+		pendingSegmentID: lastSegmentID,
+		firstSegmentID:   firstSegmentID,
+	}, records, nil
+}
+
+// HasJournalSegments returns whether a filesystem.Directory contains
+// segments of a journal created by NewDirectoryBackedJournal(). Tools
+// that modify the persistent state without replaying the journal may
+// use this to refuse operating on it, as replaying the journal
+// afterwards would undo their changes.
+func HasJournalSegments(directory filesystem.Directory) (bool, error) {
+	segmentIDs, err := getJournalSegmentIDs(directory)
+	if err != nil {
+		return false, err
+	}
+	return len(segmentIDs) > 0, nil
+}
+
+// getJournalSegmentIDs returns the sorted list of identifiers of
+// segments that are stored in a directory.
+func getJournalSegmentIDs(directory filesystem.Directory) ([]uint64, error) {
+	entries, err := directory.ReadDir()
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to read contents of directory")
+	}
+	var segmentIDs []uint64
+	for _, entry := range entries {
+		name := entry.Name().String()
+		if suffix, ok := strings.CutPrefix(name, journalSegmentPrefix); ok {
+			if segmentID, err := strconv.ParseUint(suffix, 10, 64); err == nil {
+				segmentIDs = append(segmentIDs, segmentID)
+			}
+		}
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	return segmentIDs, nil
+}
+
+func getJournalSegmentName(segmentID uint64) path.Component {
+	return path.MustNewComponent(fmt.Sprintf("%s%d", journalSegmentPrefix, segmentID))
+}
+
+// parseJournalSegment extracts all records from a segment. It returns
+// false if the segment contains a record that is incomplete or
+// corrupted.
+func parseJournalSegment(data []byte) ([]*pb.JournalRecord, bool) {
+	var records []*pb.JournalRecord
+	for len(data) > 0 {
+		size, n := protowire.ConsumeVarint(data)
+		if n < 0 || uint64(len(data)-n) < size+4 {
+			return records, false
+		}
+		payload := data[n : n+int(size)]
+		if binary.LittleEndian.Uint32(data[n+int(size):]) != crc32.Checksum(payload, journalChecksumTable) {
+			return records, false
+		}
+		var record pb.JournalRecord
+		if err := proto.Unmarshal(payload, &record); err != nil {
+			return records, false
+		}
+		records = append(records, &record)
+		data = data[n+int(size)+4:]
+	}
+	return records, true
+}
+
+func appendJournalRecord(data []byte, record *pb.JournalRecord) []byte {
+	payload, err := proto.Marshal(record)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to marshal journal record: %s", err))
+	}
+	data = protowire.AppendVarint(data, uint64(len(payload)))
+	data = append(data, payload...)
+	return binary.LittleEndian.AppendUint32(data, crc32.Checksum(payload, journalChecksumTable))
+}
+
+func (j *directoryBackedJournal) Append(record *pb.JournalRecord) {
+	j.lock.Lock()
+	j.pendingRecords = appendJournalRecord(j.pendingRecords, record)
+	j.lock.Unlock()
+}
+
+func (j *directoryBackedJournal) Sync() error {
+	j.syncLock.Lock()
+	defer j.syncLock.Unlock()
+
+	if j.err != nil {
+		return j.err
+	}
+
+	// Only write the records that were appended prior to calling
+	// this function, as only the data of the objects referenced by
+	// those records is guaranteed to be synchronized below.
+	j.lock.Lock()
+	data, segmentID := j.pendingRecords, j.pendingSegmentID
+	j.pendingRecords = nil
+	j.lock.Unlock()
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := j.dataSyncer(); err != nil {
+		j.err = util.StatusWrap(err, "Failed to synchronize data")
+		return j.err
+	}
+	if err := j.write(data, segmentID); err != nil {
+		j.err = util.StatusWrapf(err, "Failed to write to journal segment %d", segmentID)
+		return j.err
+	}
+	return nil
+}
+
+func (j *directoryBackedJournal) write(data []byte, segmentID uint64) error {
+	if j.file == nil || j.fileSegmentID != segmentID {
+		// Switch to a new segment.
+		if j.file != nil {
+			if err := j.file.Close(); err != nil {
+				return util.StatusWrapWithCode(err, codes.Internal, "Failed to close previous segment")
+			}
+			j.file = nil
+		}
+		f, err := j.directory.OpenAppend(getJournalSegmentName(segmentID), filesystem.CreateExcl(0o666))
+		if err != nil {
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to create file")
+		}
+		if err := j.directory.Sync(); err != nil {
+			f.Close()
+			return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
+		}
+		j.file = f
+		j.fileSegmentID = segmentID
+	}
+
+	if _, err := j.file.Write(data); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to write to file")
+	}
+	if err := j.file.Sync(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize file")
+	}
+	return nil
+}
+
+func (j *directoryBackedJournal) StartSegment(blockLocations []*pb.BlockLocation) uint64 {
+	// Records that have not been written yet are moved to the new
+	// segment, as the data they refer to may not have been
+	// synchronized yet. They are placed after the list of blocks,
+	// which is valid as the list of blocks does not reference any
+	// blocks that have been removed in the meantime.
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.pendingSegmentID++
+	j.pendingRecords = append(
+		appendJournalRecord(nil, &pb.JournalRecord{
+			Kind: &pb.JournalRecord_SegmentStart_{
+				SegmentStart: &pb.JournalRecord_SegmentStart{
+					Blocks: blockLocations,
+				},
+			},
+		}),
+		j.pendingRecords...)
+	return j.pendingSegmentID
+}
+
+func (j *directoryBackedJournal) RemoveSegmentsBefore(segmentID uint64) {
+	j.lock.Lock()
+	firstSegmentID := j.firstSegmentID
+	if firstSegmentID < segmentID {
+		j.firstSegmentID = segmentID
+	}
+	j.lock.Unlock()
+
+	for id := firstSegmentID; id < segmentID; id++ {
+		if err := j.directory.Remove(getJournalSegmentName(id)); err != nil && !os.IsNotExist(err) {
+			j.errorLogger.Log(util.StatusWrapfWithCode(err, codes.Internal, "Failed to remove journal segment %d", id))
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package local_test
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDirectoryBackedJournal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	directoryPath := t.TempDir()
+	directory, err := filesystem.NewLocalDirectory(path.NewLocalParser(directoryPath))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	blockLocation1 := &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 1000}
+	blockLocation2 := &pb.BlockLocation{OffsetBytes: 1000, SizeBytes: 1000}
+	record1 := newJournalPutRecord(local.NewKeyFromString("1"), blockLocation1, 0, 100)
+	record2 := newJournalPutRecord(local.NewKeyFromString("2"), blockLocation1, 100, 100)
+
+	t.Run("Empty", func(t *testing.T) {
+		// Opening an empty directory should not yield any
+		// records. Syncing without any records should not
+		// cause any data to be synchronized.
+		dataSyncer := mock.NewMockDataSyncer(ctrl)
+		journal, records, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+		require.Empty(t, records)
+		require.NoError(t, journal.Sync())
+	})
+
+	t.Run("WriteAndReplay", func(t *testing.T) {
+		// Write records into two segments.
+		dataSyncer := mock.NewMockDataSyncer(ctrl)
+		journal, _, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(1), journal.StartSegment([]*pb.BlockLocation{blockLocation1}))
+		journal.Append(record1)
+		dataSyncer.EXPECT().Call()
+		require.NoError(t, journal.Sync())
+
+		journal.Append(record2)
+		require.Equal(t, uint64(2), journal.StartSegment([]*pb.BlockLocation{blockLocation1, blockLocation2}))
+		dataSyncer.EXPECT().Call()
+		require.NoError(t, journal.Sync())
+
+		// Reopening the journal should yield all records.
+		// Records that were appended before the second segment
+		// was started should be placed after its first record.
+		journal, records, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		testutil.RequireEqualProto(t, &pb.JournalRecord{
+			Kind: &pb.JournalRecord_SegmentStart_{
+				SegmentStart: &pb.JournalRecord_SegmentStart{
+					Blocks: []*pb.BlockLocation{blockLocation1},
+				},
+			},
+		}, records[0])
+		testutil.RequireEqualProto(t, record1, records[1])
+		testutil.RequireEqualProto(t, &pb.JournalRecord{
+			Kind: &pb.JournalRecord_SegmentStart_{
+				SegmentStart: &pb.JournalRecord_SegmentStart{
+					Blocks: []*pb.BlockLocation{blockLocation1, blockLocation2},
+				},
+			},
+		}, records[2])
+		testutil.RequireEqualProto(t, record2, records[3])
+
+		// New segments should not overwrite existing ones.
+		require.Equal(t, uint64(3), journal.StartSegment(nil))
+		dataSyncer.EXPECT().Call()
+		require.NoError(t, journal.Sync())
+
+		// Removing segments should only remove the ones
+		// preceding the one that is provided.
+		journal.RemoveSegmentsBefore(3)
+		entries, err := os.ReadDir(directoryPath)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "journal.3", entries[0].Name())
+		require.NoError(t, os.Remove(filepath.Join(directoryPath, "journal.3")))
+	})
+
+	t.Run("PartiallyWrittenRecord", func(t *testing.T) {
+		dataSyncer := mock.NewMockDataSyncer(ctrl)
+		journal, _, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+
+		journal.StartSegment(nil)
+		journal.Append(record1)
+		journal.Append(record2)
+		dataSyncer.EXPECT().Call()
+		require.NoError(t, journal.Sync())
+
+		// Truncate the segment, so that the last record is
+		// incomplete. Only the records preceding it should be
+		// returned.
+		segmentPath := filepath.Join(directoryPath, "journal.1")
+		info, err := os.Stat(segmentPath)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(segmentPath, info.Size()-1))
+
+		_, records, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		testutil.RequireEqualProto(t, record1, records[1])
+		require.NoError(t, os.Remove(segmentPath))
+	})
+
+	t.Run("DataSyncFailure", func(t *testing.T) {
+		// If data cannot be synchronized, records must not be
+		// written. The journal should remain unusable.
+		dataSyncer := mock.NewMockDataSyncer(ctrl)
+		journal, _, err := local.NewDirectoryBackedJournal(directory, dataSyncer.Call, mock.NewMockErrorLogger(ctrl))
+		require.NoError(t, err)
+
+		journal.StartSegment(nil)
+		journal.Append(record1)
+		dataSyncer.EXPECT().Call().Return(status.Error(codes.Internal, "Disk on fire"))
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to synchronize data: Disk on fire"), journal.Sync())
+
+		journal.Append(record2)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to synchronize data: Disk on fire"), journal.Sync())
+
+		_, err = os.Stat(filepath.Join(directoryPath, "journal.1"))
+		require.ErrorIs(t, err, syscall.ENOENT)
+	})
+}
+
+func TestHasJournalSegments(t *testing.T) {
+	directoryPath := t.TempDir()
+	directory, err := filesystem.NewLocalDirectory(path.NewLocalParser(directoryPath))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	// Files that are not journal segments should be ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(directoryPath, "state"), nil, 0o666))
+	require.NoError(t, os.WriteFile(filepath.Join(directoryPath, "journal.foo"), nil, 0o666))
+	hasJournalSegments, err := local.HasJournalSegments(directory)
+	require.NoError(t, err)
+	require.False(t, hasJournalSegments)
+
+	require.NoError(t, os.WriteFile(filepath.Join(directoryPath, "journal.42"), nil, 0o666))
+	hasJournalSegments, err = local.HasJournalSegments(directory)
+	require.NoError(t, err)
+	require.True(t, hasJournalSegments)
+}
@@ -23,6 +23,7 @@ var (
 
 type directoryBackedPersistentStateStore struct {
 	directory filesystem.Directory
+	strict    bool
 }
 
 // NewDirectoryBackedPersistentStateStore creates a PersistentStateStore
@@ -34,6 +35,18 @@ func NewDirectoryBackedPersistentStateStore(directory filesystem.Directory) Pers
 	}
 }
 
+// NewStrictDirectoryBackedPersistentStateStore is identical to
+// NewDirectoryBackedPersistentStateStore(), except that
+// ReadPersistentState() fails if the persistent state is absent or
+// corrupted, as opposed to reinitializing the data store. This is
+// useful for tools that inspect existing data stores.
+func NewStrictDirectoryBackedPersistentStateStore(directory filesystem.Directory) PersistentStateStore {
+	return directoryBackedPersistentStateStore{
+		directory: directory,
+		strict:    true,
+	}
+}
+
 func newPersistentState() *pb.PersistentState {
 	return &pb.PersistentState{
 		OldestEpochId:                    1,
@@ -42,23 +55,23 @@ func newPersistentState() *pb.PersistentState {
 }
 
 func (pss directoryBackedPersistentStateStore) ReadPersistentState() (*pb.PersistentState, error) {
-	f, err := pss.directory.OpenRead(componentState)
+	data, err := readFile(pss.directory, componentState)
 	if os.IsNotExist(err) {
+		if pss.strict {
+			return nil, util.StatusWrapWithCode(err, codes.NotFound, "Persistent state not found")
+		}
 		// No state file present. Reinitialize the data store.
 		log.Print("Reinitializing data store, as persistent state was not found")
 		return newPersistentState(), nil
 	}
 	if err != nil {
-		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
-	}
-	defer f.Close()
-
-	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
-	if err != nil {
-		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to read from file")
+		return nil, err
 	}
 	var persistentState pb.PersistentState
 	if err := proto.Unmarshal(data, &persistentState); err != nil {
+		if pss.strict {
+			return nil, util.StatusWrapWithCode(err, codes.DataLoss, "Failed to unmarshal persistent state")
+		}
 		// The state file was read successfully, but we were
 		// unable to find any usable state. As this is not a
 		// transient issue, let's reinitialize so that the
@@ -76,11 +89,38 @@ func (pss directoryBackedPersistentStateStore) WritePersistentState(persistentSt
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal data")
 	}
 
-	// Write the persistent state to a temporary file.
-	if err := pss.directory.Remove(componentStateNew); err != nil && !os.IsNotExist(err) {
+	return writeFileAtomically(pss.directory, componentStateNew, componentState, data)
+}
+
+// readFile returns the contents of a file stored in a directory. If
+// the file does not exist, the error returned by the directory is
+// returned unmodified, so that it can be checked using os.IsNotExist().
+func readFile(directory filesystem.Directory, name path.Component) ([]byte, error) {
+	f, err := directory.OpenRead(name)
+	if os.IsNotExist(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.NewSectionReader(f, 0, math.MaxInt64))
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to read from file")
+	}
+	return data, nil
+}
+
+// writeFileAtomically replaces the contents of a file stored in a
+// directory. Data is first written to a temporary file, which is
+// subsequently moved over the original file. This ensures that the
+// file is never left in a partially written state.
+func writeFileAtomically(directory filesystem.Directory, temporaryName, name path.Component, data []byte) error {
+	if err := directory.Remove(temporaryName); err != nil && !os.IsNotExist(err) {
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to remove previous temporary file")
 	}
-	f, err := pss.directory.OpenAppend(componentStateNew, filesystem.CreateExcl(0o666))
+	f, err := directory.OpenAppend(temporaryName, filesystem.CreateExcl(0o666))
 	if err != nil {
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create temporary file")
 	}
@@ -96,11 +136,11 @@ func (pss directoryBackedPersistentStateStore) WritePersistentState(persistentSt
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to close temporary file")
 	}
 
-	// Move the new persistent state over the old copy.
-	if err := pss.directory.Rename(componentStateNew, pss.directory, componentState); err != nil {
+	// Move the new file over the old copy.
+	if err := directory.Rename(temporaryName, directory, name); err != nil {
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to rename temporary file")
 	}
-	if err := pss.directory.Sync(); err != nil {
+	if err := directory.Sync(); err != nil {
 		return util.StatusWrapWithCode(err, codes.Internal, "Failed to synchronize directory")
 	}
 	return nil
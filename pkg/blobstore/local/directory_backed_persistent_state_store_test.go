@@ -189,3 +189,31 @@ func TestDirectoryBackedPersistentStateStore(t *testing.T) {
 		require.NoError(t, persistentStateStore.WritePersistentState(&examplePersistentState))
 	})
 }
+
+func TestStrictDirectoryBackedPersistentStateStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	directory := mock.NewMockDirectory(ctrl)
+	persistentStateStore := local.NewStrictDirectoryBackedPersistentStateStore(directory)
+
+	t.Run("ReadNotFound", func(t *testing.T) {
+		// Absent persistent state should not cause the data
+		// store to be reinitialized.
+		directory.EXPECT().OpenRead(path.MustNewComponent("state")).Return(nil, syscall.ENOENT)
+
+		_, err := persistentStateStore.ReadPersistentState()
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Persistent state not found: no such file or directory"), err)
+	})
+
+	t.Run("ReadCorrupted", func(t *testing.T) {
+		f := mock.NewMockFileReader(ctrl)
+		directory.EXPECT().OpenRead(path.MustNewComponent("state")).Return(f, nil)
+		f.EXPECT().ReadAt(gomock.Any(), gomock.Any()).DoAndReturn(func(p []byte, off int64) (int, error) {
+			return copy(p, "This is not a valid protobuf"), io.EOF
+		})
+		f.EXPECT().Close()
+
+		_, err := persistentStateStore.ReadPersistentState()
+		require.Equal(t, codes.DataLoss, status.Code(err))
+	})
+}
@@ -0,0 +1,78 @@
+package local
+
+import (
+	"io"
+
+	"golang.org/x/crypto/xts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sectorDevice is the subset of blockdevice.BlockDevice that is used by
+// blocks created by BlockDeviceBackedBlockAllocator.
+type sectorDevice interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// encryptedSectorDevice is a decorator for sectorDevice that encrypts
+// data using AES-XTS, using the index of the sector on the underlying
+// device as the tweak. As XTS is length preserving, data can be stored
+// at the same offsets as when encryption is disabled.
+//
+// Writes must be performed at sector boundaries and sizes. Reads may
+// be performed at any offset, as data is read from storage at sector
+// granularity and decrypted before being copied out.
+type encryptedSectorDevice struct {
+	base            sectorDevice
+	cipher          *xts.Cipher
+	sectorSizeBytes int
+}
+
+func (d *encryptedSectorDevice) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	sectorSizeBytes := int64(d.sectorSizeBytes)
+	firstSector := off / sectorSizeBytes
+	endSector := (off + int64(len(p)) + sectorSizeBytes - 1) / sectorSizeBytes
+	ciphertext := make([]byte, (endSector-firstSector)*sectorSizeBytes)
+	n, err := d.base.ReadAt(ciphertext, firstSector*sectorSizeBytes)
+
+	// Only decrypt sectors that were read entirely.
+	readSectors := int64(n) / sectorSizeBytes
+	plaintext := ciphertext[:readSectors*sectorSizeBytes]
+	for i := int64(0); i < readSectors; i++ {
+		sector := plaintext[i*sectorSizeBytes : (i+1)*sectorSizeBytes]
+		d.cipher.Decrypt(sector, sector, uint64(firstSector+i))
+	}
+
+	skip := off - firstSector*sectorSizeBytes
+	if int64(len(plaintext)) <= skip {
+		if err == nil {
+			err = io.EOF
+		}
+		return 0, err
+	}
+	copied := copy(p, plaintext[skip:])
+	if copied < len(p) && err == nil {
+		err = io.EOF
+	}
+	return copied, err
+}
+
+func (d *encryptedSectorDevice) WriteAt(p []byte, off int64) (int, error) {
+	sectorSizeBytes := int64(d.sectorSizeBytes)
+	if off%sectorSizeBytes != 0 || int64(len(p))%sectorSizeBytes != 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "Write of %d bytes at offset %d is not aligned to sector size %d", len(p), off, sectorSizeBytes)
+	}
+	firstSector := off / sectorSizeBytes
+	ciphertext := make([]byte, len(p))
+	for i := int64(0); i < int64(len(p))/sectorSizeBytes; i++ {
+		d.cipher.Encrypt(
+			ciphertext[i*sectorSizeBytes:(i+1)*sectorSizeBytes],
+			p[i*sectorSizeBytes:(i+1)*sectorSizeBytes],
+			uint64(firstSector+i))
+	}
+	return d.base.WriteAt(ciphertext, off)
+}
@@ -0,0 +1,104 @@
+package local
+
+import (
+	"crypto/aes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/xts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptionKeys is a set of keys that may be used to encrypt data that
+// is stored on block devices at rest, using AES-XTS. Every key has an
+// identifier, which is stored alongside the encrypted data. This makes
+// it possible to rotate keys, while still being able to decrypt data
+// that was written using older keys.
+type EncryptionKeys struct {
+	currentKeyID uint32
+	ciphers      map[uint32]*xts.Cipher
+}
+
+// NewEncryptionKeys creates a set of keys that may be used to encrypt
+// data at rest. Keys must be either 32 bytes (AES-128-XTS) or 64 bytes
+// (AES-256-XTS) in size. The key with identifier currentKeyID is used
+// to encrypt new data.
+func NewEncryptionKeys(keys map[uint32][]byte, currentKeyID uint32) (*EncryptionKeys, error) {
+	if _, ok := keys[currentKeyID]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Current key ID %d does not correspond to any of the keys", currentKeyID)
+	}
+	ek := &EncryptionKeys{
+		currentKeyID: currentKeyID,
+		ciphers:      make(map[uint32]*xts.Cipher, len(keys)),
+	}
+	for keyID, key := range keys {
+		if keyID == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Key ID 0 is reserved for data that is not encrypted")
+		}
+		if len(key) != 32 && len(key) != 64 {
+			return nil, status.Errorf(codes.InvalidArgument, "Key with ID %d is %d bytes in size, while 32 or 64 bytes were expected", keyID, len(key))
+		}
+		c, err := xts.NewCipher(aes.NewCipher, key)
+		if err != nil {
+			return nil, util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid key with ID %d", keyID)
+		}
+		ek.ciphers[keyID] = c
+	}
+	return ek, nil
+}
+
+// NewEncryptionKeysFromConfiguration loads a set of keys that may be
+// used to encrypt data at rest, based on options specified in a
+// configuration file.
+func NewEncryptionKeysFromConfiguration(configuration *pb.LocalBlobAccessConfiguration_Encryption) (*EncryptionKeys, error) {
+	keys := make(map[uint32][]byte, len(configuration.Keys))
+	for _, keyConfiguration := range configuration.Keys {
+		if _, ok := keys[keyConfiguration.Id]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Multiple keys with ID %d", keyConfiguration.Id)
+		}
+		switch source := keyConfiguration.Source.(type) {
+		case *pb.LocalBlobAccessConfiguration_Encryption_Key_KeyFilePath:
+			key, err := os.ReadFile(source.KeyFilePath)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Failed to read key with ID %d from %#v", keyConfiguration.Id, source.KeyFilePath)
+			}
+			keys[keyConfiguration.Id] = key
+		case *pb.LocalBlobAccessConfiguration_Encryption_Key_MasterKeyFilePath:
+			masterKey, err := os.ReadFile(source.MasterKeyFilePath)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Failed to read master key for key with ID %d from %#v", keyConfiguration.Id, source.MasterKeyFilePath)
+			}
+			if len(masterKey) < 32 {
+				return nil, status.Errorf(codes.InvalidArgument, "Master key for key with ID %d is %d bytes in size, while at least 32 bytes were expected", keyConfiguration.Id, len(masterKey))
+			}
+			key := make([]byte, 64)
+			if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, []byte(fmt.Sprintf("bb-storage local encryption key %d", keyConfiguration.Id))), key); err != nil {
+				return nil, util.StatusWrapf(err, "Failed to derive key with ID %d", keyConfiguration.Id)
+			}
+			keys[keyConfiguration.Id] = key
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "No source specified for key with ID %d", keyConfiguration.Id)
+		}
+	}
+	return NewEncryptionKeys(keys, configuration.CurrentKeyId)
+}
+
+// getCurrentCipher returns the cipher that should be used to encrypt
+// new data, and the identifier of the key it uses.
+func (ek *EncryptionKeys) getCurrentCipher() (uint32, *xts.Cipher) {
+	return ek.currentKeyID, ek.ciphers[ek.currentKeyID]
+}
+
+// getCipher returns the cipher that can be used to decrypt data that
+// was encrypted using a given key.
+func (ek *EncryptionKeys) getCipher(keyID uint32) (*xts.Cipher, bool) {
+	c, ok := ek.ciphers[keyID]
+	return c, ok
+}
@@ -2,6 +2,9 @@ package local
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
 	"sync"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
@@ -30,12 +33,18 @@ var (
 		[]string{"storage_type", "operation"})
 )
 
+// flatBlobAccessListDigestsRecordsCount is the number of key-location
+// map records that ListDigests() examines while holding the lock.
+const flatBlobAccessListDigestsRecordsCount = 1000
+
 type flatBlobAccess struct {
 	capabilities.Provider
 
-	keyLocationMap  KeyLocationMap
-	locationBlobMap LocationBlobMap
-	digestKeyFormat digest.KeyFormat
+	keyLocationMap      KeyLocationMap
+	locationBlobMap     LocationBlobMap
+	digestKeyFormat     digest.KeyFormat
+	contentAddressed    bool
+	invalidatedPrefixes *InvalidatedInstanceNamePrefixes
 
 	lock        *sync.RWMutex
 	refreshLock sync.Mutex
@@ -51,7 +60,17 @@ type flatBlobAccess struct {
 // either ignores the REv2 instance name in digests entirely, or it
 // strongly partitions objects by instance name. It does not introduce
 // any hierarchy.
-func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, digestKeyFormat digest.KeyFormat, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider) blobstore.BlobAccess {
+//
+// If objects are partitioned by instance name, an
+// InvalidatedInstanceNamePrefixes may be provided to permit
+// invalidation of all objects stored under an instance name prefix.
+//
+// As keys are derived from digests using a one-way hash function, the
+// digests of stored objects cannot be recovered from the key-location
+// map. If the objects are content addressed (i.e., this BlobAccess is
+// used as a Content Addressable Storage), ListDigests() can still be
+// provided by reading all objects and recomputing their digests.
+func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, digestKeyFormat digest.KeyFormat, contentAddressed bool, invalidatedPrefixes *InvalidatedInstanceNamePrefixes, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider) blobstore.BlobAccess {
 	flatBlobAccessPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(flatBlobAccessRefreshes)
 	})
@@ -59,10 +78,12 @@ func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBl
 	return &flatBlobAccess{
 		Provider: capabilitiesProvider,
 
-		keyLocationMap:  keyLocationMap,
-		locationBlobMap: locationBlobMap,
-		digestKeyFormat: digestKeyFormat,
-		lock:            lock,
+		keyLocationMap:      keyLocationMap,
+		locationBlobMap:     locationBlobMap,
+		digestKeyFormat:     digestKeyFormat,
+		contentAddressed:    contentAddressed,
+		invalidatedPrefixes: invalidatedPrefixes,
+		lock:                lock,
 
 		refreshesGet:              flatBlobAccessRefreshes.WithLabelValues(storageType, "Get"),
 		refreshesGetFromComposite: flatBlobAccessRefreshes.WithLabelValues(storageType, "GetFromComposite"),
@@ -70,8 +91,17 @@ func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBl
 	}
 }
 
-func (ba *flatBlobAccess) getKey(digest digest.Digest) Key {
-	return NewKeyFromString(digest.GetKey(ba.digestKeyFormat))
+func (ba *flatBlobAccess) getKey(blobDigest digest.Digest) Key {
+	key := blobDigest.GetKey(ba.digestKeyFormat)
+	if ba.invalidatedPrefixes != nil {
+		// Objects stored under invalidated instance name
+		// prefixes use keys that include the generation of the
+		// invalidation.
+		if generation := ba.invalidatedPrefixes.getGeneration(blobDigest.GetInstanceName()); generation > 0 {
+			key = fmt.Sprintf("invalidated/%d/%s", generation, key)
+		}
+	}
+	return NewKeyFromString(key)
 }
 
 // finalizePut is called to finalize a write to the data store. This
@@ -393,3 +423,98 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	ba.refreshesFindMissing.Observe(float64(blobsRefreshedSuccessfully))
 	return missing.Build(), nil
 }
+
+func (ba *flatBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *flatBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	keys := make([]Key, 0, digests.Length())
+	for _, blobDigest := range digests.Items() {
+		keys = append(keys, ba.getKey(blobDigest))
+	}
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+	for i, blobDigest := range digests.Items() {
+		if err := ba.keyLocationMap.Delete(keys[i]); err != nil {
+			return util.StatusWrapf(err, "Failed to invalidate blob %#v", blobDigest.String())
+		}
+	}
+	return nil
+}
+
+func (ba *flatBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	if ba.digestKeyFormat != digest.KeyWithInstance || ba.invalidatedPrefixes == nil {
+		return status.Error(codes.InvalidArgument, "This storage backend does not partition objects by instance name")
+	}
+	return ba.invalidatedPrefixes.invalidate(instanceNamePrefix)
+}
+
+func (ba *flatBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	if !ba.contentAddressed {
+		return status.Error(codes.Unimplemented, "Digests can only be listed for objects that are content addressed")
+	}
+
+	position := 0
+	for {
+		if err := util.StatusFromContext(ctx); err != nil {
+			return err
+		}
+
+		// Obtain a batch of entries from the key-location map,
+		// and readers for the corresponding data. Locations are
+		// only valid while holding the lock.
+		ba.lock.RLock()
+		entries, nextPosition, err := ba.keyLocationMap.List(position, flatBlobAccessListDigestsRecordsCount)
+		if err != nil {
+			ba.lock.RUnlock()
+			return util.StatusWrap(err, "Failed to list key-location map entries")
+		}
+		readers := make([]buffer.ChunkReader, 0, len(entries))
+		for _, entry := range entries {
+			readers = append(readers, ba.locationBlobMap.GetUnvalidated(entry.Location))
+		}
+		ba.lock.RUnlock()
+
+		// Recompute the digests of the objects without holding
+		// the lock. Objects are only reported if the key that
+		// is derived from the digest matches. This discards
+		// objects that use a different digest function or
+		// instance name, and objects that are corrupted.
+		for i, entry := range entries {
+			blobDigest, err := computeDigest(digestFunction, readers[i])
+			if err == nil && ba.getKey(blobDigest) == entry.Key {
+				if err := reportDigest(blobDigest); err != nil {
+					for _, r := range readers[i+1:] {
+						r.Close()
+					}
+					return err
+				}
+			}
+		}
+
+		if nextPosition == 0 {
+			return nil
+		}
+		position = nextPosition
+	}
+}
+
+// computeDigest computes the digest of an object, given its contents.
+// The reader is closed upon completion. The size of the object is not
+// known in advance, as the size stored in the location may correspond
+// to that of compressed data.
+func computeDigest(digestFunction digest.Function, r buffer.ChunkReader) (digest.Digest, error) {
+	defer r.Close()
+	digestGenerator := digestFunction.NewGenerator(math.MaxInt64)
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			return digestGenerator.Sum(), nil
+		} else if err != nil {
+			return digest.BadDigest, err
+		}
+		digestGenerator.Write(chunk)
+	}
+}
@@ -26,7 +26,7 @@ func TestFlatBlobAccessGet(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location1 := local.Location{
@@ -197,7 +197,7 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
 	parentDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 11)
 	parentKey := local.NewKeyFromString("3-3e25960a79dbc69b674cd4ec67a72c62-11")
 	child1Digest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
@@ -475,7 +475,7 @@ func TestFlatBlobAccessPut(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location := local.Location{
@@ -550,7 +550,7 @@ func TestFlatBlobAccessFindMissing(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location1 := local.Location{
@@ -718,3 +718,170 @@ func TestFlatBlobAccessFindMissing(t *testing.T) {
 		require.Equal(t, digest.EmptySet, missing)
 	})
 }
+
+func TestFlatBlobAccessInvalidate(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
+
+	t.Run("Failure", func(t *testing.T) {
+		keyLocationMap.EXPECT().Delete(helloKey).
+			Return(status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to invalidate blob \"1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-example\": Disk on fire"),
+			blobAccess.Invalidate(ctx, helloDigest.ToSingletonSet()))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		keyLocationMap.EXPECT().Delete(helloKey)
+
+		require.NoError(t, blobAccess.Invalidate(ctx, helloDigest.ToSingletonSet()))
+	})
+}
+
+func TestFlatBlobAccessInvalidateInstanceNamePrefix(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	t.Run("WithoutInstance", func(t *testing.T) {
+		// Objects are not partitioned by instance name, meaning
+		// that instance name prefixes cannot be invalidated.
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+		locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+		capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
+		blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "This storage backend does not partition objects by instance name"),
+			blobAccess.InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("example")))
+	})
+
+	t.Run("WithInstance", func(t *testing.T) {
+		keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+		locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+		capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
+		invalidatedPrefixes, err := local.NewInvalidatedInstanceNamePrefixes(nil)
+		require.NoError(t, err)
+		blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithInstance, false, invalidatedPrefixes, &sync.RWMutex{}, "cas", capabilitiesProvider)
+		helloDigest1 := digest.MustNewDigest("hello/world", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+		helloDigest2 := digest.MustNewDigest("hello/worlds", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+		// Prior to invalidation, keys should be derived from
+		// digests directly.
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/world")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/worlds")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build(), missing)
+
+		// After invalidating an instance name prefix, objects
+		// stored under that prefix should use different keys.
+		// Instance names that merely share a string prefix
+		// should not be affected.
+		require.NoError(t, blobAccess.InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("hello/world")))
+
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("invalidated/1/1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/world")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/worlds")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+
+		missing, err = blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build(), missing)
+
+		// Invalidating a shorter prefix should affect both.
+		require.NoError(t, blobAccess.InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("hello")))
+
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("invalidated/2/1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/world")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+		keyLocationMap.EXPECT().Get(local.NewKeyFromString("invalidated/2/1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5-hello/worlds")).
+			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
+
+		missing, err = blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(helloDigest1).Add(helloDigest2).Build(), missing)
+	})
+}
+
+func TestFlatBlobAccessListDigests(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	digestFunction := digest.MustNewFunction("example", remoteexecution.DigestFunction_SHA256)
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
+	location1 := local.Location{
+		BlockIndex:  7,
+		OffsetBytes: 42,
+		SizeBytes:   5,
+	}
+	location2 := local.Location{
+		BlockIndex:  8,
+		OffsetBytes: 382,
+		SizeBytes:   5,
+	}
+
+	t.Run("NotContentAddressed", func(t *testing.T) {
+		// Keys cannot be converted back to digests, meaning
+		// that listing is only possible if digests can be
+		// recomputed from the data.
+		blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithInstance, false, nil, &sync.RWMutex{}, "ac", capabilitiesProvider)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unimplemented, "Digests can only be listed for objects that are content addressed"),
+			blobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+				t.Fatal("Digests should not be reported")
+				return nil
+			}))
+	})
+
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, true, nil, &sync.RWMutex{}, "cas", capabilitiesProvider)
+
+	t.Run("ListFailure", func(t *testing.T) {
+		keyLocationMap.EXPECT().List(0, 1000).Return(nil, 0, status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to list key-location map entries: Disk on fire"),
+			blobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+				t.Fatal("Digests should not be reported")
+				return nil
+			}))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Objects whose contents don't match the key should be
+		// skipped, as they are either corrupted or use a
+		// different digest function.
+		keyLocationMap.EXPECT().List(0, 1000).Return([]local.KeyLocationMapEntry{
+			{Key: helloKey, Location: location1},
+		}, 1000, nil)
+		locationBlobMap.EXPECT().GetUnvalidated(location1).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")).ToChunkReader(0, 1024))
+		keyLocationMap.EXPECT().List(1000, 1000).Return([]local.KeyLocationMapEntry{
+			{Key: helloKey, Location: location2},
+		}, 0, nil)
+		locationBlobMap.EXPECT().GetUnvalidated(location2).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hellp")).ToChunkReader(0, 1024))
+
+		var reportedDigests []digest.Digest
+		require.NoError(t, blobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+			reportedDigests = append(reportedDigests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{helloDigest}, reportedDigests)
+	})
+}
@@ -3,6 +3,7 @@ package local
 import (
 	"sync"
 
+	"github.com/fxtlabs/primes"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"google.golang.org/grpc/codes"
@@ -67,6 +68,19 @@ type hashingKeyLocationMap struct {
 	putTooManyIterations prometheus.Counter
 }
 
+// GetHashingKeyLocationMapRecordsCount returns the number of records
+// of a LocationRecordArray that should be used by
+// HashingKeyLocationMap. Considering that FNV-1a is used to compute
+// keys and HashingKeyLocationMap uses simple modulo arithmetic to store
+// entries in the location record array, the size that is used should
+// be prime. This causes the best dispersion of hash table entries.
+func GetHashingKeyLocationMapRecordsCount(locationRecordArraySize int) int {
+	for locationRecordArraySize > 3 && !primes.IsPrime(locationRecordArraySize) {
+		locationRecordArraySize--
+	}
+	return locationRecordArraySize
+}
+
 // NewHashingKeyLocationMap creates a KeyLocationMap backed by a hash
 // table that uses a strategy similar to Robin Hood hashing to handle
 // collisions. By displacing entries for older locations in favour of
@@ -123,7 +137,11 @@ func (klm *hashingKeyLocationMap) getSlot(k *LocationRecordKey) int {
 	return int(k.Hash(klm.hashInitialization) % uint64(klm.recordsCount))
 }
 
-func (klm *hashingKeyLocationMap) Get(key Key) (Location, error) {
+// get looks up the location of an entry. In addition to the location,
+// it returns the number of attempts that were made, and whether the
+// lookup was terminated due to the maximum number of attempts being
+// reached.
+func (klm *hashingKeyLocationMap) get(key Key) (Location, uint32, bool, error) {
 	recordKey := LocationRecordKey{Key: key}
 	for {
 		slot := klm.getSlot(&recordKey)
@@ -133,23 +151,35 @@ func (klm *hashingKeyLocationMap) Get(key Key) (Location, error) {
 			// exists. There is no need to continue
 			// searching, as everything we find after this
 			// point is even older.
-			klm.getNotFound.Observe(float64(recordKey.Attempt + 1))
-			return Location{}, status.Error(codes.NotFound, "Object not found")
+			return Location{}, recordKey.Attempt + 1, false, status.Error(codes.NotFound, "Object not found")
 		} else if err != nil {
-			return Location{}, err
+			return Location{}, recordKey.Attempt + 1, false, err
 		}
 		if record.RecordKey == recordKey {
-			klm.getFound.Observe(float64(recordKey.Attempt + 1))
-			return record.Location, nil
+			if record.Location.IsTombstone() {
+				return Location{}, recordKey.Attempt + 1, false, status.Error(codes.NotFound, "Object not found")
+			}
+			return record.Location, recordKey.Attempt + 1, false, nil
 		}
 		recordKey.Attempt++
 		if recordKey.Attempt >= klm.maximumGetAttempts {
-			klm.getTooManyAttempts.Inc()
-			return Location{}, status.Error(codes.NotFound, "Object not found")
+			return Location{}, recordKey.Attempt, true, status.Error(codes.NotFound, "Object not found")
 		}
 	}
 }
 
+func (klm *hashingKeyLocationMap) Get(key Key) (Location, error) {
+	location, attempts, tooManyAttempts, err := klm.get(key)
+	if tooManyAttempts {
+		klm.getTooManyAttempts.Inc()
+	} else if err == nil {
+		klm.getFound.Observe(float64(attempts))
+	} else if status.Code(err) == codes.NotFound {
+		klm.getNotFound.Observe(float64(attempts))
+	}
+	return location, err
+}
+
 func (klm *hashingKeyLocationMap) Put(key Key, location Location) error {
 	record := LocationRecord{
 		RecordKey: LocationRecordKey{Key: key},
@@ -201,3 +231,74 @@ func (klm *hashingKeyLocationMap) Put(key Key, location Location) error {
 	klm.putTooManyIterations.Inc()
 	return nil
 }
+
+func (klm *hashingKeyLocationMap) Delete(key Key) error {
+	recordKey := LocationRecordKey{Key: key}
+	for {
+		slot := klm.getSlot(&recordKey)
+		record, err := klm.recordArray.Get(slot)
+		if err == ErrLocationRecordInvalid {
+			// Record points to a block that no longer
+			// exists, meaning the entry is already absent.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if record.RecordKey == recordKey {
+			// Turn the record into a tombstone. The record
+			// retains its original block index and offset,
+			// so that it is displaced and eventually
+			// discarded like any other record for that
+			// location. Put() is permitted to overwrite the
+			// tombstone with newer locations.
+			if record.Location.IsTombstone() {
+				return nil
+			}
+			record.Location.SizeBytes = tombstoneSizeBytes
+			return klm.recordArray.Put(slot, record)
+		}
+		recordKey.Attempt++
+		if recordKey.Attempt >= klm.maximumGetAttempts {
+			return nil
+		}
+	}
+}
+
+func (klm *hashingKeyLocationMap) List(position, recordsCount int) ([]KeyLocationMapEntry, int, error) {
+	end := position + recordsCount
+	if end > klm.recordsCount {
+		end = klm.recordsCount
+	}
+	var entries []KeyLocationMapEntry
+	for slot := position; slot < end; slot++ {
+		record, err := klm.recordArray.Get(slot)
+		if err == ErrLocationRecordInvalid {
+			continue
+		} else if err != nil {
+			return nil, 0, err
+		}
+		if record.Location.IsTombstone() {
+			continue
+		}
+
+		// The hash table may contain records for outdated
+		// versions of an entry that are shadowed by a newer
+		// version or a tombstone. Only return the entry if this
+		// record is the one that is obtained through Get().
+		location, _, _, err := klm.get(record.RecordKey.Key)
+		if err == nil {
+			if location == record.Location {
+				entries = append(entries, KeyLocationMapEntry{
+					Key:      record.RecordKey.Key,
+					Location: location,
+				})
+			}
+		} else if status.Code(err) != codes.NotFound {
+			return nil, 0, err
+		}
+	}
+	if end == klm.recordsCount {
+		end = 0
+	}
+	return entries, end, nil
+}
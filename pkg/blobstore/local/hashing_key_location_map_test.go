@@ -151,3 +151,155 @@ func TestHashingKeyLocationMapPut(t *testing.T) {
 }
 
 // TODO: Make unit testing coverage more complete.
+
+func TestHashingKeyLocationMapDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	array := mock.NewMockLocationRecordArray(ctrl)
+	klm := local.NewHashingKeyLocationMap(array, 13, 0x970aef1f90c7f916, 2, 2, "cas")
+
+	key1 := local.Key{
+		0xca, 0x2b, 0xd6, 0xc9, 0xc9, 0x9e, 0x7b, 0xc0,
+		0x0a, 0x44, 0x09, 0x73, 0xd6, 0xe1, 0xa3, 0x69,
+	}
+	key2 := local.Key{
+		0x49, 0x42, 0x69, 0x1f, 0x59, 0x07, 0xd5, 0xed,
+		0xdb, 0x71, 0x81, 0x8f, 0x65, 0x8f, 0x20, 0x71,
+	}
+	validLocation := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   12,
+	}
+	tombstoneLocation := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   -1,
+	}
+
+	t.Run("Absent", func(t *testing.T) {
+		// Deleting an object that is not present should be a
+		// no-op.
+		array.EXPECT().Get(8).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		require.NoError(t, klm.Delete(key1))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// The record should be replaced by a tombstone, so that
+		// lookups for other keys continue to work.
+		array.EXPECT().Get(8).Return(local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key2},
+			Location:  validLocation,
+		}, nil)
+		locationRecordKey := local.LocationRecordKey{Key: key1}
+		locationRecordKey.Attempt++
+		array.EXPECT().Get(2).Return(local.LocationRecord{
+			RecordKey: locationRecordKey,
+			Location:  validLocation,
+		}, nil)
+		array.EXPECT().Put(2, local.LocationRecord{
+			RecordKey: locationRecordKey,
+			Location:  tombstoneLocation,
+		})
+		require.NoError(t, klm.Delete(key1))
+
+		// Subsequent lookups should report the object as absent.
+		array.EXPECT().Get(8).Return(local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key2},
+			Location:  validLocation,
+		}, nil)
+		array.EXPECT().Get(2).Return(local.LocationRecord{
+			RecordKey: locationRecordKey,
+			Location:  tombstoneLocation,
+		}, nil)
+		_, err := klm.Get(key1)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+}
+
+func TestHashingKeyLocationMapList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	array := mock.NewMockLocationRecordArray(ctrl)
+	klm := local.NewHashingKeyLocationMap(array, 13, 0x970aef1f90c7f916, 2, 2, "cas")
+
+	key1 := local.Key{
+		0xca, 0x2b, 0xd6, 0xc9, 0xc9, 0x9e, 0x7b, 0xc0,
+		0x0a, 0x44, 0x09, 0x73, 0xd6, 0xe1, 0xa3, 0x69,
+	}
+	key2 := local.Key{
+		0x49, 0x42, 0x69, 0x1f, 0x59, 0x07, 0xd5, 0xed,
+		0xdb, 0x71, 0x81, 0x8f, 0x65, 0x8f, 0x20, 0x71,
+	}
+	oldLocation := local.Location{
+		BlockIndex:  14,
+		OffsetBytes: 859,
+		SizeBytes:   12930,
+	}
+	newLocation := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   12,
+	}
+	tombstoneLocation := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   -1,
+	}
+
+	t.Run("StorageFailure", func(t *testing.T) {
+		array.EXPECT().Get(0).Return(local.LocationRecord{}, status.Error(codes.Internal, "Disk on fire"))
+
+		_, _, err := klm.List(0, 5)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// The first batch contains a tombstone and an outdated
+		// record for key1 that is shadowed by a newer record in
+		// the second batch. Neither should be returned.
+		for slot := 0; slot < 10; slot++ {
+			switch slot {
+			case 2:
+				locationRecordKey := local.LocationRecordKey{Key: key1}
+				locationRecordKey.Attempt++
+				array.EXPECT().Get(2).Return(local.LocationRecord{
+					RecordKey: locationRecordKey,
+					Location:  oldLocation,
+				}, nil)
+			case 4:
+				array.EXPECT().Get(4).Return(local.LocationRecord{
+					RecordKey: local.LocationRecordKey{Key: key2},
+					Location:  tombstoneLocation,
+				}, nil)
+			case 8:
+				// This record is also read when
+				// resolving key1 for both records.
+				array.EXPECT().Get(8).Return(local.LocationRecord{
+					RecordKey: local.LocationRecordKey{Key: key1},
+					Location:  newLocation,
+				}, nil).Times(3)
+			default:
+				array.EXPECT().Get(slot).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+			}
+		}
+
+		entries, nextPosition, err := klm.List(0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []local.KeyLocationMapEntry{
+			{Key: key1, Location: newLocation},
+		}, entries)
+		require.Equal(t, 10, nextPosition)
+
+		// The final batch is shorter than requested, and should
+		// cause listing to terminate.
+		for slot := 10; slot < 13; slot++ {
+			array.EXPECT().Get(slot).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		}
+
+		entries, nextPosition, err = klm.List(10, 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.Equal(t, 0, nextPosition)
+	})
+}
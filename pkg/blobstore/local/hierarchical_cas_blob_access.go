@@ -395,3 +395,32 @@ func (ba *hierarchicalCASBlobAccess) FindMissing(ctx context.Context, digests di
 	ba.lock.Unlock()
 	return missing.Build(), nil
 }
+
+func (ba *hierarchicalCASBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+// Invalidate removes both the canonical entry of an object and the
+// lookup entries for its instance name and all of its parent instance
+// names. Lookup entries for other instance names are retained, meaning
+// that the object may remain accessible through those.
+func (ba *hierarchicalCASBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+	for _, blobDigest := range digests.Items() {
+		for _, key := range append(getAllLookupKeys(blobDigest), getCanonicalKey(blobDigest)) {
+			if err := ba.keyLocationMap.Delete(key); err != nil {
+				return util.StatusWrapf(err, "Failed to invalidate blob %#v", blobDigest.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (ba *hierarchicalCASBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return status.Error(codes.Unimplemented, "Objects stored in the Content Addressable Storage cannot be invalidated by instance name prefix")
+}
+
+func (ba *hierarchicalCASBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	return status.Error(codes.Unimplemented, "Digests of objects stored in a hierarchical Content Addressable Storage cannot be listed")
+}
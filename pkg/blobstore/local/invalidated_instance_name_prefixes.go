@@ -0,0 +1,129 @@
+package local
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// InvalidatedInstanceNamePrefixesStore is used by
+// InvalidatedInstanceNamePrefixes to persist the instance name
+// prefixes that have been invalidated, so that objects stored under
+// them remain inaccessible after restarts.
+type InvalidatedInstanceNamePrefixesStore interface {
+	ReadInvalidatedInstanceNamePrefixes() (*pb.InvalidatedInstanceNamePrefixes, error)
+	WriteInvalidatedInstanceNamePrefixes(invalidatedPrefixes *pb.InvalidatedInstanceNamePrefixes) error
+}
+
+// InvalidatedInstanceNamePrefixes keeps track of instance name prefixes
+// under which all objects stored by FlatBlobAccess have been
+// invalidated.
+//
+// Removing all of the affected entries from the key-location map would
+// require a full scan, as keys are irreversible. Instead, every
+// invalidation is assigned a generation number, which FlatBlobAccess
+// incorporates into the keys of objects stored under the instance name
+// prefix. This causes objects that were written prior to invalidation
+// to become inaccessible. They are eventually discarded, just like any
+// other object that is no longer accessed.
+type InvalidatedInstanceNamePrefixes struct {
+	store      InvalidatedInstanceNamePrefixesStore
+	writeLock  sync.Mutex
+	lock       sync.RWMutex
+	generation uint64
+
+	// Generations of invalidated instance name prefixes. Instead of
+	// storing these in a hierarchical structure, lookups are
+	// performed for every prefix of an instance name.
+	generations map[string]uint64
+}
+
+// NewInvalidatedInstanceNamePrefixes creates an
+// InvalidatedInstanceNamePrefixes, reloading instance name prefixes
+// that were invalidated in the past from the provided store. If no
+// store is provided, invalidations are only retained in memory.
+func NewInvalidatedInstanceNamePrefixes(store InvalidatedInstanceNamePrefixesStore) (*InvalidatedInstanceNamePrefixes, error) {
+	ip := &InvalidatedInstanceNamePrefixes{
+		store:       store,
+		generations: map[string]uint64{},
+	}
+	if store != nil {
+		invalidatedPrefixes, err := store.ReadInvalidatedInstanceNamePrefixes()
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to read invalidated instance name prefixes")
+		}
+		for instanceNamePrefix, generation := range invalidatedPrefixes.Generations {
+			ip.generations[instanceNamePrefix] = generation
+			if ip.generation < generation {
+				ip.generation = generation
+			}
+		}
+	}
+	return ip, nil
+}
+
+// getGeneration returns the highest generation of all invalidated
+// instance name prefixes of an instance name. Zero is returned if none
+// of the prefixes of the instance name have been invalidated.
+func (ip *InvalidatedInstanceNamePrefixes) getGeneration(instanceName digest.InstanceName) uint64 {
+	ip.lock.RLock()
+	defer ip.lock.RUnlock()
+
+	if len(ip.generations) == 0 {
+		return 0
+	}
+	generation := ip.generations[""]
+	instanceNameStr := instanceName.String()
+	for i, c := range instanceNameStr {
+		if c == '/' {
+			if g := ip.generations[instanceNameStr[:i]]; generation < g {
+				generation = g
+			}
+		}
+	}
+	if instanceNameStr != "" {
+		if g := ip.generations[instanceNameStr]; generation < g {
+			generation = g
+		}
+	}
+	return generation
+}
+
+// invalidate an instance name prefix by assigning a new generation to
+// it. Generations of instance name prefixes below it are discarded, as
+// they are lower than the new generation.
+func (ip *InvalidatedInstanceNamePrefixes) invalidate(instanceNamePrefix digest.InstanceName) error {
+	ip.writeLock.Lock()
+	defer ip.writeLock.Unlock()
+
+	ip.lock.RLock()
+	generation := ip.generation + 1
+	instanceNamePrefixStr := instanceNamePrefix.String()
+	generations := make(map[string]uint64, len(ip.generations)+1)
+	for existingPrefix, existingGeneration := range ip.generations {
+		if instanceNamePrefixStr != "" && existingPrefix != instanceNamePrefixStr && !strings.HasPrefix(existingPrefix, instanceNamePrefixStr+"/") {
+			generations[existingPrefix] = existingGeneration
+		}
+	}
+	ip.lock.RUnlock()
+	generations[instanceNamePrefixStr] = generation
+
+	// Persist the new generation before using it, so that objects
+	// remain invalidated if a crash occurs.
+	if ip.store != nil {
+		if err := ip.store.WriteInvalidatedInstanceNamePrefixes(&pb.InvalidatedInstanceNamePrefixes{
+			Generations: generations,
+		}); err != nil {
+			return util.StatusWrap(err, "Failed to write invalidated instance name prefixes")
+		}
+	}
+
+	ip.lock.Lock()
+	ip.generation = generation
+	ip.generations = generations
+	ip.lock.Unlock()
+	return nil
+}
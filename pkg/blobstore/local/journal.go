@@ -0,0 +1,232 @@
+package local
+
+import (
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/random"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Journal is a write-ahead log that is used by PersistentBlockList to
+// record changes to the key-location map and the list of blocks that
+// have not been captured by the persistent state yet. By replaying the
+// journal on startup, writes that were acknowledged to clients are not
+// lost if the process crashes before the next epoch is persisted.
+//
+// The journal is split into segments. A new segment is started every
+// time data is synchronized. Segments can be removed as soon as
+// persistent state covering the data that was synchronized is written.
+type Journal interface {
+	// Append a record to the current segment. Records are not
+	// durable until Sync() is called.
+	//
+	// This function must be called while holding a write lock on
+	// the BlockList, so that records are appended in the same order
+	// in which the changes are made.
+	Append(record *pb.JournalRecord)
+
+	// Sync blocks until all records that were appended prior to
+	// this call are durable. As records may refer to objects that
+	// have just been written, implementations must ensure that the
+	// data of these objects is synchronized before the records are
+	// written.
+	//
+	// This function must be called without holding any locks.
+	Sync() error
+
+	// StartSegment causes successive records to be written to a new
+	// segment. The segment is started with a record containing the
+	// locations of all blocks that are currently part of the
+	// BlockList. The identifier of the new segment is returned.
+	StartSegment(blockLocations []*pb.BlockLocation) uint64
+
+	// RemoveSegmentsBefore removes all segments that were started
+	// before the segment with a given identifier, as their contents
+	// are covered by the persistent state.
+	RemoveSegmentsBefore(segmentID uint64)
+}
+
+// blockLocationKey contains the fields of a BlockLocation that
+// identify the physical location of a block, so that it may be used as
+// a map key.
+type blockLocationKey struct {
+	deviceIndex uint32
+	offsetBytes int64
+}
+
+func newBlockLocationKey(blockLocation *pb.BlockLocation) blockLocationKey {
+	return blockLocationKey{
+		deviceIndex: blockLocation.GetDeviceIndex(),
+		offsetBytes: blockLocation.GetOffsetBytes(),
+	}
+}
+
+// ReplayJournal computes the state of the blocks and the key-location
+// map at the time the journal was last synchronized, based on the
+// persistent state that was written last and the records contained in
+// the journal.
+//
+// Blocks that were added after the persistent state was written are
+// appended to the list of blocks, and the write offsets of blocks are
+// adjusted to cover all objects that were written to them. A new epoch
+// is added to the last block, which should be used to recreate records
+// in the key-location map for all of the entries that are returned.
+// The block indices of these entries correspond to the list of blocks
+// that is returned. Entries with tombstone locations correspond to
+// objects that have been invalidated.
+func ReplayJournal(initialBlocks []*pb.BlockState, records []*pb.JournalRecord) ([]*pb.BlockState, []KeyLocationMapEntry) {
+	blocks := make([]*pb.BlockState, 0, len(initialBlocks))
+	blockIndices := map[blockLocationKey]int{}
+	for _, blockState := range initialBlocks {
+		blockIndices[newBlockLocationKey(blockState.BlockLocation)] = len(blocks)
+		blocks = append(blocks, proto.Clone(blockState).(*pb.BlockState))
+	}
+
+	// Blocks from which objects can be restored. Initially, this
+	// corresponds to the blocks stored in the persistent state.
+	// Every segment starts with a full list of blocks. Blocks
+	// that are removed must no longer be used, as any records
+	// referring to them may have become stale.
+	validBlocks := map[blockLocationKey]*pb.BlockLocation{}
+	for _, blockState := range initialBlocks {
+		validBlocks[newBlockLocationKey(blockState.BlockLocation)] = blockState.BlockLocation
+	}
+	var addedBlocks []blockLocationKey
+	addBlock := func(blockLocation *pb.BlockLocation) {
+		key := newBlockLocationKey(blockLocation)
+		if _, ok := validBlocks[key]; !ok {
+			validBlocks[key] = blockLocation
+			addedBlocks = append(addedBlocks, key)
+		}
+	}
+
+	type pendingEntry struct {
+		key         Key
+		block       blockLocationKey
+		offsetBytes int64
+		sizeBytes   int64
+	}
+	var pendingEntries []pendingEntry
+	removeBlocks := func(removedBlocks map[blockLocationKey]struct{}) {
+		// Discard all objects stored in the removed blocks.
+		remainingEntries := pendingEntries[:0]
+		for _, pendingEntry := range pendingEntries {
+			if _, ok := removedBlocks[pendingEntry.block]; !ok || pendingEntry.sizeBytes == tombstoneSizeBytes {
+				remainingEntries = append(remainingEntries, pendingEntry)
+			}
+		}
+		pendingEntries = remainingEntries
+
+		remainingBlocks := addedBlocks[:0]
+		for _, key := range addedBlocks {
+			if _, ok := removedBlocks[key]; !ok {
+				remainingBlocks = append(remainingBlocks, key)
+			}
+		}
+		addedBlocks = remainingBlocks
+
+		for key := range removedBlocks {
+			delete(validBlocks, key)
+		}
+	}
+
+	for _, record := range records {
+		switch kind := record.Kind.(type) {
+		case *pb.JournalRecord_SegmentStart_:
+			segmentBlocks := map[blockLocationKey]struct{}{}
+			for _, blockLocation := range kind.SegmentStart.Blocks {
+				segmentBlocks[newBlockLocationKey(blockLocation)] = struct{}{}
+			}
+			removedBlocks := map[blockLocationKey]struct{}{}
+			for key := range validBlocks {
+				if _, ok := segmentBlocks[key]; !ok {
+					removedBlocks[key] = struct{}{}
+				}
+			}
+			removeBlocks(removedBlocks)
+			for _, blockLocation := range kind.SegmentStart.Blocks {
+				addBlock(blockLocation)
+			}
+		case *pb.JournalRecord_BlockPushed:
+			addBlock(kind.BlockPushed)
+		case *pb.JournalRecord_BlockPopped:
+			removeBlocks(map[blockLocationKey]struct{}{
+				newBlockLocationKey(kind.BlockPopped): {},
+			})
+		case *pb.JournalRecord_KeyLocationMapPut_:
+			put := kind.KeyLocationMapPut
+			var key Key
+			if len(put.Key) != len(key) {
+				continue
+			}
+			copy(key[:], put.Key)
+			if put.SizeBytes == tombstoneSizeBytes {
+				pendingEntries = append(pendingEntries, pendingEntry{
+					key:       key,
+					sizeBytes: tombstoneSizeBytes,
+				})
+			} else if put.BlockLocation != nil {
+				block := newBlockLocationKey(put.BlockLocation)
+				if _, ok := validBlocks[block]; ok {
+					pendingEntries = append(pendingEntries, pendingEntry{
+						key:         key,
+						block:       block,
+						offsetBytes: put.OffsetBytes,
+						sizeBytes:   put.SizeBytes,
+					})
+				}
+			}
+		}
+	}
+
+	// Append blocks that were added after the persistent state was
+	// written, in the order in which they were added.
+	for _, key := range addedBlocks {
+		if _, ok := blockIndices[key]; !ok {
+			blockIndices[key] = len(blocks)
+			blocks = append(blocks, &pb.BlockState{
+				BlockLocation: validBlocks[key],
+			})
+		}
+	}
+
+	// Convert the records to entries in the key-location map,
+	// while ensuring that the write offsets of blocks cover all
+	// objects.
+	entries := make([]KeyLocationMapEntry, 0, len(pendingEntries))
+	hasObjects := false
+	for _, pendingEntry := range pendingEntries {
+		if pendingEntry.sizeBytes == tombstoneSizeBytes {
+			entries = append(entries, KeyLocationMapEntry{
+				Key:      pendingEntry.key,
+				Location: Location{SizeBytes: tombstoneSizeBytes},
+			})
+			continue
+		}
+		blockIndex, ok := blockIndices[pendingEntry.block]
+		if !ok {
+			continue
+		}
+		blockState := blocks[blockIndex]
+		if writeOffsetBytes := pendingEntry.offsetBytes + pendingEntry.sizeBytes; blockState.WriteOffsetBytes < writeOffsetBytes {
+			blockState.WriteOffsetBytes = writeOffsetBytes
+		}
+		entries = append(entries, KeyLocationMapEntry{
+			Key: pendingEntry.key,
+			Location: Location{
+				BlockIndex:  blockIndex,
+				OffsetBytes: pendingEntry.offsetBytes,
+				SizeBytes:   pendingEntry.sizeBytes,
+			},
+		})
+		hasObjects = true
+	}
+
+	// Create a new epoch that can be used to reference all of the
+	// restored objects.
+	if hasObjects {
+		lastBlock := blocks[len(blocks)-1]
+		lastBlock.EpochHashSeeds = append(lastBlock.EpochHashSeeds, random.CryptoThreadSafeGenerator.Uint64())
+	}
+	return blocks, entries
+}
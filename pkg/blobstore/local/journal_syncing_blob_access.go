@@ -0,0 +1,50 @@
+package local
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type journalSyncingBlobAccess struct {
+	blobstore.BlobAccess
+	journal Journal
+}
+
+// NewJournalSyncingBlobAccess creates a decorator for BlobAccess that
+// synchronizes a Journal after objects are written or invalidated.
+// This ensures that these calls only return after the changes they
+// made are durable, meaning they are not lost if the process crashes.
+//
+// Refreshes of objects triggered by Get() and FindMissing() are not
+// synchronized explicitly, as losing these only causes objects to be
+// retained for a shorter amount of time.
+func NewJournalSyncingBlobAccess(base blobstore.BlobAccess, journal Journal) blobstore.BlobAccess {
+	return &journalSyncingBlobAccess{
+		BlobAccess: base,
+		journal:    journal,
+	}
+}
+
+func (ba *journalSyncingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	if err := ba.BlobAccess.Put(ctx, digest, b); err != nil {
+		return err
+	}
+	if err := ba.journal.Sync(); err != nil {
+		return util.StatusWrap(err, "Failed to synchronize journal")
+	}
+	return nil
+}
+
+func (ba *journalSyncingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	if err := ba.BlobAccess.Invalidate(ctx, digests); err != nil {
+		return err
+	}
+	if err := ba.journal.Sync(); err != nil {
+		return util.StatusWrap(err, "Failed to synchronize journal")
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package local_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newJournalPutRecord(key local.Key, blockLocation *pb.BlockLocation, offsetBytes, sizeBytes int64) *pb.JournalRecord {
+	return &pb.JournalRecord{
+		Kind: &pb.JournalRecord_KeyLocationMapPut_{
+			KeyLocationMapPut: &pb.JournalRecord_KeyLocationMapPut{
+				Key:           key[:],
+				BlockLocation: blockLocation,
+				OffsetBytes:   offsetBytes,
+				SizeBytes:     sizeBytes,
+			},
+		},
+	}
+}
+
+func TestReplayJournal(t *testing.T) {
+	blockLocation1 := &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 1000}
+	blockLocation2 := &pb.BlockLocation{OffsetBytes: 1000, SizeBytes: 1000}
+	blockLocation3 := &pb.BlockLocation{OffsetBytes: 2000, SizeBytes: 1000}
+	key1 := local.NewKeyFromString("1")
+	key2 := local.NewKeyFromString("2")
+	key3 := local.NewKeyFromString("3")
+
+	t.Run("Empty", func(t *testing.T) {
+		// Without any records, the persistent state should be
+		// returned as is. No epochs should be added.
+		initialBlocks := []*pb.BlockState{{
+			BlockLocation:    blockLocation1,
+			WriteOffsetBytes: 100,
+			EpochHashSeeds:   []uint64{123},
+		}}
+		blocks, entries := local.ReplayJournal(initialBlocks, nil)
+		require.Len(t, blocks, 1)
+		testutil.RequireEqualProto(t, initialBlocks[0], blocks[0])
+		require.Empty(t, entries)
+	})
+
+	t.Run("ObjectsInExistingAndNewBlocks", func(t *testing.T) {
+		initialBlocks := []*pb.BlockState{{
+			BlockLocation:    blockLocation1,
+			WriteOffsetBytes: 100,
+			EpochHashSeeds:   []uint64{123},
+		}}
+		blocks, entries := local.ReplayJournal(initialBlocks, []*pb.JournalRecord{
+			{
+				Kind: &pb.JournalRecord_SegmentStart_{
+					SegmentStart: &pb.JournalRecord_SegmentStart{
+						Blocks: []*pb.BlockLocation{blockLocation1},
+					},
+				},
+			},
+			newJournalPutRecord(key1, blockLocation1, 100, 50),
+			{Kind: &pb.JournalRecord_BlockPushed{BlockPushed: blockLocation2}},
+			newJournalPutRecord(key2, blockLocation2, 0, 30),
+			newJournalPutRecord(key3, nil, 0, -1),
+		})
+
+		// Both blocks should be returned, having their write
+		// offsets extended to cover the objects. A new epoch
+		// should be attached to the last block.
+		require.Len(t, blocks, 2)
+		testutil.RequireEqualProto(t, &pb.BlockState{
+			BlockLocation:    blockLocation1,
+			WriteOffsetBytes: 150,
+			EpochHashSeeds:   []uint64{123},
+		}, blocks[0])
+		testutil.RequireEqualProto(t, blockLocation2, blocks[1].BlockLocation)
+		require.Equal(t, int64(30), blocks[1].WriteOffsetBytes)
+		require.Len(t, blocks[1].EpochHashSeeds, 1)
+
+		// The original persistent state should not be modified.
+		require.Equal(t, int64(100), initialBlocks[0].WriteOffsetBytes)
+
+		require.Equal(t, []local.KeyLocationMapEntry{
+			{Key: key1, Location: local.Location{BlockIndex: 0, OffsetBytes: 100, SizeBytes: 50}},
+			{Key: key2, Location: local.Location{BlockIndex: 1, OffsetBytes: 0, SizeBytes: 30}},
+			{Key: key3, Location: local.Location{SizeBytes: -1}},
+		}, entries)
+	})
+
+	t.Run("BlockPopped", func(t *testing.T) {
+		// Objects stored in a block that was removed afterwards
+		// must not be restored, as the block may have been
+		// reused to store other data.
+		initialBlocks := []*pb.BlockState{
+			{
+				BlockLocation:    blockLocation1,
+				WriteOffsetBytes: 100,
+				EpochHashSeeds:   []uint64{123},
+			},
+			{
+				BlockLocation:    blockLocation2,
+				WriteOffsetBytes: 100,
+				EpochHashSeeds:   []uint64{456},
+			},
+		}
+		blocks, entries := local.ReplayJournal(initialBlocks, []*pb.JournalRecord{
+			newJournalPutRecord(key1, blockLocation1, 100, 50),
+			newJournalPutRecord(key2, blockLocation2, 100, 50),
+			{Kind: &pb.JournalRecord_BlockPopped{BlockPopped: blockLocation1}},
+			{Kind: &pb.JournalRecord_BlockPushed{BlockPushed: blockLocation3}},
+			newJournalPutRecord(key1, blockLocation1, 200, 50),
+			newJournalPutRecord(key3, blockLocation3, 0, 10),
+		})
+
+		require.Len(t, blocks, 3)
+		require.Equal(t, int64(100), blocks[0].WriteOffsetBytes)
+		require.Equal(t, int64(150), blocks[1].WriteOffsetBytes)
+		testutil.RequireEqualProto(t, blockLocation3, blocks[2].BlockLocation)
+		require.Equal(t, int64(10), blocks[2].WriteOffsetBytes)
+		require.Len(t, blocks[2].EpochHashSeeds, 1)
+
+		require.Equal(t, []local.KeyLocationMapEntry{
+			{Key: key2, Location: local.Location{BlockIndex: 1, OffsetBytes: 100, SizeBytes: 50}},
+			{Key: key3, Location: local.Location{BlockIndex: 2, OffsetBytes: 0, SizeBytes: 10}},
+		}, entries)
+	})
+
+	t.Run("SegmentStartRemovesBlocks", func(t *testing.T) {
+		// Segments may be retained after the persistent state
+		// has been updated, because removing them failed. The
+		// list of blocks at the start of successive segments
+		// should cause stale records to be discarded.
+		blocks, entries := local.ReplayJournal(nil, []*pb.JournalRecord{
+			{
+				Kind: &pb.JournalRecord_SegmentStart_{
+					SegmentStart: &pb.JournalRecord_SegmentStart{
+						Blocks: []*pb.BlockLocation{blockLocation1, blockLocation2},
+					},
+				},
+			},
+			newJournalPutRecord(key1, blockLocation1, 0, 50),
+			newJournalPutRecord(key2, blockLocation2, 0, 50),
+			{
+				Kind: &pb.JournalRecord_SegmentStart_{
+					SegmentStart: &pb.JournalRecord_SegmentStart{
+						Blocks: []*pb.BlockLocation{blockLocation2},
+					},
+				},
+			},
+		})
+
+		require.Len(t, blocks, 1)
+		testutil.RequireEqualProto(t, blockLocation2, blocks[0].BlockLocation)
+		require.Equal(t, int64(50), blocks[0].WriteOffsetBytes)
+		require.Equal(t, []local.KeyLocationMapEntry{
+			{Key: key2, Location: local.Location{BlockIndex: 0, OffsetBytes: 0, SizeBytes: 50}},
+		}, entries)
+	})
+}
@@ -0,0 +1,37 @@
+package local
+
+type journalingKeyLocationMap struct {
+	KeyLocationMap
+	blockList *PersistentBlockList
+}
+
+// NewJournalingKeyLocationMap creates a decorator for KeyLocationMap
+// that records all entries that are created or deleted in the journal
+// of a PersistentBlockList. Upon startup, these entries can be
+// recreated by calling ReplayJournal().
+//
+// Like calls against the underlying KeyLocationMap, calls to Put() and
+// Delete() must be performed while holding a write lock on the
+// PersistentBlockList.
+func NewJournalingKeyLocationMap(base KeyLocationMap, blockList *PersistentBlockList) KeyLocationMap {
+	return &journalingKeyLocationMap{
+		KeyLocationMap: base,
+		blockList:      blockList,
+	}
+}
+
+func (klm *journalingKeyLocationMap) Put(key Key, location Location) error {
+	if err := klm.KeyLocationMap.Put(key, location); err != nil {
+		return err
+	}
+	klm.blockList.appendKeyLocationMapJournalRecord(key, location)
+	return nil
+}
+
+func (klm *journalingKeyLocationMap) Delete(key Key) error {
+	if err := klm.KeyLocationMap.Delete(key); err != nil {
+		return err
+	}
+	klm.blockList.appendKeyLocationMapJournalRecord(key, Location{SizeBytes: tombstoneSizeBytes})
+	return nil
+}
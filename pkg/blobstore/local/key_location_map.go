@@ -8,4 +8,28 @@ package local
 type KeyLocationMap interface {
 	Get(key Key) (Location, error)
 	Put(key Key, location Location) error
+
+	// Delete an entry from the KeyLocationMap, causing subsequent
+	// calls to Get() to fail with NOT_FOUND. The entry can be
+	// recreated by calling Put() with a Location that is newer
+	// than the one that was deleted.
+	Delete(key Key) error
+
+	// List entries stored in the KeyLocationMap. As the number of
+	// entries may be large, listing is performed incrementally.
+	// Every call examines up to recordsCount records, starting at
+	// the provided position, and returns the entries that are
+	// present. Listing starts at position zero and has completed
+	// when zero is returned as the next position.
+	//
+	// Entries that are inserted or deleted while listing is in
+	// progress may or may not be returned. Entries may also be
+	// returned more than once.
+	List(position, recordsCount int) ([]KeyLocationMapEntry, int, error)
+}
+
+// KeyLocationMapEntry is an entry returned by KeyLocationMap.List().
+type KeyLocationMapEntry struct {
+	Key      Key
+	Location Location
 }
@@ -15,3 +15,16 @@ type Location struct {
 func (a Location) IsOlder(b Location) bool {
 	return a.BlockIndex < b.BlockIndex || (a.BlockIndex == b.BlockIndex && a.OffsetBytes < b.OffsetBytes)
 }
+
+// tombstoneSizeBytes is the size that is stored in Locations of
+// records in the key-location map that correspond to objects that have
+// been invalidated. Such records cannot simply be cleared, as that
+// would cause lookups for other keys to terminate prematurely.
+const tombstoneSizeBytes = -1
+
+// IsTombstone returns true if the Location does not refer to any data,
+// because it is stored in a record in the key-location map that
+// corresponds to an object that has been invalidated.
+func (a Location) IsTombstone() bool {
+	return a.SizeBytes == tombstoneSizeBytes
+}
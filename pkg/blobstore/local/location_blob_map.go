@@ -40,9 +40,9 @@ type LocationBlobPutFinalizer func() (Location, error)
 // remain valid over time), all Locations provided to Get() must be
 // validated using a BlockReferenceResolver.
 //
-// LocationBlobMap is only partially thread-safe. LocationBlobMap.Get()
-// and LocationBlobGetter can be invoked in parallel (e.g., under a read
-// lock), while LocationBlobMap.Put() and LocationBlobPutFinalizer must
+// LocationBlobMap is only partially thread-safe. LocationBlobMap.Get(),
+// LocationBlobMap.GetUnvalidated() and LocationBlobGetter can be
+// invoked in parallel (e.g., under a read lock), while LocationBlobMap.Put() and LocationBlobPutFinalizer must
 // run exclusively (e.g., under a write lock). LocationBlobPutWriter is
 // safe to call without holding any locks.
 type LocationBlobMap interface {
@@ -61,6 +61,14 @@ type LocationBlobMap interface {
 	// LocationBlobGetters is invoked.
 	Get(location Location) (LocationBlobGetter, bool)
 
+	// GetUnvalidated obtains the data associated with a blob,
+	// without performing any checksum validation. As the digest of
+	// the blob does not need to be provided, this can be used to
+	// enumerate the contents of storage. Data corruption is not
+	// reported, meaning that callers need to validate the data
+	// themselves.
+	GetUnvalidated(location Location) buffer.ChunkReader
+
 	// Put a new blob to storage.
 	//
 	// This function returns a LocationBlobPutWriter, which must be
@@ -27,6 +27,10 @@ var (
 		[]string{"storage_type"})
 )
 
+// oldCurrentNewLocationBlobMapChunkSizeBytes is the maximum size of
+// the chunks returned by GetUnvalidated().
+const oldCurrentNewLocationBlobMapChunkSizeBytes = 64 * 1024
+
 type oldBlockState struct {
 	insertionTime float64
 }
@@ -248,6 +252,13 @@ func (lbm *OldCurrentNewLocationBlobMap) Get(location Location) (LocationBlobGet
 	}, location.BlockIndex < len(lbm.oldBlocks)
 }
 
+// GetUnvalidated obtains the data associated with a blob, without
+// performing any checksum validation.
+func (lbm *OldCurrentNewLocationBlobMap) GetUnvalidated(location Location) buffer.ChunkReader {
+	return lbm.blockList.GetUnvalidated(location.BlockIndex, location.OffsetBytes, location.SizeBytes).
+		ToChunkReader(0, oldCurrentNewLocationBlobMapChunkSizeBytes)
+}
+
 // resetAllocationBlockIndex resets the counters used to determine from
 // which "new" block to allocate data. This causes the next allocation
 // to be performed against the first "new" block.
@@ -20,7 +20,9 @@ type PeriodicSyncer struct {
 	errorRetryInterval               time.Duration
 	minimumEpochInterval             time.Duration
 	keyLocationMapHashInitialization uint64
+	keyLocationMapRecordsCount       int
 	dataSyncer                       DataSyncer
+	journal                          Journal
 
 	sourceLock *sync.RWMutex
 	source     PersistentStateSource
@@ -42,14 +44,20 @@ type DataSyncer func() error
 
 // NewPeriodicSyncer creates a new PeriodicSyncer according to the
 // arguments provided.
-func NewPeriodicSyncer(source PersistentStateSource, sourceLock *sync.RWMutex, store PersistentStateStore, clock clock.Clock, errorLogger util.ErrorLogger, errorRetryInterval, minimumEpochInterval time.Duration, keyLocationMapHashInitialization uint64, dataSyncer DataSyncer) *PeriodicSyncer {
+//
+// If the PersistentStateSource writes records into a Journal, it
+// should be provided as well. This ensures that the removal of blocks
+// has been recorded in the journal before the blocks are reused.
+func NewPeriodicSyncer(source PersistentStateSource, sourceLock *sync.RWMutex, store PersistentStateStore, clock clock.Clock, errorLogger util.ErrorLogger, errorRetryInterval, minimumEpochInterval time.Duration, keyLocationMapHashInitialization uint64, keyLocationMapRecordsCount int, dataSyncer DataSyncer, journal Journal) *PeriodicSyncer {
 	return &PeriodicSyncer{
 		clock:                            clock,
 		errorLogger:                      errorLogger,
 		errorRetryInterval:               errorRetryInterval,
 		minimumEpochInterval:             minimumEpochInterval,
 		keyLocationMapHashInitialization: keyLocationMapHashInitialization,
+		keyLocationMapRecordsCount:       keyLocationMapRecordsCount,
 		dataSyncer:                       dataSyncer,
+		journal:                          journal,
 
 		source:                  source,
 		sourceLock:              sourceLock,
@@ -80,9 +88,15 @@ func (ps *PeriodicSyncer) writePersistentState() error {
 		OldestEpochId:                    oldestEpochID,
 		Blocks:                           blocks,
 		KeyLocationMapHashInitialization: ps.keyLocationMapHashInitialization,
+		KeyLocationMapRecordsCount:       int64(ps.keyLocationMapRecordsCount),
 	}); err != nil {
 		return err
 	}
+	if ps.journal != nil {
+		if err := ps.journal.Sync(); err != nil {
+			return util.StatusWrap(err, "Failed to synchronize journal")
+		}
+	}
 
 	ps.sourceLock.Lock()
 	ps.source.NotifyPersistentStateWritten()
@@ -37,7 +37,9 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 		30*time.Second,
 		time.Minute,
 		0xdf280dd45b2c39e,
-		dataSyncer.Call)
+		1009,
+		dataSyncer.Call,
+		nil)
 
 	blockReleaseWakeup := make(chan struct{}, 1)
 	close(blockReleaseWakeup)
@@ -76,6 +78,7 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 				},
 			},
 			KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+			KeyLocationMapRecordsCount:       1009,
 		}).Return(status.Error(codes.Internal, "Permission denied")),
 
 		// When the above fails, we should wait a bit before
@@ -109,6 +112,7 @@ func TestPeriodicSyncerProcessBlockRelease(t *testing.T) {
 				},
 			},
 			KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+			KeyLocationMapRecordsCount:       1009,
 		}),
 
 		// Upon success, PersistentBlockList should be notified,
@@ -137,7 +141,9 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 		30*time.Second,
 		time.Minute,
 		0xdf280dd45b2c39e,
-		dataSyncer.Call)
+		1009,
+		dataSyncer.Call,
+		nil)
 
 	exampleBlockState := []*pb.BlockState{
 		{
@@ -199,6 +205,7 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 				OldestEpochId:                    7,
 				Blocks:                           exampleBlockState,
 				KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+				KeyLocationMapRecordsCount:       1009,
 			}),
 			source.EXPECT().NotifyPersistentStateWritten())
 
@@ -228,6 +235,7 @@ func TestPeriodicSyncerProcessBlockPut(t *testing.T) {
 				OldestEpochId:                    13,
 				Blocks:                           exampleBlockState,
 				KeyLocationMapHashInitialization: 0xdf280dd45b2c39e,
+				KeyLocationMapRecordsCount:       1009,
 			}),
 			source.EXPECT().NotifyPersistentStateWritten())
 
@@ -125,15 +125,29 @@ type PersistentBlockList struct {
 	blocksToRelease    []Block
 	blocksReleasing    int
 	blockReleaseWakeup notificationChannel
+
+	// If a write-ahead journal is used, the identifiers of the
+	// journal segments that were started when data was last
+	// synchronized. Segments preceding the one whose data is
+	// covered by the persistent state may be removed.
+	journal                       Journal
+	journalSynchronizingSegmentID uint64
+	journalSynchronizedSegmentID  uint64
+	journalReleasingSegmentID     uint64
 }
 
 // NewPersistentBlockList provides an implementation of BlockList whose
 // state can be persisted. This makes it possible to preserve the
 // contents of FlatBlobAccess and HierarchicalCASBlobAccess across
 // restarts.
-func NewPersistentBlockList(blockAllocator BlockAllocator, initialOldestEpochID uint32, initialBlocks []*pb.BlockState) (*PersistentBlockList, int) {
+//
+// If a Journal is provided, all changes to the list of blocks are
+// recorded in it, so that objects stored in blocks that were added
+// after the persistent state was written can be restored.
+func NewPersistentBlockList(blockAllocator BlockAllocator, journal Journal, initialOldestEpochID uint32, initialBlocks []*pb.BlockState) (*PersistentBlockList, int) {
 	bl := &PersistentBlockList{
 		blockAllocator: blockAllocator,
+		journal:        journal,
 
 		blockPutWakeup:     newNotificationChannel(),
 		blockReleaseWakeup: newNotificationChannel(),
@@ -168,6 +182,13 @@ func NewPersistentBlockList(blockAllocator BlockAllocator, initialOldestEpochID
 	bl.oldestEpochID = initialOldestEpochID
 	bl.synchronizingEpochs = len(bl.epochHashSeeds)
 	bl.synchronizedEpochs = len(bl.epochHashSeeds)
+
+	// Records in the journal that were written by a previous run
+	// may refer to blocks that could not be restored. Start a new
+	// segment, so that these blocks are no longer considered.
+	if journal != nil {
+		journal.StartSegment(bl.getBlockLocations())
+	}
 	return bl, len(bl.blocks)
 }
 
@@ -206,6 +227,35 @@ func (bl *PersistentBlockList) BlockIndexToBlockReference(blockIndex int) (Block
 	}, bl.epochHashSeeds[lastEpochIndex]
 }
 
+func (bl *PersistentBlockList) getBlockLocations() []*pb.BlockLocation {
+	blockLocations := make([]*pb.BlockLocation, 0, len(bl.blocks))
+	for _, blockInfo := range bl.blocks {
+		blockLocations = append(blockLocations, blockInfo.blockLocation)
+	}
+	return blockLocations
+}
+
+// appendKeyLocationMapJournalRecord records that an entry in the
+// key-location map was created or deleted in the journal.
+func (bl *PersistentBlockList) appendKeyLocationMapJournalRecord(key Key, location Location) {
+	if bl.journal == nil {
+		return
+	}
+	put := &pb.JournalRecord_KeyLocationMapPut{
+		Key:       key[:],
+		SizeBytes: location.SizeBytes,
+	}
+	if !location.IsTombstone() {
+		put.BlockLocation = bl.blocks[location.BlockIndex].blockLocation
+		put.OffsetBytes = location.OffsetBytes
+	}
+	bl.journal.Append(&pb.JournalRecord{
+		Kind: &pb.JournalRecord_KeyLocationMapPut_{
+			KeyLocationMapPut: put,
+		},
+	})
+}
+
 // PopFront removes the oldest block from the BlockList, having index
 // zero.
 func (bl *PersistentBlockList) PopFront() {
@@ -216,6 +266,13 @@ func (bl *PersistentBlockList) PopFront() {
 	// PushBack() may start to fail otherwise.
 	firstBlock := &bl.blocks[0]
 	bl.blocks = bl.blocks[1:]
+	if bl.journal != nil {
+		bl.journal.Append(&pb.JournalRecord{
+			Kind: &pb.JournalRecord_BlockPopped{
+				BlockPopped: firstBlock.blockLocation,
+			},
+		})
+	}
 	bl.blocksToRelease = append(bl.blocksToRelease, firstBlock.block)
 	firstBlock.block = nil
 	bl.blockReleaseWakeup.unblock()
@@ -260,6 +317,13 @@ func (bl *PersistentBlockList) PushBack() error {
 		block:         block,
 		blockLocation: location,
 	})
+	if bl.journal != nil {
+		bl.journal.Append(&pb.JournalRecord{
+			Kind: &pb.JournalRecord_BlockPushed{
+				BlockPushed: location,
+			},
+		})
+	}
 	return nil
 }
 
@@ -268,6 +332,12 @@ func (bl *PersistentBlockList) Get(index int, digest digest.Digest, offsetBytes,
 	return bl.blocks[index].block.Get(digest, offsetBytes, sizeBytes, dataIntegrityCallback)
 }
 
+// GetUnvalidated obtains data from one of the blocks managed by this
+// BlockList, without performing any checksum validation.
+func (bl *PersistentBlockList) GetUnvalidated(index int, offsetBytes, sizeBytes int64) buffer.Buffer {
+	return bl.blocks[index].block.GetUnvalidated(offsetBytes, sizeBytes)
+}
+
 // HasSpace returns whether a block with a given index has sufficient
 // space to store a blob of a given size.
 func (bl *PersistentBlockList) HasSpace(index int, sizeBytes int64) bool {
@@ -370,6 +440,13 @@ func (bl *PersistentBlockList) NotifySyncStarting(isFinalSync bool) {
 	for i := range bl.blocks {
 		bl.blocks[i].synchronizingOffsetBytes = bl.blocks[i].writtenOffsetBytes
 	}
+
+	// Successive changes are recorded in a new journal segment,
+	// so that existing segments can be removed once the data is
+	// covered by the persistent state.
+	if bl.journal != nil {
+		bl.journalSynchronizingSegmentID = bl.journal.StartSegment(bl.getBlockLocations())
+	}
 }
 
 // NotifySyncCompleted needs to be called right after the data on the
@@ -386,6 +463,7 @@ func (bl *PersistentBlockList) NotifySyncCompleted() {
 	for i := range bl.blocks {
 		bl.blocks[i].synchronizedOffsetBytes = bl.blocks[i].synchronizingOffsetBytes
 	}
+	bl.journalSynchronizedSegmentID = bl.journalSynchronizingSegmentID
 }
 
 // GetPersistentState returns information that needs to be persisted to
@@ -423,6 +501,13 @@ func (bl *PersistentBlockList) GetPersistentState() (uint32, []*pb.BlockState) {
 	// from our bookkeeping, thereby allowing PushBack() to start
 	// using those blocks again.
 	bl.blocksReleasing = len(bl.blocksToRelease)
+
+	// Similarly, store up to which journal segment the data is
+	// covered by the persistent state. Segments only need to be
+	// removed once. If writing the persistent state fails, they
+	// are removed after data is synchronized once more.
+	bl.journalReleasingSegmentID = bl.journalSynchronizedSegmentID
+	bl.journalSynchronizedSegmentID = 0
 	return bl.oldestEpochID, blocks
 }
 
@@ -442,4 +527,12 @@ func (bl *PersistentBlockList) NotifyPersistentStateWritten() {
 	if len(bl.blocksToRelease) == 0 {
 		bl.blockReleaseWakeup.block()
 	}
+
+	// Journal segments preceding the one that was started when
+	// the data contained in the persistent state was synchronized
+	// are no longer needed.
+	if bl.journalReleasingSegmentID > 0 {
+		bl.journal.RemoveSegmentsBefore(bl.journalReleasingSegmentID)
+		bl.journalReleasingSegmentID = 0
+	}
 }
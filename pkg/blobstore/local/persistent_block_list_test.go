@@ -20,7 +20,7 @@ func TestPersistentBlockListPersistentState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockAllocator := mock.NewMockBlockAllocator(ctrl)
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 1, nil)
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 1, nil)
 	require.Equal(t, 0, blocksRestored)
 
 	// The persistent state should match up with how the BlockList
@@ -255,7 +255,7 @@ func TestPersistentBlockListPutInterruptedByPopFront(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockAllocator := mock.NewMockBlockAllocator(ctrl)
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 0, nil)
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 0, nil)
 	require.Equal(t, 0, blocksRestored)
 
 	// Attach a Block to the BlockList in which we're going to write
@@ -322,7 +322,7 @@ func TestPersistentBlockListRestorePersistentState(t *testing.T) {
 		SizeBytes:   160,
 	}, int64(103)).Return(block2, true)
 
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 5, []*pb.BlockState{
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 5, []*pb.BlockState{
 		{
 			BlockLocation: &pb.BlockLocation{
 				OffsetBytes: 0,
@@ -380,7 +380,7 @@ func TestPersistentBlockListRestorePersistentState(t *testing.T) {
 }
 
 func TestPersistentBlockListPushBackAfterFinalSync(t *testing.T) {
-	blockList, _ := local.NewPersistentBlockList(nil, 0, nil)
+	blockList, _ := local.NewPersistentBlockList(nil, nil, 0, nil)
 
 	blockList.NotifySyncStarting(true)
 
@@ -397,7 +397,7 @@ func TestPersistentBlockListPutAfterFinalSync1(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockAllocator := mock.NewMockBlockAllocator(ctrl)
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 0, nil)
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 0, nil)
 	require.Equal(t, 0, blocksRestored)
 
 	// Attach a Block to the BlockList in which we're going to write
@@ -440,7 +440,7 @@ func TestPersistentBlockListPutAfterFinalSync2(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockAllocator := mock.NewMockBlockAllocator(ctrl)
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 0, nil)
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 0, nil)
 	require.Equal(t, 0, blocksRestored)
 
 	// Attach a Block to the BlockList in which we're going to write
@@ -495,7 +495,7 @@ func TestPersistentBlockListPutAfterFinalSync3(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	blockAllocator := mock.NewMockBlockAllocator(ctrl)
-	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, 0, nil)
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, nil, 0, nil)
 	require.Equal(t, 0, blocksRestored)
 
 	// Attach a Block to the BlockList in which we're going to write
@@ -556,3 +556,91 @@ func verifyEpochIDStaysAtZero(t *testing.T, blockList *local.PersistentBlockList
 	require.Equal(t, uint32(0), oldestEpochID)
 	require.Empty(t, blockStateList)
 }
+
+func TestPersistentBlockListJournal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Creating the BlockList should cause a new journal segment to
+	// be started, so that records written by a previous run that
+	// refer to blocks that could not be restored are discarded.
+	blockAllocator := mock.NewMockBlockAllocator(ctrl)
+	journal := mock.NewMockJournal(ctrl)
+	journal.EXPECT().StartSegment(gomock.Len(0)).Return(uint64(7))
+	blockList, blocksRestored := local.NewPersistentBlockList(blockAllocator, journal, 1, nil)
+	require.Equal(t, 0, blocksRestored)
+
+	// Adding blocks should be recorded.
+	block1 := mock.NewMockBlock(ctrl)
+	blockLocation1 := &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 160}
+	blockAllocator.EXPECT().NewBlock().Return(block1, blockLocation1, nil)
+	journal.EXPECT().Append(testutil.EqProto(t, &pb.JournalRecord{
+		Kind: &pb.JournalRecord_BlockPushed{BlockPushed: blockLocation1},
+	}))
+	require.NoError(t, blockList.PushBack())
+
+	block2 := mock.NewMockBlock(ctrl)
+	blockLocation2 := &pb.BlockLocation{OffsetBytes: 160, SizeBytes: 160}
+	blockAllocator.EXPECT().NewBlock().Return(block2, blockLocation2, nil)
+	journal.EXPECT().Append(testutil.EqProto(t, &pb.JournalRecord{
+		Kind: &pb.JournalRecord_BlockPushed{BlockPushed: blockLocation2},
+	}))
+	require.NoError(t, blockList.PushBack())
+
+	// Entries created in the key-location map should be recorded,
+	// using the location of the block instead of its index.
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	journalingKeyLocationMap := local.NewJournalingKeyLocationMap(keyLocationMap, blockList)
+	key1 := local.NewKeyFromString("1")
+	location1 := local.Location{BlockIndex: 1, OffsetBytes: 10, SizeBytes: 20}
+	keyLocationMap.EXPECT().Put(key1, location1)
+	journal.EXPECT().Append(testutil.EqProto(t, &pb.JournalRecord{
+		Kind: &pb.JournalRecord_KeyLocationMapPut_{
+			KeyLocationMapPut: &pb.JournalRecord_KeyLocationMapPut{
+				Key:           key1[:],
+				BlockLocation: blockLocation2,
+				OffsetBytes:   10,
+				SizeBytes:     20,
+			},
+		},
+	}))
+	require.NoError(t, journalingKeyLocationMap.Put(key1, location1))
+
+	// Failed insertions should not be recorded.
+	keyLocationMap.EXPECT().Put(key1, location1).Return(status.Error(codes.Internal, "I/O error"))
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "I/O error"), journalingKeyLocationMap.Put(key1, location1))
+
+	// Deletions should be recorded as tombstones.
+	keyLocationMap.EXPECT().Delete(key1)
+	journal.EXPECT().Append(testutil.EqProto(t, &pb.JournalRecord{
+		Kind: &pb.JournalRecord_KeyLocationMapPut_{
+			KeyLocationMapPut: &pb.JournalRecord_KeyLocationMapPut{
+				Key:       key1[:],
+				SizeBytes: -1,
+			},
+		},
+	}))
+	require.NoError(t, journalingKeyLocationMap.Delete(key1))
+
+	// Synchronizing data should start a new segment. Once the
+	// persistent state has been written, older segments may be
+	// removed.
+	journal.EXPECT().StartSegment(gomock.Len(2)).Return(uint64(8))
+	blockList.NotifySyncStarting(false)
+	blockList.NotifySyncCompleted()
+	blockList.GetPersistentState()
+	journal.EXPECT().RemoveSegmentsBefore(uint64(8))
+	blockList.NotifyPersistentStateWritten()
+
+	// Removing blocks should be recorded.
+	journal.EXPECT().Append(testutil.EqProto(t, &pb.JournalRecord{
+		Kind: &pb.JournalRecord_BlockPopped{BlockPopped: blockLocation1},
+	}))
+	blockList.PopFront()
+
+	// Persistent state may be written without synchronizing data
+	// when blocks are released. This must not cause segments to be
+	// removed once more.
+	blockList.GetPersistentState()
+	block1.EXPECT().Release()
+	blockList.NotifyPersistentStateWritten()
+}
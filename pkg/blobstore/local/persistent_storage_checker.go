@@ -0,0 +1,285 @@
+package local
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// BlobContentsValidator is called by CheckPersistentStorage() to
+// validate that the contents of a blob correspond with the key under
+// which it is stored in the key-location map. As keys are irreversible
+// hashes of digests, validation can only be performed for objects
+// whose digest can be derived from their contents, such as the ones
+// stored in the Content Addressable Storage.
+//
+// The contents of the blob are provided in the form of an io.ReaderAt,
+// so that validators may read the data multiple times if needed.
+type BlobContentsValidator func(key Key, sizeBytes int64, r io.ReaderAt) (bool, error)
+
+// BlockReport contains statistics on a single block that is referenced
+// by the persistent state of a LocalBlobAccess.
+type BlockReport struct {
+	BlockLocation    *pb.BlockLocation
+	WriteOffsetBytes int64
+	EpochCount       int
+
+	// Number and total size of the blobs in this block that are
+	// referenced by valid records in the key-location map.
+	ValidRecords          int
+	ValidRecordsSizeBytes int64
+
+	// Number of records in the key-location map that point into
+	// this block, but are corrupted.
+	CorruptRecords int
+}
+
+// CorruptRecord describes a record in the key-location map that passed
+// checksum validation, but is inconsistent with the data it
+// references.
+type CorruptRecord struct {
+	Slot   int
+	Record LocationRecord
+	Reason string
+}
+
+// PersistentStorageReport contains the results of
+// CheckPersistentStorage().
+type PersistentStorageReport struct {
+	OldestEpochID uint32
+	EpochCount    int
+
+	// Blocks that can be restored from the persistent state. If the
+	// persistent state references blocks that are invalid, the
+	// invalid block and all of the blocks following it are
+	// discarded upon startup. InvalidBlocksReason contains the
+	// reason the first invalid block was rejected.
+	Blocks              []BlockReport
+	InvalidBlocks       int
+	InvalidBlocksReason string
+
+	// Number of records in the key-location map, and the
+	// classification of their contents. Empty records either have
+	// never been written or point to data that is no longer
+	// available. Deleted records are tombstones of objects that
+	// have been invalidated.
+	RecordsCount      int
+	EmptyRecords      int
+	DeletedRecords    int
+	VerifiedRecords   int
+	UnverifiedRecords int
+	CorruptRecords    []CorruptRecord
+}
+
+// checkingBlockAllocator is used by CheckPersistentStorage() to
+// determine which blocks referenced by the persistent state would be
+// accepted by BlockDeviceBackedBlockAllocator upon startup.
+type checkingBlockAllocator struct {
+	blockSizeBytes    int64
+	deviceBlockCounts []int
+	encryptionKeys    *EncryptionKeys
+	usedBlocks        map[checkedBlockLocation]struct{}
+	invalidReason     string
+}
+
+// checkedBlockLocation is used by checkingBlockAllocator to detect
+// blocks that are referenced multiple times.
+type checkedBlockLocation struct {
+	deviceIndex uint32
+	offsetBytes int64
+}
+
+func (ba *checkingBlockAllocator) NewBlock() (Block, *pb.BlockLocation, error) {
+	panic("Blocks cannot be allocated while checking persistent storage")
+}
+
+func (ba *checkingBlockAllocator) NewBlockAtLocation(location *pb.BlockLocation, writeOffsetBytes int64) (Block, bool) {
+	offsetBytes := location.GetOffsetBytes()
+	deviceIndex := location.GetDeviceIndex()
+	if deviceIndex >= uint32(len(ba.deviceBlockCounts)) {
+		ba.invalidReason = fmt.Sprintf("Block at offset %d is stored on block device %d, while only %d block devices are present", offsetBytes, deviceIndex, len(ba.deviceBlockCounts))
+		return nil, false
+	}
+	if location.GetSizeBytes() != ba.blockSizeBytes {
+		ba.invalidReason = fmt.Sprintf("Block at offset %d has size %d, while %d was expected", offsetBytes, location.GetSizeBytes(), ba.blockSizeBytes)
+		return nil, false
+	}
+	blockCount := ba.deviceBlockCounts[deviceIndex]
+	if offsetBytes < 0 || offsetBytes%ba.blockSizeBytes != 0 || offsetBytes/ba.blockSizeBytes >= int64(blockCount) {
+		ba.invalidReason = fmt.Sprintf("Block at offset %d does not correspond to any of the %d blocks of size %d", offsetBytes, blockCount, ba.blockSizeBytes)
+		return nil, false
+	}
+	usedBlock := checkedBlockLocation{deviceIndex: deviceIndex, offsetBytes: offsetBytes}
+	if _, ok := ba.usedBlocks[usedBlock]; ok {
+		ba.invalidReason = fmt.Sprintf("Block at offset %d is referenced multiple times", offsetBytes)
+		return nil, false
+	}
+	if writeOffsetBytes < 0 || writeOffsetBytes > ba.blockSizeBytes {
+		ba.invalidReason = fmt.Sprintf("Block at offset %d has write offset %d, which lies outside the block", offsetBytes, writeOffsetBytes)
+		return nil, false
+	}
+	if encryptionKeyID := location.GetEncryptionKeyId(); encryptionKeyID != 0 {
+		if ba.encryptionKeys == nil {
+			ba.invalidReason = fmt.Sprintf("Block at offset %d is encrypted using key %d, while encryption is disabled", offsetBytes, encryptionKeyID)
+			return nil, false
+		}
+		if _, ok := ba.encryptionKeys.getCipher(encryptionKeyID); !ok {
+			ba.invalidReason = fmt.Sprintf("Block at offset %d is encrypted using key %d, which is not provided", offsetBytes, encryptionKeyID)
+			return nil, false
+		}
+	}
+	ba.usedBlocks[usedBlock] = struct{}{}
+	return checkingBlock{}, true
+}
+
+// checkingBlock is returned by checkingBlockAllocator. As
+// CheckPersistentStorage() reads blobs from the block device directly,
+// none of its methods are called.
+type checkingBlock struct{}
+
+func (checkingBlock) Get(digest digest.Digest, offsetBytes, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	panic("Blobs cannot be read from blocks while checking persistent storage")
+}
+
+func (checkingBlock) GetUnvalidated(offsetBytes, sizeBytes int64) buffer.Buffer {
+	panic("Blobs cannot be read from blocks while checking persistent storage")
+}
+
+func (checkingBlock) HasSpace(sizeBytes int64) bool {
+	return false
+}
+
+func (checkingBlock) Put(sizeBytes int64) BlockPutWriter {
+	panic("Blobs cannot be written to blocks while checking persistent storage")
+}
+
+func (checkingBlock) Release() {}
+
+// CheckPersistentStorage performs an offline consistency check of the
+// data stored by a LocalBlobAccess that has persistency enabled. It
+// determines which blocks referenced by the persistent state can be
+// restored, and walks over all records in the key-location map to
+// validate that they reference data that is present.
+//
+// This function only reads from the block devices that are provided.
+// It is the responsibility of the caller to ensure that the storage is
+// not in use by another process. If the storage is encrypted, the keys
+// used to encrypt it must be provided. If blocks are striped across
+// multiple block devices, all block devices must be provided in the
+// same order as in the configuration, together with the number of
+// blocks stored on each of them.
+func CheckPersistentStorage(persistentState *pb.PersistentState, blocksDevices []io.ReaderAt, sectorSizeBytes int, blockSizeBytes int64, deviceBlockCounts []int, keyLocationMapDevice blockdevice.BlockDevice, recordsCount int, encryptionKeys *EncryptionKeys, validator BlobContentsValidator) (*PersistentStorageReport, error) {
+	// Restore the blocks in the same way as PersistentBlockList
+	// does upon startup. This ensures that block references stored
+	// in the key-location map are resolved identically.
+	blockAllocator := &checkingBlockAllocator{
+		blockSizeBytes:    blockSizeBytes,
+		deviceBlockCounts: deviceBlockCounts,
+		encryptionKeys:    encryptionKeys,
+		usedBlocks:        map[checkedBlockLocation]struct{}{},
+	}
+	blockList, validBlocksCount := NewPersistentBlockList(blockAllocator, nil, persistentState.OldestEpochId, persistentState.Blocks)
+
+	report := &PersistentStorageReport{
+		OldestEpochID:       persistentState.OldestEpochId,
+		InvalidBlocks:       len(persistentState.Blocks) - validBlocksCount,
+		InvalidBlocksReason: blockAllocator.invalidReason,
+		RecordsCount:        recordsCount,
+	}
+	validBlocks := persistentState.Blocks[:validBlocksCount]
+	for _, blockState := range validBlocks {
+		report.EpochCount += len(blockState.EpochHashSeeds)
+		report.Blocks = append(report.Blocks, BlockReport{
+			BlockLocation:    blockState.BlockLocation,
+			WriteOffsetBytes: blockState.WriteOffsetBytes,
+			EpochCount:       len(blockState.EpochHashSeeds),
+		})
+	}
+
+	recordArray := NewBlockDeviceBackedLocationRecordArray(keyLocationMapDevice, blockList, encryptionKeys)
+	for slot := 0; slot < recordsCount; slot++ {
+		record, err := recordArray.Get(slot)
+		if err == ErrLocationRecordInvalid {
+			report.EmptyRecords++
+			continue
+		} else if err != nil {
+			return nil, util.StatusWrapf(err, "Failed to read record in slot %d", slot)
+		}
+		if record.Location.IsTombstone() {
+			report.DeletedRecords++
+			continue
+		}
+
+		blockReport := &report.Blocks[record.Location.BlockIndex]
+		if reason := checkLocationRecord(
+			slot,
+			record,
+			validBlocks[record.Location.BlockIndex],
+			blocksDevices,
+			sectorSizeBytes,
+			encryptionKeys,
+			recordsCount,
+			persistentState.KeyLocationMapHashInitialization,
+			validator,
+		); reason != "" {
+			blockReport.CorruptRecords++
+			report.CorruptRecords = append(report.CorruptRecords, CorruptRecord{
+				Slot:   slot,
+				Record: record,
+				Reason: reason,
+			})
+			continue
+		}
+
+		blockReport.ValidRecords++
+		blockReport.ValidRecordsSizeBytes += record.Location.SizeBytes
+		if validator == nil {
+			report.UnverifiedRecords++
+		} else {
+			report.VerifiedRecords++
+		}
+	}
+	return report, nil
+}
+
+// checkLocationRecord validates a single record in the key-location
+// map. If the record is corrupted, a textual reason is returned.
+func checkLocationRecord(slot int, record LocationRecord, blockState *pb.BlockState, blocksDevices []io.ReaderAt, sectorSizeBytes int, encryptionKeys *EncryptionKeys, recordsCount int, hashInitialization uint64, validator BlobContentsValidator) string {
+	location := record.Location
+	if location.OffsetBytes < 0 || location.SizeBytes < 0 || location.OffsetBytes > blockState.WriteOffsetBytes-location.SizeBytes {
+		return fmt.Sprintf("Blob at offset %d with size %d lies beyond write offset %d of block %d", location.OffsetBytes, location.SizeBytes, blockState.WriteOffsetBytes, location.BlockIndex)
+	}
+	if expectedSlot := int(record.RecordKey.Hash(hashInitialization) % uint64(recordsCount)); expectedSlot != slot {
+		return fmt.Sprintf("Record for attempt %d should be stored in slot %d", record.RecordKey.Attempt, expectedSlot)
+	}
+	if validator != nil {
+		// Device indices are validated by checkingBlockAllocator.
+		blocksDevice := blocksDevices[blockState.BlockLocation.GetDeviceIndex()]
+		if encryptionKeyID := blockState.BlockLocation.GetEncryptionKeyId(); encryptionKeyID != 0 {
+			// Validated by checkingBlockAllocator.
+			cipher, _ := encryptionKeys.getCipher(encryptionKeyID)
+			blocksDevice = &encryptedSectorDevice{
+				base: struct {
+					io.ReaderAt
+					io.WriterAt
+				}{ReaderAt: blocksDevice},
+				cipher:          cipher,
+				sectorSizeBytes: sectorSizeBytes,
+			}
+		}
+		r := io.NewSectionReader(blocksDevice, blockState.BlockLocation.OffsetBytes+location.OffsetBytes, location.SizeBytes)
+		valid, err := validator(record.RecordKey.Key, location.SizeBytes, r)
+		if err != nil {
+			return fmt.Sprintf("Failed to read blob: %s", err)
+		}
+		if !valid {
+			return "Blob contents do not correspond with key"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+package local_test
+
+import (
+	"io"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/blockdevice"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckPersistentStorage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Create a block device containing two blocks, each containing
+	// a single blob.
+	blocksDevice, _, _, err := blockdevice.NewBlockDeviceFromFile(filepath.Join(t.TempDir(), "blocks"), 2*4096, true)
+	require.NoError(t, err)
+	_, err = blocksDevice.WriteAt([]byte("Hello"), 0)
+	require.NoError(t, err)
+	_, err = blocksDevice.WriteAt([]byte("World"), 4096)
+	require.NoError(t, err)
+
+	// The persistent state references both blocks, followed by a
+	// third block that is invalid, as it reuses the first block.
+	persistentState := &pb.PersistentState{
+		OldestEpochId: 7,
+		Blocks: []*pb.BlockState{
+			{
+				BlockLocation:    &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 4096},
+				WriteOffsetBytes: 5,
+				EpochHashSeeds:   []uint64{0x4c1bd10cbd3a1f3b},
+			},
+			{
+				BlockLocation:    &pb.BlockLocation{OffsetBytes: 4096, SizeBytes: 4096},
+				WriteOffsetBytes: 5,
+				EpochHashSeeds:   []uint64{0xb8e1b7e5fa0d9f8e, 0x0e0bb3c9d9a6a2e4},
+			},
+			{
+				BlockLocation:    &pb.BlockLocation{OffsetBytes: 0, SizeBytes: 4096},
+				WriteOffsetBytes: 0,
+				EpochHashSeeds:   []uint64{0x3a1a7a3cc8c1d4f0},
+			},
+		},
+		KeyLocationMapHashInitialization: 0x9b3c4e8a1e5a0f7d,
+	}
+
+	// Populate the key-location map with a couple of records.
+	const recordsCount = 1009
+	keyLocationMapDevice, _, _, err := blockdevice.NewBlockDeviceFromFile(filepath.Join(t.TempDir(), "key_location_map"), recordsCount*local.BlockDeviceBackedLocationRecordSize, true)
+	require.NoError(t, err)
+	resolver := mock.NewMockBlockReferenceResolver(ctrl)
+	resolver.EXPECT().BlockIndexToBlockReference(0).
+		Return(local.BlockReference{EpochID: 7}, uint64(0x4c1bd10cbd3a1f3b)).AnyTimes()
+	resolver.EXPECT().BlockIndexToBlockReference(1).
+		Return(local.BlockReference{EpochID: 9}, uint64(0x0e0bb3c9d9a6a2e4)).AnyTimes()
+	recordArray := local.NewBlockDeviceBackedLocationRecordArray(keyLocationMapDevice, resolver, nil)
+
+	getSlot := func(key local.Key) int {
+		recordKey := local.LocationRecordKey{Key: key}
+		return int(recordKey.Hash(persistentState.KeyLocationMapHashInitialization) % recordsCount)
+	}
+	putRecord := func(slot int, key local.Key, location local.Location) {
+		require.NoError(t, recordArray.Put(slot, local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key},
+			Location:  location,
+		}))
+	}
+
+	// A valid record.
+	helloKey := local.NewKeyFromString("Hello")
+	helloSlot := getSlot(helloKey)
+	putRecord(helloSlot, helloKey, local.Location{BlockIndex: 0, OffsetBytes: 0, SizeBytes: 5})
+
+	// A record whose key does not match the contents of the blob.
+	mismatchKey := local.NewKeyFromString("Mismatch")
+	mismatchSlot := getSlot(mismatchKey)
+	putRecord(mismatchSlot, mismatchKey, local.Location{BlockIndex: 1, OffsetBytes: 0, SizeBytes: 5})
+
+	// A record that references data beyond the write offset.
+	truncatedKey := local.NewKeyFromString("Truncated")
+	truncatedSlot := getSlot(truncatedKey)
+	putRecord(truncatedSlot, truncatedKey, local.Location{BlockIndex: 1, OffsetBytes: 3, SizeBytes: 5})
+
+	// A record that is stored in a slot at which Get() won't look.
+	worldKey := local.NewKeyFromString("World")
+	misplacedSlot := (getSlot(worldKey) + 1) % recordsCount
+	putRecord(misplacedSlot, worldKey, local.Location{BlockIndex: 1, OffsetBytes: 0, SizeBytes: 5})
+
+	require.Len(t, map[int]struct{}{
+		helloSlot:     {},
+		mismatchSlot:  {},
+		truncatedSlot: {},
+		misplacedSlot: {},
+	}, 4)
+
+	t.Run("WithoutValidator", func(t *testing.T) {
+		report, err := local.CheckPersistentStorage(persistentState, []io.ReaderAt{blocksDevice}, 512, 4096, []int{2}, keyLocationMapDevice, recordsCount, nil, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, uint32(7), report.OldestEpochID)
+		require.Equal(t, 3, report.EpochCount)
+		require.Equal(t, 1, report.InvalidBlocks)
+		require.Equal(t, "Block at offset 0 is referenced multiple times", report.InvalidBlocksReason)
+		require.Len(t, report.Blocks, 2)
+		require.Equal(t, 1, report.Blocks[0].ValidRecords)
+		require.Equal(t, int64(5), report.Blocks[0].ValidRecordsSizeBytes)
+		require.Equal(t, 1, report.Blocks[1].ValidRecords)
+		require.Equal(t, 2, report.Blocks[1].CorruptRecords)
+
+		require.Equal(t, recordsCount-4, report.EmptyRecords)
+		require.Equal(t, 0, report.VerifiedRecords)
+		require.Equal(t, 2, report.UnverifiedRecords)
+
+		reasons := map[int]string{}
+		for _, corruptRecord := range report.CorruptRecords {
+			reasons[corruptRecord.Slot] = corruptRecord.Reason
+		}
+		require.Equal(t, map[int]string{
+			truncatedSlot: "Blob at offset 3 with size 5 lies beyond write offset 5 of block 1",
+			misplacedSlot: "Record for attempt 0 should be stored in slot " + strconv.Itoa(getSlot(worldKey)),
+		}, reasons)
+	})
+
+	t.Run("WithValidator", func(t *testing.T) {
+		// With a validator present, the contents of blobs can be
+		// compared against their keys.
+		report, err := local.CheckPersistentStorage(persistentState, []io.ReaderAt{blocksDevice}, 512, 4096, []int{2}, keyLocationMapDevice, recordsCount, nil, func(key local.Key, sizeBytes int64, r io.ReaderAt) (bool, error) {
+			data, err := io.ReadAll(io.NewSectionReader(r, 0, sizeBytes))
+			if err != nil {
+				return false, err
+			}
+			require.Equal(t, sizeBytes, int64(len(data)))
+			return local.NewKeyFromString(string(data)) == key, nil
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, 1, report.VerifiedRecords)
+		require.Equal(t, 0, report.UnverifiedRecords)
+
+		reasons := map[int]string{}
+		for _, corruptRecord := range report.CorruptRecords {
+			reasons[corruptRecord.Slot] = corruptRecord.Reason
+		}
+		require.Equal(t, "Blob contents do not correspond with key", reasons[mismatchSlot])
+		require.Len(t, reasons, 3)
+	})
+}
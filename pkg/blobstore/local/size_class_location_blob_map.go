@@ -0,0 +1,173 @@
+package local
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+)
+
+// SizeClassBackend is a LocationBlobMap that stores objects belonging
+// to a single size class. It must also be capable of resolving block
+// references, so that entries in the key-location map can refer to its
+// blocks. OldCurrentNewLocationBlobMap implements this interface.
+type SizeClassBackend interface {
+	LocationBlobMap
+	BlockReferenceResolver
+}
+
+// SizeClassLocationBlobMap is a LocationBlobMap that stores objects in
+// one of multiple backends, based on their size. This makes it possible
+// to give every size class its own pool of blocks. This prevents large
+// objects from displacing small objects that are accessed frequently.
+//
+// Every backend uses its own set of block indices. To allow a single
+// key-location map to refer to objects stored in any of the backends,
+// block indices and BlockReferences are interleaved. For example, with
+// three backends, BlockReference.BlocksFromLast 7 refers to block 2 of
+// backend 1.
+//
+// HashingKeyLocationMap uses Location.IsOlder() to decide which records
+// to displace. As backends may have different numbers of blocks, block
+// indices of different backends cannot be compared directly. A backend
+// with few blocks would always appear to contain older data than a
+// backend with many blocks. Block indices are therefore scaled to the
+// number of blocks of the largest backend before being interleaved,
+// meaning that the newest block of every backend receives the same
+// scaled index.
+type SizeClassLocationBlobMap struct {
+	maximumSizesBytes  []int64
+	backends           []SizeClassBackend
+	blocksCounts       []int
+	maximumBlocksCount int
+}
+
+var (
+	_ LocationBlobMap        = (*SizeClassLocationBlobMap)(nil)
+	_ BlockReferenceResolver = (*SizeClassLocationBlobMap)(nil)
+)
+
+// NewSizeClassLocationBlobMap creates a SizeClassLocationBlobMap.
+// Objects whose size is at most maximumSizesBytes[i] are stored in
+// backends[i]. Objects that are larger than any of the maximum sizes
+// are stored in the final backend. This means that the number of
+// backends must be one higher than the number of maximum sizes.
+//
+// As BlockReference.BlocksFromLast is a 16-bit value, the number of
+// blocks in every backend multiplied by the number of backends may not
+// exceed 65536. It is the responsibility of the caller to enforce this.
+//
+// blocksCounts[i] contains the total number of blocks of backends[i],
+// which is used to compare the age of objects stored in different
+// backends.
+func NewSizeClassLocationBlobMap(maximumSizesBytes []int64, backends []SizeClassBackend, blocksCounts []int) *SizeClassLocationBlobMap {
+	if len(backends) != len(maximumSizesBytes)+1 {
+		panic("The number of backends must be one higher than the number of maximum sizes")
+	}
+	if len(blocksCounts) != len(backends) {
+		panic("The number of blocks counts must be equal to the number of backends")
+	}
+	maximumBlocksCount := 1
+	for _, blocksCount := range blocksCounts {
+		if blocksCount > maximumBlocksCount {
+			maximumBlocksCount = blocksCount
+		}
+	}
+	return &SizeClassLocationBlobMap{
+		maximumSizesBytes:  maximumSizesBytes,
+		backends:           backends,
+		blocksCounts:       blocksCounts,
+		maximumBlocksCount: maximumBlocksCount,
+	}
+}
+
+// toInterleavedBlockIndex converts the index of a block within a
+// backend to an interleaved block index. The block index is scaled, so
+// that block i of a backend with n blocks is placed at the same
+// relative position as block (i+1)*m/n-1 of a backend with m blocks.
+// As m is the largest number of blocks across all backends, the scaled
+// indices of consecutive blocks are strictly increasing.
+func (lbm *SizeClassLocationBlobMap) toInterleavedBlockIndex(backendIndex, blockIndex int) int {
+	blocksCount := max(lbm.blocksCounts[backendIndex], 1)
+	scaledBlockIndex := (blockIndex+1)*lbm.maximumBlocksCount/blocksCount - 1
+	return scaledBlockIndex*len(lbm.backends) + backendIndex
+}
+
+// splitBlockIndex converts an interleaved block index to the index of
+// the backend and the index of the block within that backend. It is
+// the inverse of toInterleavedBlockIndex().
+func (lbm *SizeClassLocationBlobMap) splitBlockIndex(blockIndex int) (int, int) {
+	backendsCount := len(lbm.backends)
+	backendIndex := blockIndex % backendsCount
+	blocksCount := max(lbm.blocksCounts[backendIndex], 1)
+	scaledBlockIndex := blockIndex / backendsCount
+	return backendIndex, ((scaledBlockIndex+1)*blocksCount+lbm.maximumBlocksCount-1)/lbm.maximumBlocksCount - 1
+}
+
+// splitLocation converts a Location whose block index is interleaved
+// to the index of the backend and the Location within that backend.
+func (lbm *SizeClassLocationBlobMap) splitLocation(location Location) (int, Location) {
+	backendIndex, blockIndex := lbm.splitBlockIndex(location.BlockIndex)
+	location.BlockIndex = blockIndex
+	return backendIndex, location
+}
+
+// BlockReferenceToBlockIndex converts a BlockReference that contains a
+// stable reference to a block to an interleaved block index.
+func (lbm *SizeClassLocationBlobMap) BlockReferenceToBlockIndex(blockReference BlockReference) (int, uint64, bool) {
+	backendsCount := len(lbm.backends)
+	backendIndex := int(blockReference.BlocksFromLast) % backendsCount
+	blockReference.BlocksFromLast /= uint16(backendsCount)
+	blockIndex, hashSeed, found := lbm.backends[backendIndex].BlockReferenceToBlockIndex(blockReference)
+	if !found {
+		return 0, 0, false
+	}
+	return lbm.toInterleavedBlockIndex(backendIndex, blockIndex), hashSeed, true
+}
+
+// BlockIndexToBlockReference converts an interleaved block index to a
+// BlockReference.
+func (lbm *SizeClassLocationBlobMap) BlockIndexToBlockReference(blockIndex int) (BlockReference, uint64) {
+	backendIndex, backendBlockIndex := lbm.splitBlockIndex(blockIndex)
+	blockReference, hashSeed := lbm.backends[backendIndex].BlockIndexToBlockReference(backendBlockIndex)
+	blockReference.BlocksFromLast = blockReference.BlocksFromLast*uint16(len(lbm.backends)) + uint16(backendIndex)
+	return blockReference, hashSeed
+}
+
+// Get information about a blob based on its Location.
+func (lbm *SizeClassLocationBlobMap) Get(location Location) (LocationBlobGetter, bool) {
+	backendIndex, backendLocation := lbm.splitLocation(location)
+	return lbm.backends[backendIndex].Get(backendLocation)
+}
+
+// GetUnvalidated obtains the data associated with a blob, without
+// performing any checksum validation.
+func (lbm *SizeClassLocationBlobMap) GetUnvalidated(location Location) buffer.ChunkReader {
+	backendIndex, backendLocation := lbm.splitLocation(location)
+	return lbm.backends[backendIndex].GetUnvalidated(backendLocation)
+}
+
+// Put a new blob of a given size to storage. The blob is stored in the
+// backend corresponding to its size class.
+func (lbm *SizeClassLocationBlobMap) Put(sizeBytes int64) (LocationBlobPutWriter, error) {
+	backendIndex := len(lbm.maximumSizesBytes)
+	for i, maximumSizeBytes := range lbm.maximumSizesBytes {
+		if sizeBytes <= maximumSizeBytes {
+			backendIndex = i
+			break
+		}
+	}
+
+	putWriter, err := lbm.backends[backendIndex].Put(sizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	return func(b buffer.Buffer) LocationBlobPutFinalizer {
+		putFinalizer := putWriter(b)
+		return func() (Location, error) {
+			location, err := putFinalizer()
+			if err != nil {
+				return Location{}, err
+			}
+			location.BlockIndex = lbm.toInterleavedBlockIndex(backendIndex, location.BlockIndex)
+			return location, nil
+		}
+	}, nil
+}
@@ -0,0 +1,209 @@
+package local_test
+
+import (
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSizeClassLocationBlobMap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	smallBackend := mock.NewMockSizeClassBackend(ctrl)
+	mediumBackend := mock.NewMockSizeClassBackend(ctrl)
+	largeBackend := mock.NewMockSizeClassBackend(ctrl)
+	locationBlobMap := local.NewSizeClassLocationBlobMap(
+		[]int64{100, 10000},
+		[]local.SizeClassBackend{smallBackend, mediumBackend, largeBackend},
+		[]int{8, 8, 8})
+
+	t.Run("PutSmall", func(t *testing.T) {
+		// Objects should be placed in the first size class
+		// that is large enough. Block indices of the backend
+		// should be interleaved.
+		blockPutWriter := mock.NewMockLocationBlobPutWriter(ctrl)
+		smallBackend.EXPECT().Put(int64(100)).Return(blockPutWriter.Call, nil)
+		putWriter, err := locationBlobMap.Put(100)
+		require.NoError(t, err)
+
+		b := buffer.NewValidatedBufferFromByteSlice(make([]byte, 100))
+		blockPutWriter.EXPECT().Call(b).Return(func() (local.Location, error) {
+			return local.Location{BlockIndex: 4, OffsetBytes: 200, SizeBytes: 100}, nil
+		})
+		location, err := putWriter(b)()
+		require.NoError(t, err)
+		require.Equal(t, local.Location{BlockIndex: 12, OffsetBytes: 200, SizeBytes: 100}, location)
+	})
+
+	t.Run("PutLarge", func(t *testing.T) {
+		// Objects exceeding the largest size class should be
+		// placed in the final backend.
+		blockPutWriter := mock.NewMockLocationBlobPutWriter(ctrl)
+		largeBackend.EXPECT().Put(int64(10001)).Return(blockPutWriter.Call, nil)
+		putWriter, err := locationBlobMap.Put(10001)
+		require.NoError(t, err)
+
+		b := buffer.NewValidatedBufferFromByteSlice(make([]byte, 10001))
+		blockPutWriter.EXPECT().Call(b).Return(func() (local.Location, error) {
+			return local.Location{BlockIndex: 1, OffsetBytes: 0, SizeBytes: 10001}, nil
+		})
+		location, err := putWriter(b)()
+		require.NoError(t, err)
+		require.Equal(t, local.Location{BlockIndex: 5, OffsetBytes: 0, SizeBytes: 10001}, location)
+	})
+
+	t.Run("PutFailure", func(t *testing.T) {
+		mediumBackend.EXPECT().Put(int64(101)).Return(nil, status.Error(codes.Internal, "No space left"))
+		_, err := locationBlobMap.Put(101)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "No space left"), err)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		// Calls to Get() should be forwarded to the right
+		// backend, having the block index translated.
+		blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "8b1a9953c4611296a827abf8c47804d7e6c49c6b8b0aa8ab3f6ffda4c1b2d53c", 5)
+		getter := mock.NewMockLocationBlobGetter(ctrl)
+		mediumBackend.EXPECT().Get(local.Location{BlockIndex: 2, OffsetBytes: 10, SizeBytes: 5}).Return(getter.Call, true)
+		getter.EXPECT().Call(blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		locationGetter, needsRefresh := locationBlobMap.Get(local.Location{BlockIndex: 7, OffsetBytes: 10, SizeBytes: 5})
+		require.True(t, needsRefresh)
+		data, err := locationGetter(blobDigest).ToByteSlice(10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("BlockIndexToBlockReference", func(t *testing.T) {
+		// The index of the backend should be stored in the
+		// BlockReference, so that it can be converted back.
+		largeBackend.EXPECT().BlockIndexToBlockReference(3).Return(local.BlockReference{
+			EpochID:        42,
+			BlocksFromLast: 5,
+		}, uint64(0x1234))
+		blockReference, hashSeed := locationBlobMap.BlockIndexToBlockReference(11)
+		require.Equal(t, local.BlockReference{EpochID: 42, BlocksFromLast: 17}, blockReference)
+		require.Equal(t, uint64(0x1234), hashSeed)
+	})
+
+	t.Run("BlockReferenceToBlockIndex", func(t *testing.T) {
+		largeBackend.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        42,
+			BlocksFromLast: 5,
+		}).Return(3, uint64(0x1234), true)
+		blockIndex, hashSeed, found := locationBlobMap.BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        42,
+			BlocksFromLast: 17,
+		})
+		require.True(t, found)
+		require.Equal(t, 11, blockIndex)
+		require.Equal(t, uint64(0x1234), hashSeed)
+
+		// Failures to resolve blocks should be propagated.
+		smallBackend.EXPECT().BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        7,
+			BlocksFromLast: 1,
+		}).Return(0, uint64(0), false)
+		_, _, found = locationBlobMap.BlockReferenceToBlockIndex(local.BlockReference{
+			EpochID:        7,
+			BlocksFromLast: 3,
+		})
+		require.False(t, found)
+	})
+}
+
+func TestSizeClassLocationBlobMapUnequalBlocksCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Let the small size class only have 2 blocks, while the large
+	// size class has 64 blocks.
+	smallBackend := mock.NewMockSizeClassBackend(ctrl)
+	largeBackend := mock.NewMockSizeClassBackend(ctrl)
+	locationBlobMap := local.NewSizeClassLocationBlobMap(
+		[]int64{100},
+		[]local.SizeClassBackend{smallBackend, largeBackend},
+		[]int{2, 64})
+	for _, backend := range []struct {
+		mock        *mock.MockSizeClassBackend
+		blocksCount int
+	}{
+		{smallBackend, 2},
+		{largeBackend, 64},
+	} {
+		// Only let references to epoch 1 be valid, so that
+		// empty slots of the key-location map are ignored.
+		blocksCount := backend.blocksCount
+		backend.mock.EXPECT().BlockReferenceToBlockIndex(gomock.Any()).DoAndReturn(
+			func(blockReference local.BlockReference) (int, uint64, bool) {
+				return blocksCount - 1 - int(blockReference.BlocksFromLast), 0, blockReference.EpochID == 1
+			}).AnyTimes()
+		backend.mock.EXPECT().BlockIndexToBlockReference(gomock.Any()).DoAndReturn(
+			func(blockIndex int) (local.BlockReference, uint64) {
+				return local.BlockReference{EpochID: 1, BlocksFromLast: uint16(blocksCount - 1 - blockIndex)}, 0
+			}).AnyTimes()
+	}
+	getBlockIndex := func(blocksFromLast uint16) int {
+		blockIndex, _, found := locationBlobMap.BlockReferenceToBlockIndex(local.BlockReference{EpochID: 1, BlocksFromLast: blocksFromLast})
+		require.True(t, found)
+		return blockIndex
+	}
+
+	t.Run("BlockIndexRoundTrip", func(t *testing.T) {
+		// Block indices should be scaled, so that the newest
+		// blocks of both size classes have a similar index.
+		for blocksFromLast := uint16(0); blocksFromLast < 2*64; blocksFromLast++ {
+			if blocksFromLast%2 == 0 && blocksFromLast >= 2*2 {
+				continue
+			}
+			blockReference, _ := locationBlobMap.BlockIndexToBlockReference(getBlockIndex(blocksFromLast))
+			require.Equal(t, local.BlockReference{EpochID: 1, BlocksFromLast: blocksFromLast}, blockReference)
+		}
+
+		require.Equal(t, 126, getBlockIndex(0))
+		require.Equal(t, 62, getBlockIndex(2))
+		require.Equal(t, 127, getBlockIndex(1))
+		require.Equal(t, 1, getBlockIndex(63*2+1))
+	})
+
+	t.Run("SmallSizeClassRetainedInKeyLocationMap", func(t *testing.T) {
+		// Create a key-location map that only has a single
+		// slot, so that all records compete for it. Records of
+		// the small size class that are stored in its newest
+		// block should not be displaced by records of the large
+		// size class that are stored in older blocks, even
+		// though the block index of the latter within their
+		// size class is much higher.
+		keyLocationMap := local.NewHashingKeyLocationMap(
+			local.NewInMemoryLocationRecordArray(1, locationBlobMap),
+			1,
+			0,
+			1,
+			64,
+			"cas")
+
+		smallKey := local.NewKeyFromString("small")
+		smallLocation := local.Location{BlockIndex: getBlockIndex(0), OffsetBytes: 0, SizeBytes: 10}
+		require.NoError(t, keyLocationMap.Put(smallKey, smallLocation))
+
+		for i := 1; i < 64; i++ {
+			require.NoError(t, keyLocationMap.Put(
+				local.NewKeyFromString(fmt.Sprintf("large%d", i)),
+				local.Location{BlockIndex: getBlockIndex(uint16(2*i + 1)), OffsetBytes: 0, SizeBytes: 1000}))
+		}
+
+		location, err := keyLocationMap.Get(smallKey)
+		require.NoError(t, err)
+		require.Equal(t, smallLocation, location)
+	})
+}
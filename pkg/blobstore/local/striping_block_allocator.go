@@ -0,0 +1,134 @@
+package local
+
+import (
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	configuration_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type stripingBlockAllocator struct {
+	blockAllocators []BlockAllocator
+	nextIndex       int
+}
+
+// NewStripingBlockAllocator creates a BlockAllocator that allocates
+// blocks from multiple underlying BlockAllocators, each typically
+// backed by a separate block device. This makes it possible to let a
+// single LocalBlobAccess use the capacity and throughput of multiple
+// storage devices.
+//
+// New blocks are allocated from the underlying BlockAllocators in
+// round-robin fashion, skipping BlockAllocators that have no unused
+// blocks available. The index of the BlockAllocator from which a block
+// was allocated is stored in the BlockLocation's device index, so that
+// the block can be restored from the right device after restarts.
+func NewStripingBlockAllocator(blockAllocators []BlockAllocator) BlockAllocator {
+	return &stripingBlockAllocator{
+		blockAllocators: blockAllocators,
+	}
+}
+
+func (ba *stripingBlockAllocator) NewBlock() (Block, *pb.BlockLocation, error) {
+	err := status.Error(codes.Unavailable, "No unused blocks available")
+	for i := 0; i < len(ba.blockAllocators); i++ {
+		deviceIndex := (ba.nextIndex + i) % len(ba.blockAllocators)
+		block, location, allocateErr := ba.blockAllocators[deviceIndex].NewBlock()
+		if allocateErr == nil {
+			ba.nextIndex = deviceIndex + 1
+			if location != nil {
+				location.DeviceIndex = uint32(deviceIndex)
+			}
+			return block, location, nil
+		}
+		if status.Code(allocateErr) != codes.Unavailable {
+			return nil, nil, allocateErr
+		}
+		err = allocateErr
+	}
+	return nil, nil, err
+}
+
+func (ba *stripingBlockAllocator) NewBlockAtLocation(location *pb.BlockLocation, writeOffsetBytes int64) (Block, bool) {
+	deviceIndex := location.GetDeviceIndex()
+	if deviceIndex >= uint32(len(ba.blockAllocators)) {
+		return nil, false
+	}
+	deviceLocation := proto.Clone(location).(*pb.BlockLocation)
+	deviceLocation.DeviceIndex = 0
+	return ba.blockAllocators[deviceIndex].NewBlockAtLocation(deviceLocation, writeOffsetBytes)
+}
+
+// GetStripedBlockGeometry computes how blocks should be laid out when
+// striping them across multiple block devices. Given the number of
+// sectors of each block device, it returns the largest block size in
+// sectors for which blockCount blocks can be stored, and the number of
+// blocks that should be stored on each of the block devices. A block
+// size of zero is returned if the block devices are too small to hold
+// blockCount blocks.
+//
+// When only a single block device is provided, the block size is
+// identical to the one that is used when striping is not enabled.
+func GetStripedBlockGeometry(deviceSectorCounts []int64, blockCount int) (int64, []int) {
+	getBlockCount := func(blockSectorCount int64) int64 {
+		total := int64(0)
+		for _, sectorCount := range deviceSectorCounts {
+			total += sectorCount / blockSectorCount
+		}
+		return total
+	}
+
+	// Perform a binary search for the largest block size that
+	// yields enough blocks.
+	low, high := int64(0), int64(0)
+	for _, sectorCount := range deviceSectorCounts {
+		if high < sectorCount {
+			high = sectorCount
+		}
+	}
+	for low < high {
+		mid := low + (high-low+1)/2
+		if getBlockCount(mid) >= int64(blockCount) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	if low == 0 {
+		return 0, nil
+	}
+
+	// Due to rounding, more blocks may fit than requested. Remove
+	// the excess blocks from the devices holding the most blocks,
+	// preferring the last ones.
+	deviceBlockCounts := make([]int, len(deviceSectorCounts))
+	excess := -blockCount
+	for i, sectorCount := range deviceSectorCounts {
+		deviceBlockCounts[i] = int(sectorCount / low)
+		excess += deviceBlockCounts[i]
+	}
+	for ; excess > 0; excess-- {
+		largest := 0
+		for i, count := range deviceBlockCounts {
+			if count >= deviceBlockCounts[largest] {
+				largest = i
+			}
+		}
+		deviceBlockCounts[largest]--
+	}
+	return low, deviceBlockCounts
+}
+
+// GetBlockDeviceBackedBlockCount returns the total number of blocks
+// that LocalBlobAccess stores on block devices, including spare blocks
+// and blocks belonging to additional size classes. This value can be
+// provided to GetStripedBlockGeometry() to compute the size of blocks.
+func GetBlockDeviceBackedBlockCount(config *configuration_pb.LocalBlobAccessConfiguration) int {
+	blockCount := config.GetBlocksOnBlockDevice().GetSpareBlocks() + config.OldBlocks + config.CurrentBlocks + config.NewBlocks
+	for _, sizeClass := range config.SizeClasses {
+		blockCount += sizeClass.OldBlocks + sizeClass.CurrentBlocks + sizeClass.NewBlocks
+	}
+	return int(blockCount)
+}
@@ -0,0 +1,129 @@
+package local_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestStripingBlockAllocatorNewBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockAllocator0 := mock.NewMockBlockAllocator(ctrl)
+	blockAllocator1 := mock.NewMockBlockAllocator(ctrl)
+	blockAllocator2 := mock.NewMockBlockAllocator(ctrl)
+	ba := local.NewStripingBlockAllocator([]local.BlockAllocator{blockAllocator0, blockAllocator1, blockAllocator2})
+
+	t.Run("RoundRobin", func(t *testing.T) {
+		// Blocks should be allocated from all block devices in
+		// order, with the device index stored in the location.
+		for i, blockAllocator := range []*mock.MockBlockAllocator{blockAllocator0, blockAllocator1, blockAllocator2} {
+			block := mock.NewMockBlock(ctrl)
+			blockAllocator.EXPECT().NewBlock().Return(block, &pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100}, nil)
+
+			allocatedBlock, location, err := ba.NewBlock()
+			require.NoError(t, err)
+			require.Equal(t, block, allocatedBlock)
+			testutil.RequireEqualProto(t, &pb.BlockLocation{
+				OffsetBytes: 100,
+				SizeBytes:   100,
+				DeviceIndex: uint32(i),
+			}, location)
+		}
+	})
+
+	t.Run("SkipFull", func(t *testing.T) {
+		// Block devices that have no unused blocks should be
+		// skipped.
+		block := mock.NewMockBlock(ctrl)
+		blockAllocator0.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Unavailable, "No unused blocks available"))
+		blockAllocator1.EXPECT().NewBlock().Return(block, &pb.BlockLocation{OffsetBytes: 200, SizeBytes: 100}, nil)
+
+		allocatedBlock, location, err := ba.NewBlock()
+		require.NoError(t, err)
+		require.Equal(t, block, allocatedBlock)
+		testutil.RequireEqualProto(t, &pb.BlockLocation{
+			OffsetBytes: 200,
+			SizeBytes:   100,
+			DeviceIndex: 1,
+		}, location)
+	})
+
+	t.Run("AllFull", func(t *testing.T) {
+		blockAllocator2.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Unavailable, "No unused blocks available"))
+		blockAllocator0.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Unavailable, "No unused blocks available"))
+		blockAllocator1.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Unavailable, "No unused blocks available"))
+
+		_, _, err := ba.NewBlock()
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "No unused blocks available"), err)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Errors other than exhaustion should be propagated.
+		blockAllocator2.EXPECT().NewBlock().Return(nil, nil, status.Error(codes.Internal, "Disk failure"))
+
+		_, _, err := ba.NewBlock()
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk failure"), err)
+	})
+}
+
+func TestStripingBlockAllocatorNewBlockAtLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockAllocator0 := mock.NewMockBlockAllocator(ctrl)
+	blockAllocator1 := mock.NewMockBlockAllocator(ctrl)
+	ba := local.NewStripingBlockAllocator([]local.BlockAllocator{blockAllocator0, blockAllocator1})
+
+	t.Run("UnknownDevice", func(t *testing.T) {
+		_, found := ba.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100, DeviceIndex: 2}, 10)
+		require.False(t, found)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// The block should be restored from the right device,
+		// without the device index being forwarded.
+		block := mock.NewMockBlock(ctrl)
+		blockAllocator1.EXPECT().NewBlockAtLocation(testutil.EqProto(t, &pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100}), int64(10)).
+			Return(block, true)
+
+		restoredBlock, found := ba.NewBlockAtLocation(&pb.BlockLocation{OffsetBytes: 100, SizeBytes: 100, DeviceIndex: 1}, 10)
+		require.True(t, found)
+		require.Equal(t, block, restoredBlock)
+	})
+}
+
+func TestGetStripedBlockGeometry(t *testing.T) {
+	t.Run("SingleDevice", func(t *testing.T) {
+		// The results should be identical to when striping is
+		// not used.
+		blockSectorCount, deviceBlockCounts := local.GetStripedBlockGeometry([]int64{1000}, 7)
+		require.Equal(t, int64(142), blockSectorCount)
+		require.Equal(t, []int{7}, deviceBlockCounts)
+	})
+
+	t.Run("EqualDevices", func(t *testing.T) {
+		blockSectorCount, deviceBlockCounts := local.GetStripedBlockGeometry([]int64{1000, 1000, 1000, 1000}, 10)
+		require.Equal(t, int64(333), blockSectorCount)
+		require.Equal(t, []int{3, 3, 2, 2}, deviceBlockCounts)
+	})
+
+	t.Run("UnequalDevices", func(t *testing.T) {
+		blockSectorCount, deviceBlockCounts := local.GetStripedBlockGeometry([]int64{1000, 3000}, 8)
+		require.Equal(t, int64(500), blockSectorCount)
+		require.Equal(t, []int{2, 6}, deviceBlockCounts)
+	})
+
+	t.Run("TooSmall", func(t *testing.T) {
+		blockSectorCount, _ := local.GetStripedBlockGeometry([]int64{2, 3}, 10)
+		require.Equal(t, int64(0), blockSectorCount)
+	})
+}
@@ -61,6 +61,10 @@ type metricsBlobAccess struct {
 	putDurationSeconds              prometheus.ObserverVec
 	findMissingBatchSize            prometheus.Observer
 	findMissingDurationSeconds      prometheus.ObserverVec
+	findMissingStreamingSeconds     prometheus.ObserverVec
+	invalidateDurationSeconds       prometheus.ObserverVec
+	invalidatePrefixDurationSeconds prometheus.ObserverVec
+	listDigestsDurationSeconds      prometheus.ObserverVec
 	getCapabilitiesSeconds          prometheus.ObserverVec
 }
 
@@ -85,6 +89,10 @@ func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType,
 		putDurationSeconds:              blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Put"}),
 		findMissingBatchSize:            blobAccessOperationsFindMissingBatchSize.WithLabelValues(storageType, backendType),
 		findMissingDurationSeconds:      blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissing"}),
+		findMissingStreamingSeconds:     blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissingStreaming"}),
+		invalidateDurationSeconds:       blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Invalidate"}),
+		invalidatePrefixDurationSeconds: blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "InvalidateInstanceNamePrefix"}),
+		listDigestsDurationSeconds:      blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "ListDigests"}),
 		getCapabilitiesSeconds:          blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetCapabilities"}),
 	}
 }
@@ -157,6 +165,35 @@ func (ba *metricsBlobAccess) FindMissing(ctx context.Context, digests digest.Set
 	return digests, err
 }
 
+func (ba *metricsBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return &metricsFindMissingStream{
+		FindMissingStream: ba.blobAccess.FindMissingStreaming(ctx, reportMissing),
+		blobAccess:        ba,
+		timeStart:         ba.clock.Now(),
+	}
+}
+
+func (ba *metricsBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	timeStart := ba.clock.Now()
+	err := ba.blobAccess.Invalidate(ctx, digests)
+	ba.updateDurationSeconds(ba.invalidateDurationSeconds, status.Code(err), timeStart)
+	return err
+}
+
+func (ba *metricsBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	timeStart := ba.clock.Now()
+	err := ba.blobAccess.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix)
+	ba.updateDurationSeconds(ba.invalidatePrefixDurationSeconds, status.Code(err), timeStart)
+	return err
+}
+
+func (ba *metricsBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	timeStart := ba.clock.Now()
+	err := ba.blobAccess.ListDigests(ctx, digestFunction, reportDigest)
+	ba.updateDurationSeconds(ba.listDigestsDurationSeconds, status.Code(err), timeStart)
+	return err
+}
+
 func (ba *metricsBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	timeStart := ba.clock.Now()
 	capabilities, err := ba.blobAccess.GetCapabilities(ctx, instanceName)
@@ -179,3 +216,18 @@ func (eh *metricsErrorHandler) OnError(err error) (buffer.Buffer, error) {
 func (eh *metricsErrorHandler) Done() {
 	eh.blobAccess.updateDurationSeconds(eh.durationSeconds, eh.errorCode, eh.timeStart)
 }
+
+// metricsFindMissingStream is returned by
+// metricsBlobAccess.FindMissingStreaming(). It measures the amount of
+// time it takes for the stream to be finished.
+type metricsFindMissingStream struct {
+	FindMissingStream
+	blobAccess *metricsBlobAccess
+	timeStart  time.Time
+}
+
+func (s *metricsFindMissingStream) Finish() error {
+	err := s.FindMissingStream.Finish()
+	s.blobAccess.updateDurationSeconds(s.blobAccess.findMissingStreamingSeconds, status.Code(err), s.timeStart)
+	return err
+}
@@ -33,13 +33,42 @@ var (
 		[]string{"direction"})
 	mirroredBlobAccessFindMissingSynchronizationsFromAToB = mirroredBlobAccessFindMissingSynchronizations.WithLabelValues("FromAToB")
 	mirroredBlobAccessFindMissingSynchronizationsFromBToA = mirroredBlobAccessFindMissingSynchronizations.WithLabelValues("FromBToA")
+
+	mirroredBlobAccessReadFailovers = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "mirrored_blob_access_read_failovers_total",
+			Help:      "Number of reads that were retried against the other backend due to an infrastructure error",
+		})
+	mirroredBlobAccessHedgedReadsFired = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "mirrored_blob_access_hedged_reads_fired_total",
+			Help:      "Number of reads that were sent to the other backend, because the first backend did not respond quickly enough",
+		})
+	mirroredBlobAccessHedgedReadsWon = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "mirrored_blob_access_hedged_reads_won_total",
+			Help:      "Number of hedged reads for which the other backend responded first",
+		})
 )
 
+type mirroredBackend struct {
+	index      int
+	name       string
+	blobAccess blobstore.BlobAccess
+}
+
 type mirroredBlobAccess struct {
-	backendA       blobstore.BlobAccess
-	backendB       blobstore.BlobAccess
+	backends       [2]mirroredBackend
 	replicatorAToB replication.BlobReplicator
 	replicatorBToA replication.BlobReplicator
+	readFailover   bool
+	readHedger     *ReadHedger
 	round          atomic.Uint32
 }
 
@@ -48,42 +77,95 @@ type mirroredBlobAccess struct {
 // inconsistencies between the two storage backends are detected (i.e.,
 // a blob is only present in one of the backends), the blob is
 // replicated.
-func NewMirroredBlobAccess(backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator) blobstore.BlobAccess {
+//
+// If readFailover is set, reads that fail due to infrastructure errors
+// (e.g., UNAVAILABLE) are retried against the other backend. If a
+// ReadHedger is provided, reads are sent to the other backend if the
+// backend that was picked initially does not respond quickly enough.
+func NewMirroredBlobAccess(backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator, readFailover bool, readHedger *ReadHedger) blobstore.BlobAccess {
 	mirroredBlobAccessPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(mirroredBlobAccessFindMissingSynchronizations)
+		prometheus.MustRegister(mirroredBlobAccessReadFailovers)
+		prometheus.MustRegister(mirroredBlobAccessHedgedReadsFired)
+		prometheus.MustRegister(mirroredBlobAccessHedgedReadsWon)
 	})
 
 	return &mirroredBlobAccess{
-		backendA:       backendA,
-		backendB:       backendB,
+		backends: [...]mirroredBackend{
+			{index: 0, name: "Backend A", blobAccess: backendA},
+			{index: 1, name: "Backend B", blobAccess: backendB},
+		},
 		replicatorAToB: replicatorAToB,
 		replicatorBToA: replicatorBToA,
+		readFailover:   readFailover,
+		readHedger:     readHedger,
 	}
 }
 
-func (ba *mirroredBlobAccess) getBlobReplicatorSelector() (blobstore.BlobAccess, replication.BlobReplicatorSelector) {
-	// Alternate requests between storage backends.
-	var firstBackend blobstore.BlobAccess
-	var firstBackendName, secondBackendName string
-	var replicator replication.BlobReplicator
+// isInfrastructureError returns true if an error is likely caused by
+// a backend being temporarily unavailable, as opposed to the request
+// itself being faulty.
+func isInfrastructureError(err error) bool {
+	switch status.Code(err) {
+	case codes.Aborted, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// getBackends returns the storage backends in the order in which they
+// should be consulted. Requests are alternated between backends.
+func (ba *mirroredBlobAccess) getBackends() (*mirroredBackend, *mirroredBackend) {
 	if ba.round.Add(1)%2 == 1 {
-		firstBackend = ba.backendA
-		firstBackendName, secondBackendName = "Backend A", "Backend B"
+		return &ba.backends[0], &ba.backends[1]
+	}
+	return &ba.backends[1], &ba.backends[0]
+}
+
+func (ba *mirroredBlobAccess) getBlobReplicatorSelector(ctx context.Context) (*mirroredBackend, *mirroredBackend, replication.BlobReplicatorSelector) {
+	firstBackend, secondBackend := ba.getBackends()
+	return firstBackend, secondBackend, ba.newBlobReplicatorSelector(ctx, firstBackend, secondBackend)
+}
+
+// newBlobReplicatorSelector returns a BlobReplicatorSelector that is
+// called into when a read against firstBackend fails. It may cause the
+// object to be replicated from secondBackend to firstBackend.
+func (ba *mirroredBlobAccess) newBlobReplicatorSelector(ctx context.Context, firstBackend, secondBackend *mirroredBackend) replication.BlobReplicatorSelector {
+	replicator := ba.replicatorAToB
+	if firstBackend.index == 0 {
 		replicator = ba.replicatorBToA
-	} else {
-		firstBackend = ba.backendB
-		firstBackendName, secondBackendName = "Backend B", "Backend A"
-		replicator = ba.replicatorAToB
 	}
 
-	return firstBackend, func(observedErr error) (replication.BlobReplicator, error) {
-		// A fatal error occurred. Prepend the name of the
-		// backend that triggered the error.
+	var failoverErr error
+	return func(observedErr error) (replication.BlobReplicator, error) {
 		if status.Code(observedErr) != codes.NotFound {
 			if replicator != nil {
-				return nil, util.StatusWrap(observedErr, firstBackendName)
+				// Retry reads that failed due to
+				// infrastructure errors against the
+				// other storage backend, unless the
+				// request itself got cancelled.
+				if ba.readFailover && isInfrastructureError(observedErr) && ctx.Err() == nil {
+					mirroredBlobAccessReadFailovers.Inc()
+					failoverErr = util.StatusWrap(observedErr, firstBackend.name)
+					replicator = nil
+					return replication.NewNoopBlobReplicator(secondBackend.blobAccess), nil
+				}
+
+				// A fatal error occurred. Prepend the
+				// name of the backend that triggered the
+				// error.
+				return nil, util.StatusWrap(observedErr, firstBackend.name)
 			}
-			return nil, util.StatusWrap(observedErr, secondBackendName)
+			return nil, util.StatusWrap(observedErr, secondBackend.name)
+		}
+
+		// The other storage backend was consulted due to an
+		// infrastructure error, but it does not have a copy of
+		// the object. Return the original error, as the object
+		// may still be present in the first storage backend.
+		if failoverErr != nil {
+			return nil, failoverErr
 		}
 
 		// Both storage backends returned NotFound. Return one
@@ -102,13 +184,17 @@ func (ba *mirroredBlobAccess) getBlobReplicatorSelector() (blobstore.BlobAccess,
 }
 
 func (ba *mirroredBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
-	firstBackend, successiveBackends := ba.getBlobReplicatorSelector()
-	return replication.GetWithBlobReplicator(ctx, digest, firstBackend, successiveBackends)
+	if ba.readHedger != nil {
+		firstBackend, secondBackend := ba.getBackends()
+		return ba.readHedger.get(ctx, digest, ba, firstBackend, secondBackend)
+	}
+	firstBackend, _, successiveBackends := ba.getBlobReplicatorSelector(ctx)
+	return replication.GetWithBlobReplicator(ctx, digest, firstBackend.blobAccess, successiveBackends)
 }
 
 func (ba *mirroredBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
-	firstBackend, successiveBackends := ba.getBlobReplicatorSelector()
-	return replication.GetFromCompositeWithBlobReplicator(ctx, parentDigest, childDigest, slicer, firstBackend, successiveBackends)
+	firstBackend, _, successiveBackends := ba.getBlobReplicatorSelector(ctx)
+	return replication.GetFromCompositeWithBlobReplicator(ctx, parentDigest, childDigest, slicer, firstBackend.blobAccess, successiveBackends)
 }
 
 func (ba *mirroredBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
@@ -116,13 +202,13 @@ func (ba *mirroredBlobAccess) Put(ctx context.Context, digest digest.Digest, b b
 	b1, b2 := b.CloneStream()
 	group, groupCtx := errgroup.WithContext(ctx)
 	group.Go(func() error {
-		if err := ba.backendA.Put(groupCtx, digest, b1); err != nil {
+		if err := ba.backends[0].blobAccess.Put(groupCtx, digest, b1); err != nil {
 			return util.StatusWrap(err, "Backend A")
 		}
 		return nil
 	})
 	group.Go(func() error {
-		if err := ba.backendB.Put(groupCtx, digest, b2); err != nil {
+		if err := ba.backends[1].blobAccess.Put(groupCtx, digest, b2); err != nil {
 			return util.StatusWrap(err, "Backend B")
 		}
 		return nil
@@ -136,7 +222,7 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	var resultsA, resultsB digest.Set
 	findMissingGroup.Go(func() error {
 		var err error
-		resultsA, err = ba.backendA.FindMissing(findMissingCtx, digests)
+		resultsA, err = ba.backends[0].blobAccess.FindMissing(findMissingCtx, digests)
 		if err != nil {
 			return util.StatusWrap(err, "Backend A")
 		}
@@ -144,7 +230,7 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	})
 	findMissingGroup.Go(func() error {
 		var err error
-		resultsB, err = ba.backendB.FindMissing(findMissingCtx, digests)
+		resultsB, err = ba.backends[1].blobAccess.FindMissing(findMissingCtx, digests)
 		if err != nil {
 			return util.StatusWrap(err, "Backend B")
 		}
@@ -185,21 +271,63 @@ func (ba *mirroredBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	return missingFromBoth, nil
 }
 
+func (ba *mirroredBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+// invalidate applies an invalidation operation to both storage
+// backends. Objects need to be removed from both backends, as
+// FindMissing() would otherwise replicate them back.
+func (ba *mirroredBlobAccess) invalidate(ctx context.Context, invalidate func(ctx context.Context, blobAccess blobstore.BlobAccess) error) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i := range ba.backends {
+		backend := &ba.backends[i]
+		group.Go(func() error {
+			if err := invalidate(groupCtx, backend.blobAccess); err != nil {
+				return util.StatusWrap(err, backend.name)
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+func (ba *mirroredBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	return ba.invalidate(ctx, func(ctx context.Context, blobAccess blobstore.BlobAccess) error {
+		return blobAccess.Invalidate(ctx, digests)
+	})
+}
+
+func (ba *mirroredBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return ba.invalidate(ctx, func(ctx context.Context, blobAccess blobstore.BlobAccess) error {
+		return blobAccess.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix)
+	})
+}
+
+func (ba *mirroredBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	// Both backends may contain objects that have not been
+	// replicated to the other backend yet. List the contents of
+	// both of them, even though this causes most objects to be
+	// reported twice.
+	for i := range ba.backends {
+		backend := &ba.backends[i]
+		if err := backend.blobAccess.ListDigests(ctx, digestFunction, reportDigest); err != nil {
+			return util.StatusWrap(err, backend.name)
+		}
+	}
+	return nil
+}
+
 func (ba *mirroredBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	// Alternate requests between storage backends.
-	var backend blobstore.BlobAccess
-	var backendName string
+	backend := &ba.backends[1]
 	if ba.round.Add(1)%2 == 1 {
-		backend = ba.backendA
-		backendName = "Backend A"
-	} else {
-		backend = ba.backendB
-		backendName = "Backend B"
+		backend = &ba.backends[0]
 	}
 
-	capabilities, err := backend.GetCapabilities(ctx, instanceName)
+	capabilities, err := backend.blobAccess.GetCapabilities(ctx, instanceName)
 	if err != nil {
-		return nil, util.StatusWrap(err, backendName)
+		return nil, util.StatusWrap(err, backend.name)
 	}
 	return capabilities, nil
 }
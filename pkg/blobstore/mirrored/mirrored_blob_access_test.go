@@ -3,6 +3,7 @@ package mirrored_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
@@ -36,7 +37,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 			backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))),
 		)
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		for i := 0; i < 3; i++ {
 			data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 			require.NoError(t, err)
@@ -51,7 +52,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
 	})
@@ -62,7 +63,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		require.NoError(t, err)
 		require.Equal(t, []byte("Hello world"), data)
@@ -73,7 +74,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 
 		// In case of fatal errors, the name of the backend
 		// should be prepended.
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend A: Server on fire"), err)
 	})
@@ -82,10 +83,153 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Server on fire")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend B: Server on fire"), err)
 	})
+
+	t.Run("FailoverSuccess", func(t *testing.T) {
+		// If failover is enabled, infrastructure errors should
+		// cause the other backend to be consulted, without
+		// performing any replication.
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, true, nil)
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("FailoverNotFound", func(t *testing.T) {
+		// If the other backend does not contain the object,
+		// the original error should be returned, as the object
+		// may still be present in the first backend.
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, true, nil)
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Backend A: Server offline"), err)
+	})
+
+	t.Run("FailoverDisabled", func(t *testing.T) {
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Backend A: Server offline"), err)
+	})
+}
+
+func TestMirroredBlobAccessGetHedged(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	backendA := mock.NewMockBlobAccess(ctrl)
+	backendB := mock.NewMockBlobAccess(ctrl)
+	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
+	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
+
+	t.Run("FirstBackendResponds", func(t *testing.T) {
+		// If the first backend responds before the delay has
+		// passed, the read should not be hedged.
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA, backendB, replicatorAToB, replicatorBToA, false,
+			mirrored.NewReadHedger(clock, 95, 10*time.Millisecond, time.Second))
+
+		timer := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(time.Second).Return(timer, nil)
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		timer.EXPECT().Stop()
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("FirstBackendFailsQuickly", func(t *testing.T) {
+		// Errors returned before the delay has passed should
+		// be processed as if no hedging was used.
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA, backendB, replicatorAToB, replicatorBToA, false,
+			mirrored.NewReadHedger(clock, 95, 10*time.Millisecond, time.Second))
+
+		timer := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(time.Second).Return(timer, nil)
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
+		timer.EXPECT().Stop()
+		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("SecondBackendWins", func(t *testing.T) {
+		// If the first backend does not respond in time, the
+		// read should be sent to the second backend. The buffer
+		// returned by the second backend should be returned,
+		// while the buffer of the first backend should be
+		// discarded once it arrives.
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA, backendB, replicatorAToB, replicatorBToA, false,
+			mirrored.NewReadHedger(clock, 95, 10*time.Millisecond, time.Second))
+
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1001, 0)
+		clock.EXPECT().NewTimer(time.Second).Return(mock.NewMockTimer(ctrl), timerChannel)
+		releaseFirst := make(chan struct{})
+		firstDiscarded := make(chan struct{})
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				<-releaseFirst
+				r := mock.NewMockReadCloser(ctrl)
+				r.EXPECT().Close().DoAndReturn(func() error {
+					close(firstDiscarded)
+					return nil
+				})
+				return buffer.NewCASBufferFromReader(blobDigest, r, buffer.UserProvided)
+			})
+		backendB.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+		close(releaseFirst)
+		<-firstDiscarded
+	})
+
+	t.Run("SecondBackendWinsNotFound", func(t *testing.T) {
+		// If the second backend wins the race, but does not
+		// have the object, it should be replicated from the
+		// first backend.
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA, backendB, replicatorAToB, replicatorBToA, false,
+			mirrored.NewReadHedger(clock, 95, 10*time.Millisecond, time.Second))
+
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1001, 0)
+		clock.EXPECT().NewTimer(time.Second).Return(mock.NewMockTimer(ctrl), timerChannel)
+		releaseFirst := make(chan struct{})
+		firstReturned := make(chan struct{})
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				<-releaseFirst
+				close(firstReturned)
+				return buffer.NewBufferFromError(status.Error(codes.Internal, "Server on fire"))
+			})
+		backendB.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
+		replicatorAToB.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+		close(releaseFirst)
+		<-firstReturned
+	})
 }
 
 func TestMirroredBlobAccessGetFromComposite(t *testing.T) {
@@ -106,7 +250,7 @@ func TestMirroredBlobAccessGetFromComposite(t *testing.T) {
 		backendA.EXPECT().GetFromComposite(ctx, parentDigest, childDigest, slicer).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateComposite(ctx, parentDigest, childDigest, slicer).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 		data, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer).ToByteSlice(100)
 		require.NoError(t, err)
 		require.Equal(t, []byte("Hello world"), data)
@@ -121,7 +265,7 @@ func TestMirroredBlobAccessPut(t *testing.T) {
 	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
 	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
 	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
-	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 
 	t.Run("Success", func(t *testing.T) {
 		backendA.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
@@ -195,7 +339,7 @@ func TestMirroredBlobAccessFindMissing(t *testing.T) {
 	onlyOnB := digestB.ToSingletonSet()
 	missingFromA := digest.NewSetBuilder().Add(digestNone).Add(digestB).Build()
 	missingFromB := digest.NewSetBuilder().Add(digestNone).Add(digestA).Build()
-	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
 
 	t.Run("Success", func(t *testing.T) {
 		// Listings of both backends should be requested.
@@ -274,3 +418,34 @@ func TestMirroredBlobAccessFindMissing(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend B returned inconsistent results while synchronizing: Object 522b44d647b6989f60302ef755c277e508d5bcc38f05e139906ebdb03a5b19f2 not found"), err)
 	})
 }
+
+func TestMirroredBlobAccessInvalidate(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	backendA := mock.NewMockBlobAccess(ctrl)
+	backendB := mock.NewMockBlobAccess(ctrl)
+	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
+	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
+	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, false, nil)
+	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
+
+	t.Run("Failure", func(t *testing.T) {
+		// Objects must be invalidated in both backends, as
+		// they would otherwise be replicated back.
+		backendA.EXPECT().Invalidate(gomock.Any(), blobDigest.ToSingletonSet())
+		backendB.EXPECT().Invalidate(gomock.Any(), blobDigest.ToSingletonSet()).
+			Return(status.Error(codes.Internal, "Server on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Backend B: Server on fire"),
+			blobAccess.Invalidate(ctx, blobDigest.ToSingletonSet()))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		backendA.EXPECT().Invalidate(gomock.Any(), blobDigest.ToSingletonSet())
+		backendB.EXPECT().Invalidate(gomock.Any(), blobDigest.ToSingletonSet())
+
+		require.NoError(t, blobAccess.Invalidate(ctx, blobDigest.ToSingletonSet()))
+	})
+}
@@ -0,0 +1,162 @@
+package mirrored
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+const (
+	// The number of recent reads of which the latency is tracked.
+	latencySampleCount = 1024
+	// The number of reads that need to be performed before the
+	// latency percentile is used.
+	latencyMinimumSampleCount = 64
+	// The number of reads after which the latency percentile is
+	// recomputed.
+	latencyRecomputeInterval = 64
+)
+
+// latencyTracker keeps track of the latency of recent reads against a
+// single backend, so that the latency percentile after which reads
+// should be hedged can be computed.
+type latencyTracker struct {
+	lock                 sync.Mutex
+	samples              []time.Duration
+	nextIndex            int
+	sinceRecompute       int
+	percentileLatency    time.Duration
+	hasPercentileLatency bool
+}
+
+func (lt *latencyTracker) record(latency time.Duration, percentile float64) {
+	lt.lock.Lock()
+	defer lt.lock.Unlock()
+
+	if len(lt.samples) < latencySampleCount {
+		lt.samples = append(lt.samples, latency)
+	} else {
+		lt.samples[lt.nextIndex] = latency
+		lt.nextIndex = (lt.nextIndex + 1) % latencySampleCount
+	}
+
+	lt.sinceRecompute++
+	if len(lt.samples) >= latencyMinimumSampleCount && lt.sinceRecompute >= latencyRecomputeInterval {
+		sorted := slices.Clone(lt.samples)
+		slices.Sort(sorted)
+		lt.percentileLatency = sorted[int(percentile/100*float64(len(sorted)-1))]
+		lt.hasPercentileLatency = true
+		lt.sinceRecompute = 0
+	}
+}
+
+func (lt *latencyTracker) getPercentileLatency() (time.Duration, bool) {
+	lt.lock.Lock()
+	defer lt.lock.Unlock()
+	return lt.percentileLatency, lt.hasPercentileLatency
+}
+
+// ReadHedger can be provided to NewMirroredBlobAccess() to enable
+// hedging of reads. If a backend does not respond to a read within a
+// given percentile of the latency of recent reads, the same read is
+// sent to the other backend. The response that arrives first is used.
+//
+// A backend is considered to have responded once its Get() call
+// returns. The buffer that it returns is passed on to the caller
+// unmodified.
+type ReadHedger struct {
+	clock             clock.Clock
+	latencyPercentile float64
+	minimumDelay      time.Duration
+	maximumDelay      time.Duration
+
+	latencyTrackers [2]latencyTracker
+}
+
+// NewReadHedger creates a ReadHedger.
+func NewReadHedger(clock clock.Clock, latencyPercentile float64, minimumDelay, maximumDelay time.Duration) *ReadHedger {
+	return &ReadHedger{
+		clock:             clock,
+		latencyPercentile: latencyPercentile,
+		minimumDelay:      minimumDelay,
+		maximumDelay:      maximumDelay,
+	}
+}
+
+// getDelay returns the amount of time to wait for a backend to respond
+// before sending the read to the other backend.
+func (h *ReadHedger) getDelay(backendIndex int) time.Duration {
+	delay, ok := h.latencyTrackers[backendIndex].getPercentileLatency()
+	if !ok || delay > h.maximumDelay {
+		return h.maximumDelay
+	}
+	if delay < h.minimumDelay {
+		return h.minimumDelay
+	}
+	return delay
+}
+
+// hedgedRead holds the result of a read against a single backend.
+type hedgedRead struct {
+	backend *mirroredBackend
+	buffer  buffer.Buffer
+}
+
+// startRead starts reading an object from a backend. Its result is
+// written into a channel once the backend responds.
+//
+// The latency of every read that completes is recorded, regardless of
+// whether it won or lost the race. Only recording the latency of
+// reads that won would cause the latency percentile to be biased
+// towards lower values.
+func (h *ReadHedger) startRead(ctx context.Context, backend *mirroredBackend, blobDigest digest.Digest, results chan<- hedgedRead) {
+	go func() {
+		timeStart := h.clock.Now()
+		b := backend.blobAccess.Get(ctx, blobDigest)
+		if ctx.Err() == nil {
+			h.latencyTrackers[backend.index].record(h.clock.Now().Sub(timeStart), h.latencyPercentile)
+		}
+		results <- hedgedRead{
+			backend: backend,
+			buffer:  b,
+		}
+	}()
+}
+
+func (h *ReadHedger) get(ctx context.Context, blobDigest digest.Digest, ba *mirroredBlobAccess, firstBackend, secondBackend *mirroredBackend) buffer.Buffer {
+	results := make(chan hedgedRead, 2)
+	h.startRead(ctx, firstBackend, blobDigest, results)
+	timer, timerChannel := h.clock.NewTimer(h.getDelay(firstBackend.index))
+	select {
+	case read := <-results:
+		// The first backend responded in time.
+		timer.Stop()
+		return replication.WithBlobReplicator(ctx, blobDigest, read.buffer, ba.newBlobReplicatorSelector(ctx, firstBackend, secondBackend))
+	case <-timerChannel:
+	case <-ctx.Done():
+		timer.Stop()
+		go func() { (<-results).buffer.Discard() }()
+		return buffer.NewBufferFromError(util.StatusFromContext(ctx))
+	}
+
+	// The first backend did not respond in time. Send the same
+	// read to the other backend, and use the response that
+	// arrives first. The buffer returned by the other backend is
+	// discarded once it arrives.
+	mirroredBlobAccessHedgedReadsFired.Inc()
+	h.startRead(ctx, secondBackend, blobDigest, results)
+	read := <-results
+	go func() { (<-results).buffer.Discard() }()
+	if read.backend == firstBackend {
+		return replication.WithBlobReplicator(ctx, blobDigest, read.buffer, ba.newBlobReplicatorSelector(ctx, firstBackend, secondBackend))
+	}
+	mirroredBlobAccessHedgedReadsWon.Inc()
+	return replication.WithBlobReplicator(ctx, blobDigest, read.buffer, ba.newBlobReplicatorSelector(ctx, secondBackend, firstBackend))
+}
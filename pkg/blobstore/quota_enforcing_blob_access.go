@@ -0,0 +1,244 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	quotaEnforcingBlobAccessPrometheusMetrics sync.Once
+
+	quotaEnforcingBlobAccessWindowBytesWritten = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "quota_enforcing_blob_access_window_bytes_written",
+			Help:      "Number of bytes written within the sliding window, as of the last write.",
+		},
+		[]string{"storage_type", "instance_name_prefix"})
+	quotaEnforcingBlobAccessLimitBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "quota_enforcing_blob_access_limit_bytes",
+			Help:      "Number of bytes that may be written within the sliding window before writes are deprioritized or rejected.",
+		},
+		[]string{"storage_type", "instance_name_prefix", "limit"})
+	quotaEnforcingBlobAccessBytesWritten = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "quota_enforcing_blob_access_bytes_written_total",
+			Help:      "Total number of bytes of writes that were accepted.",
+		},
+		[]string{"storage_type", "instance_name_prefix"})
+	quotaEnforcingBlobAccessWrites = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "quota_enforcing_blob_access_writes_total",
+			Help:      "Total number of writes, partitioned by whether they were accepted, deprioritized or rejected.",
+		},
+		[]string{"storage_type", "instance_name_prefix", "result"})
+)
+
+// quotaWindowBucketsCount is the number of buckets into which the
+// sliding window of QuotaEnforcingBlobAccess is split. Writes expire
+// from the sliding window with a granularity of a single bucket.
+const quotaWindowBucketsCount = 60
+
+// InstanceNamePrefixQuota contains the limits on the number of bytes
+// that may be written by QuotaEnforcingBlobAccess under a given
+// instance name prefix.
+type InstanceNamePrefixQuota struct {
+	// The instance name prefix to which the quota applies. Writes
+	// for all instance names below this prefix share the same
+	// quota.
+	InstanceNamePrefix digest.InstanceName
+
+	// The number of bytes that may be written within the sliding
+	// window before writes are deprioritized. Deprioritized writes
+	// are performed with a limited amount of concurrency. Zero
+	// disables deprioritization.
+	DeprioritizationThresholdBytes int64
+
+	// The maximum number of deprioritized writes that may be
+	// performed concurrently.
+	MaximumConcurrentDeprioritizedWrites int64
+
+	// The maximum number of bytes that may be written within the
+	// sliding window. Writes in excess of this limit fail with
+	// RESOURCE_EXHAUSTED. Zero disables this limit.
+	MaximumBytes int64
+}
+
+type quotaEnforcingInstanceNamePrefix struct {
+	quota                InstanceNamePrefixQuota
+	deprioritizedWrites  *semaphore.Weighted
+	lock                 sync.Mutex
+	buckets              [quotaWindowBucketsCount]int64
+	currentBucket        int64
+	windowBytesWritten   int64
+	windowBytesGauge     prometheus.Gauge
+	bytesWrittenAccepted prometheus.Counter
+	writesAccepted       prometheus.Counter
+	writesDeprioritized  prometheus.Counter
+	writesRejected       prometheus.Counter
+}
+
+// advance the sliding window, so that it ends with the provided bucket.
+// This method must be called while holding the lock.
+func (p *quotaEnforcingInstanceNamePrefix) advance(bucket int64) {
+	if bucket-p.currentBucket >= quotaWindowBucketsCount {
+		// All writes have expired.
+		p.buckets = [quotaWindowBucketsCount]int64{}
+		p.currentBucket = bucket
+		p.windowBytesWritten = 0
+		return
+	}
+	for p.currentBucket < bucket {
+		p.currentBucket++
+		i := p.currentBucket % quotaWindowBucketsCount
+		p.windowBytesWritten -= p.buckets[i]
+		p.buckets[i] = 0
+	}
+}
+
+type quotaEnforcingBlobAccess struct {
+	BlobAccess
+	clock          clock.Clock
+	bucketDuration time.Duration
+	trie           *digest.InstanceNameTrie
+	prefixes       []*quotaEnforcingInstanceNamePrefix
+}
+
+// NewQuotaEnforcingBlobAccess creates a decorator for BlobAccess that
+// keeps track of the number of bytes written per instance name prefix
+// over a sliding window of time. This can be used to prevent tenants
+// sharing the same storage cluster from evicting each other's data by
+// writing excessive amounts of data.
+//
+// Writes are accounted against the quota with the longest matching
+// instance name prefix. Writes for instance names that do not match any
+// of the quotas are forwarded without being accounted.
+func NewQuotaEnforcingBlobAccess(blobAccess BlobAccess, clock clock.Clock, window time.Duration, quotas []InstanceNamePrefixQuota, storageType string) BlobAccess {
+	quotaEnforcingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(quotaEnforcingBlobAccessWindowBytesWritten)
+		prometheus.MustRegister(quotaEnforcingBlobAccessLimitBytes)
+		prometheus.MustRegister(quotaEnforcingBlobAccessBytesWritten)
+		prometheus.MustRegister(quotaEnforcingBlobAccessWrites)
+	})
+
+	ba := &quotaEnforcingBlobAccess{
+		BlobAccess:     blobAccess,
+		clock:          clock,
+		bucketDuration: window / quotaWindowBucketsCount,
+		trie:           digest.NewInstanceNameTrie(),
+		prefixes:       make([]*quotaEnforcingInstanceNamePrefix, 0, len(quotas)),
+	}
+	if ba.bucketDuration <= 0 {
+		ba.bucketDuration = 1
+	}
+	for _, quota := range quotas {
+		instanceNamePrefix := quota.InstanceNamePrefix.String()
+		quotaEnforcingBlobAccessLimitBytes.WithLabelValues(storageType, instanceNamePrefix, "Deprioritization").Set(float64(quota.DeprioritizationThresholdBytes))
+		quotaEnforcingBlobAccessLimitBytes.WithLabelValues(storageType, instanceNamePrefix, "Maximum").Set(float64(quota.MaximumBytes))
+
+		maximumConcurrentDeprioritizedWrites := quota.MaximumConcurrentDeprioritizedWrites
+		if maximumConcurrentDeprioritizedWrites <= 0 {
+			maximumConcurrentDeprioritizedWrites = 1
+		}
+		ba.trie.Set(quota.InstanceNamePrefix, len(ba.prefixes))
+		ba.prefixes = append(ba.prefixes, &quotaEnforcingInstanceNamePrefix{
+			quota:                quota,
+			deprioritizedWrites:  semaphore.NewWeighted(maximumConcurrentDeprioritizedWrites),
+			windowBytesGauge:     quotaEnforcingBlobAccessWindowBytesWritten.WithLabelValues(storageType, instanceNamePrefix),
+			bytesWrittenAccepted: quotaEnforcingBlobAccessBytesWritten.WithLabelValues(storageType, instanceNamePrefix),
+			writesAccepted:       quotaEnforcingBlobAccessWrites.WithLabelValues(storageType, instanceNamePrefix, "Accepted"),
+			writesDeprioritized:  quotaEnforcingBlobAccessWrites.WithLabelValues(storageType, instanceNamePrefix, "Deprioritized"),
+			writesRejected:       quotaEnforcingBlobAccessWrites.WithLabelValues(storageType, instanceNamePrefix, "Rejected"),
+		})
+	}
+	return ba
+}
+
+func (ba *quotaEnforcingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	idx := ba.trie.GetLongestPrefix(blobDigest.GetInstanceName())
+	if idx < 0 {
+		return ba.BlobAccess.Put(ctx, blobDigest, b)
+	}
+	p := ba.prefixes[idx]
+
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return err
+	}
+
+	// Account the write against the quota prior to forwarding it,
+	// so that concurrent writes cannot exceed the quota.
+	bucket := ba.clock.Now().UnixNano() / int64(ba.bucketDuration)
+	p.lock.Lock()
+	p.advance(bucket)
+	windowBytesWritten := p.windowBytesWritten
+	if maximumBytes := p.quota.MaximumBytes; maximumBytes > 0 && windowBytesWritten+sizeBytes > maximumBytes {
+		p.windowBytesGauge.Set(float64(windowBytesWritten))
+		p.lock.Unlock()
+		p.writesRejected.Inc()
+		b.Discard()
+		return status.Errorf(codes.ResourceExhausted, "Writing %d bytes would cause the quota of %d bytes for instance name prefix %#v to be exceeded, as %d bytes have already been written recently", sizeBytes, maximumBytes, p.quota.InstanceNamePrefix.String(), windowBytesWritten)
+	}
+	p.buckets[bucket%quotaWindowBucketsCount] += sizeBytes
+	p.windowBytesWritten += sizeBytes
+	p.windowBytesGauge.Set(float64(p.windowBytesWritten))
+	p.lock.Unlock()
+
+	if threshold := p.quota.DeprioritizationThresholdBytes; threshold > 0 && windowBytesWritten+sizeBytes > threshold {
+		// Writes above the deprioritization threshold are
+		// performed with limited concurrency, so that they
+		// cannot saturate storage.
+		p.writesDeprioritized.Inc()
+		if err := util.AcquireSemaphore(ctx, p.deprioritizedWrites, 1); err != nil {
+			ba.refund(p, bucket, sizeBytes)
+			b.Discard()
+			return err
+		}
+		defer p.deprioritizedWrites.Release(1)
+	} else {
+		p.writesAccepted.Inc()
+	}
+
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		// The data was not stored, so it should not count
+		// towards the quota.
+		ba.refund(p, bucket, sizeBytes)
+		return err
+	}
+	p.bytesWrittenAccepted.Add(float64(sizeBytes))
+	return nil
+}
+
+// refund bytes that were accounted against a quota for a write that
+// did not complete. Nothing needs to be done if the write has already
+// expired from the sliding window.
+func (ba *quotaEnforcingBlobAccess) refund(p *quotaEnforcingInstanceNamePrefix, bucket, sizeBytes int64) {
+	p.lock.Lock()
+	p.advance(ba.clock.Now().UnixNano() / int64(ba.bucketDuration))
+	if p.currentBucket-bucket < quotaWindowBucketsCount {
+		p.buckets[bucket%quotaWindowBucketsCount] -= sizeBytes
+		p.windowBytesWritten -= sizeBytes
+	}
+	p.windowBytesGauge.Set(float64(p.windowBytesWritten))
+	p.lock.Unlock()
+}
@@ -0,0 +1,117 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestQuotaEnforcingBlobAccessPut(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewQuotaEnforcingBlobAccess(
+		baseBlobAccess,
+		clock,
+		time.Minute,
+		[]blobstore.InstanceNamePrefixQuota{
+			{
+				InstanceNamePrefix:                   digest.MustNewInstanceName("team"),
+				DeprioritizationThresholdBytes:       5,
+				MaximumConcurrentDeprioritizedWrites: 1,
+				MaximumBytes:                         10,
+			},
+		},
+		"cas")
+	teamDigest := digest.MustNewDigest("team/project", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("NoQuota", func(t *testing.T) {
+		// Writes for instance names that do not match any of
+		// the quotas should be forwarded without accounting.
+		blobDigest := digest.MustNewDigest("other", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Accepted", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseBlobAccess.EXPECT().Put(ctx, teamDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, teamDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Deprioritized", func(t *testing.T) {
+		// This write causes the deprioritization threshold to be
+		// exceeded, but is still within the maximum.
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		baseBlobAccess.EXPECT().Put(ctx, teamDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, teamDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		// This write would cause the maximum to be exceeded.
+		clock.EXPECT().Now().Return(time.Unix(1002, 0))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Writing 5 bytes would cause the quota of 10 bytes for instance name prefix \"team\" to be exceeded, as 10 bytes have already been written recently"),
+			blobAccess.Put(ctx, teamDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Refund", func(t *testing.T) {
+		// After a minute, the first write should have expired
+		// from the sliding window, meaning that writes are
+		// permitted again. Failed writes should not count
+		// towards the quota.
+		clock.EXPECT().Now().Return(time.Unix(1060, 0)).Times(2)
+		baseBlobAccess.EXPECT().Put(ctx, teamDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Internal, "Disk on fire")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Disk on fire"),
+			blobAccess.Put(ctx, teamDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		clock.EXPECT().Now().Return(time.Unix(1060, 0))
+		baseBlobAccess.EXPECT().Put(ctx, teamDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, teamDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
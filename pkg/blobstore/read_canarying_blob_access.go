@@ -191,6 +191,10 @@ func (ba *readCanaryingBlobAccess) FindMissing(ctx context.Context, digests dige
 	return digest.GetUnion(append(missingFromReplicas, missingFromSource)), nil
 }
 
+func (ba *readCanaryingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
 // readCanaryingReplicaGetErrorHandler is the ErrorHandler that is
 // attached to all buffers read from the replica backend through the
 // Get() operation.
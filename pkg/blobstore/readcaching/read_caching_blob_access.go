@@ -8,6 +8,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -61,3 +62,25 @@ func (ba *readCachingBlobAccess) GetFromComposite(ctx context.Context, parentDig
 		ba.fast,
 		ba.getBlobReplicatorSelector())
 }
+
+func (ba *readCachingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Invalidate objects in the slow data store first, so that
+	// concurrent reads don't repopulate the fast data store.
+	if err := ba.BlobAccess.Invalidate(ctx, digests); err != nil {
+		return util.StatusWrap(err, "Slow")
+	}
+	if err := ba.fast.Invalidate(ctx, digests); err != nil {
+		return util.StatusWrap(err, "Fast")
+	}
+	return nil
+}
+
+func (ba *readCachingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	if err := ba.BlobAccess.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix); err != nil {
+		return util.StatusWrap(err, "Slow")
+	}
+	if err := ba.fast.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix); err != nil {
+		return util.StatusWrap(err, "Fast")
+	}
+	return nil
+}
@@ -103,3 +103,7 @@ func (ba *readFallbackBlobAccess) FindMissing(ctx context.Context, digests diges
 
 	return missingInBoth, nil
 }
+
+func (ba *readFallbackBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
@@ -207,6 +207,22 @@ func (ba *referenceExpandingBlobAccess) FindMissing(ctx context.Context, digests
 	return ba.indirectContentAddressableStorage.FindMissing(ctx, digests)
 }
 
+func (ba *referenceExpandingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *referenceExpandingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	return ba.indirectContentAddressableStorage.Invalidate(ctx, digests)
+}
+
+func (ba *referenceExpandingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return ba.indirectContentAddressableStorage.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix)
+}
+
+func (ba *referenceExpandingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	return ba.indirectContentAddressableStorage.ListDigests(ctx, digestFunction, reportDigest)
+}
+
 func errToStatus(err error) error {
 	if err == nil {
 		return nil
@@ -20,8 +20,15 @@ type BlobReplicatorSelector func(observedErr error) (BlobReplicator, error)
 // common pattern, used by backends such as MirroredBlobAccess and
 // ReadCachingBlobAccess.
 func GetWithBlobReplicator(ctx context.Context, digest digest.Digest, initialBackend blobstore.BlobAccess, successiveBackends BlobReplicatorSelector) buffer.Buffer {
+	return WithBlobReplicator(ctx, digest, initialBackend.Get(ctx, digest), successiveBackends)
+}
+
+// WithBlobReplicator is equivalent to GetWithBlobReplicator, except
+// that it takes a buffer that was already obtained from the initial
+// backend.
+func WithBlobReplicator(ctx context.Context, digest digest.Digest, b buffer.Buffer, successiveBackends BlobReplicatorSelector) buffer.Buffer {
 	return buffer.WithErrorHandler(
-		initialBackend.Get(ctx, digest),
+		b,
 		&getReplicatingErrorHandler{
 			selector: successiveBackends,
 			context:  ctx,
@@ -0,0 +1,97 @@
+package sharding
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+)
+
+type consistentHashingRingPoint struct {
+	hash  uint64
+	index int
+}
+
+type consistentHashingShardPermuter struct {
+	shardCount int
+	ring       []consistentHashingRingPoint
+}
+
+// NewConsistentHashingShardPermuter is a shard selection algorithm
+// that places shards on a hash ring. Every shard is represented by
+// weights[i]*virtualNodesPerWeight virtual nodes, at positions that
+// only depend on the index of the shard. For every hash, shards are
+// returned in the order in which they are encountered when walking the
+// ring clockwise, starting at the position of the hash.
+//
+// Unlike NewWeightedShardPermuter(), adding a shard to the end of the
+// list only causes the keys that are placed in the new shard to be
+// moved, which is approximately the new shard's share of the total
+// weight. Every shard is returned at most once.
+func NewConsistentHashingShardPermuter(weights []uint32, virtualNodesPerWeight uint32) ShardPermuter {
+	totalVirtualNodes := 0
+	for _, weight := range weights {
+		totalVirtualNodes += int(weight) * int(virtualNodesPerWeight)
+	}
+	ring := make([]consistentHashingRingPoint, 0, totalVirtualNodes)
+	var key [16]byte
+	for index, weight := range weights {
+		binary.LittleEndian.PutUint64(key[:8], uint64(index))
+		for virtualNode := 0; virtualNode < int(weight)*int(virtualNodesPerWeight); virtualNode++ {
+			binary.LittleEndian.PutUint64(key[8:], uint64(virtualNode))
+			h := fnv.New64a()
+			h.Write(key[:])
+			ring = append(ring, consistentHashingRingPoint{
+				hash:  mixConsistentHash(h.Sum64()),
+				index: index,
+			})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash != ring[j].hash {
+			return ring[i].hash < ring[j].hash
+		}
+		return ring[i].index < ring[j].index
+	})
+	return &consistentHashingShardPermuter{
+		shardCount: len(weights),
+		ring:       ring,
+	}
+}
+
+// mixConsistentHash applies the finalizer of SplitMix64 to a hash.
+// FNV-1a only provides a poor distribution in its upper bits, which
+// would cause points on the ring to be clustered.
+func mixConsistentHash(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+func (s *consistentHashingShardPermuter) GetShard(hash uint64, selector ShardSelector) {
+	h := mixConsistentHash(hash)
+	start := sort.Search(len(s.ring), func(i int) bool {
+		return s.ring[i].hash >= h
+	})
+
+	// Walk the ring clockwise, skipping virtual nodes of shards
+	// that have already been returned. Only allocate bookkeeping
+	// in case more than one shard is requested.
+	var returned []bool
+	for i := 0; i < len(s.ring); i++ {
+		index := s.ring[(start+i)%len(s.ring)].index
+		if returned == nil {
+			if !selector(index) {
+				return
+			}
+			returned = make([]bool, s.shardCount)
+		} else if returned[index] {
+			continue
+		} else if !selector(index) {
+			return
+		}
+		returned[index] = true
+	}
+}
@@ -0,0 +1,68 @@
+package sharding_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/lazybeaver/xorshift"
+	"github.com/stretchr/testify/require"
+)
+
+func getFirstShard(s sharding.ShardPermuter, hash uint64) int {
+	var firstIndex int
+	s.GetShard(hash, func(index int) bool {
+		firstIndex = index
+		return false
+	})
+	return firstIndex
+}
+
+func TestConsistentHashingShardPermuterDistribution(t *testing.T) {
+	// Distribution across five backends with a total weight of 15.
+	weights := []uint32{1, 4, 2, 5, 3}
+	s := sharding.NewConsistentHashingShardPermuter(weights, 1000)
+
+	occurrences := map[int]uint32{}
+	hashes := xorshift.NewXorShift64Star(1)
+	for i := 0; i < 1000000; i++ {
+		occurrences[getFirstShard(s, hashes.Next())]++
+	}
+
+	// Keys should be fanned out with a small error margin.
+	for shard, weight := range weights {
+		require.InEpsilon(t, weight*1000000/15, occurrences[shard], 0.05)
+	}
+}
+
+func TestConsistentHashingShardPermuterPermutation(t *testing.T) {
+	// Every shard should be returned exactly once, after which
+	// GetShard() terminates.
+	s := sharding.NewConsistentHashingShardPermuter([]uint32{1, 4, 2, 5, 3}, 100)
+	occurrences := map[int]int{}
+	s.GetShard(9127725482751685232, func(index int) bool {
+		occurrences[index]++
+		return true
+	})
+	require.Equal(t, map[int]int{0: 1, 1: 1, 2: 1, 3: 1, 4: 1}, occurrences)
+}
+
+func TestConsistentHashingShardPermuterAddShard(t *testing.T) {
+	// Adding a shard to the end of the list should only cause
+	// keys to be moved to the new shard. With four shards of
+	// equal weight becoming five, approximately 1/5 of the keys
+	// should move.
+	sBefore := sharding.NewConsistentHashingShardPermuter([]uint32{1, 1, 1, 1}, 1000)
+	sAfter := sharding.NewConsistentHashingShardPermuter([]uint32{1, 1, 1, 1, 1}, 1000)
+
+	moved := 0
+	hashes := xorshift.NewXorShift64Star(1)
+	for i := 0; i < 100000; i++ {
+		hash := hashes.Next()
+		before, after := getFirstShard(sBefore, hash), getFirstShard(sAfter, hash)
+		if before != after {
+			require.Equal(t, 4, after)
+			moved++
+		}
+	}
+	require.InEpsilon(t, 100000/5, moved, 0.05)
+}
@@ -0,0 +1,198 @@
+package sharding
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	drainingShardSetPrometheusMetrics sync.Once
+
+	drainingShardSetShardDraining = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_draining",
+			Help:      "Whether a shard of ShardingBlobAccess is draining (1) or not (0)",
+		},
+		[]string{"storage_type", "shard"})
+)
+
+// DrainingShardSet keeps track of which shards of a
+// ShardingBlobAccess are draining. Unlike shards that have no backend
+// configured, draining shards keep their place in the permutation.
+// This means that the set of draining shards can be adjusted at
+// runtime without causing data to be reshuffled.
+//
+// Writes skip over draining shards, as if they were drained. Reads
+// still consult draining shards, but fall through to the next shard in
+// the permutation if the object cannot be obtained. This permits
+// storage nodes to undergo maintenance one by one.
+type DrainingShardSet struct {
+	undrainedShards []bool
+	draining        atomic.Pointer[[]bool]
+
+	drainingGauges []prometheus.Gauge
+}
+
+// NewDrainingShardSet creates a DrainingShardSet for a list of
+// backends of ShardingBlobAccess. Initially, none of the shards are
+// draining.
+func NewDrainingShardSet(backends []blobstore.BlobAccess, storageType string) *DrainingShardSet {
+	drainingShardSetPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(drainingShardSetShardDraining)
+	})
+
+	undrainedShards := make([]bool, 0, len(backends))
+	drainingGauges := make([]prometheus.Gauge, 0, len(backends))
+	for index, backend := range backends {
+		undrainedShards = append(undrainedShards, backend != nil)
+		var drainingGauge prometheus.Gauge
+		if backend != nil {
+			drainingGauge = drainingShardSetShardDraining.WithLabelValues(storageType, strconv.FormatInt(int64(index), 10))
+			drainingGauge.Set(0)
+		}
+		drainingGauges = append(drainingGauges, drainingGauge)
+	}
+	return &DrainingShardSet{
+		undrainedShards: undrainedShards,
+		drainingGauges:  drainingGauges,
+	}
+}
+
+// IsDraining returns whether the shard with a given index is draining.
+func (s *DrainingShardSet) IsDraining(index int) bool {
+	draining := s.draining.Load()
+	return draining != nil && (*draining)[index]
+}
+
+// SetDraining replaces the set of shards that are draining. At least
+// one shard having a backend must remain undrained, as writes would
+// otherwise have nowhere to go.
+func (s *DrainingShardSet) SetDraining(indices []int) error {
+	draining := make([]bool, len(s.undrainedShards))
+	for _, index := range indices {
+		if index < 0 || index >= len(s.undrainedShards) {
+			return status.Errorf(codes.InvalidArgument, "Shard index %d is not in range [0, %d)", index, len(s.undrainedShards))
+		}
+		draining[index] = true
+	}
+	for index, undrained := range s.undrainedShards {
+		if undrained && !draining[index] {
+			s.draining.Store(&draining)
+			s.updateDrainingGauges(draining)
+			return nil
+		}
+	}
+	return status.Error(codes.InvalidArgument, "Cannot drain all shards that have a backend")
+}
+
+// updateDrainingGauges updates the Prometheus metrics that expose
+// whether shards are draining.
+func (s *DrainingShardSet) updateDrainingGauges(draining []bool) {
+	for index, drainingGauge := range s.drainingGauges {
+		if drainingGauge != nil {
+			if draining[index] {
+				drainingGauge.Set(1)
+			} else {
+				drainingGauge.Set(0)
+			}
+		}
+	}
+}
+
+// LoadFromFile replaces the set of shards that are draining with the
+// contents of a file. The file should contain the indices of the
+// draining shards, one per line. Empty lines and comments starting
+// with '#' are ignored. A file that does not exist is interpreted as
+// having no draining shards, so that draining can be undone by
+// removing the file.
+func (s *DrainingShardSet) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s.SetDraining(nil)
+		}
+		return util.StatusWrapf(err, "Failed to read %#v", path)
+	}
+
+	var indices []int
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		index, err := strconv.Atoi(line)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Line %d of %#v: Invalid shard index %#v", lineNumber, path, line)
+		}
+		indices = append(indices, index)
+	}
+	if err := scanner.Err(); err != nil {
+		return util.StatusWrapf(err, "Failed to read %#v", path)
+	}
+	if err := s.SetDraining(indices); err != nil {
+		return util.StatusWrapf(err, "Invalid contents of %#v", path)
+	}
+	return nil
+}
+
+// WatchFile periodically calls LoadFromFile() until the provided
+// context is cancelled. Errors are logged, causing the previously
+// loaded set of draining shards to remain in use.
+func (s *DrainingShardSet) WatchFile(ctx context.Context, clock clock.Clock, path string, refreshInterval time.Duration, errorLogger util.ErrorLogger) {
+	previous := s.getDrainingIndices()
+	for {
+		timer, t := clock.NewTimer(refreshInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-t:
+		}
+
+		if err := s.LoadFromFile(path); err != nil {
+			errorLogger.Log(err)
+			continue
+		}
+		if current := s.getDrainingIndices(); current != previous {
+			log.Printf("Set of draining shards changed from [%s] to [%s]", previous, current)
+			previous = current
+		}
+	}
+}
+
+// getDrainingIndices returns a textual representation of the set of
+// draining shards, for logging purposes.
+func (s *DrainingShardSet) getDrainingIndices() string {
+	var indices []string
+	if draining := s.draining.Load(); draining != nil {
+		for index, isDraining := range *draining {
+			if isDraining {
+				indices = append(indices, strconv.Itoa(index))
+			}
+		}
+	}
+	return strings.Join(indices, ", ")
+}
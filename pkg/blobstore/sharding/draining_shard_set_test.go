@@ -0,0 +1,78 @@
+package sharding_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDrainingShardSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	drainingShards := sharding.NewDrainingShardSet([]blobstore.BlobAccess{
+		mock.NewMockBlobAccess(ctrl),
+		nil, // Shard that is explicitly drained.
+		mock.NewMockBlobAccess(ctrl),
+	}, "cas")
+	path := filepath.Join(t.TempDir(), "draining_shards")
+
+	t.Run("NonExistentFile", func(t *testing.T) {
+		// The absence of the file should be interpreted as
+		// none of the shards draining.
+		require.NoError(t, drainingShards.LoadFromFile(path))
+		require.False(t, drainingShards.IsDraining(0))
+		require.False(t, drainingShards.IsDraining(1))
+		require.False(t, drainingShards.IsDraining(2))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("# Maintenance of storage-2.\n\n  2  # Replace disks\n"), 0o666))
+		require.NoError(t, drainingShards.LoadFromFile(path))
+		require.False(t, drainingShards.IsDraining(0))
+		require.False(t, drainingShards.IsDraining(1))
+		require.True(t, drainingShards.IsDraining(2))
+	})
+
+	t.Run("InvalidIndex", func(t *testing.T) {
+		// Errors should leave the previous state intact.
+		require.NoError(t, os.WriteFile(path, []byte("2\nhello\n"), 0o666))
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.InvalidArgument, "Line 2 of %#v: Invalid shard index \"hello\"", path),
+			drainingShards.LoadFromFile(path))
+		require.True(t, drainingShards.IsDraining(2))
+
+		require.NoError(t, os.WriteFile(path, []byte("3\n"), 0o666))
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.InvalidArgument, "Invalid contents of %#v: Shard index 3 is not in range [0, 3)", path),
+			drainingShards.LoadFromFile(path))
+		require.True(t, drainingShards.IsDraining(2))
+	})
+
+	t.Run("AllShardsDraining", func(t *testing.T) {
+		// At least one shard needs to remain available for
+		// writes.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Cannot drain all shards that have a backend"),
+			drainingShards.SetDraining([]int{0, 2}))
+		require.False(t, drainingShards.IsDraining(0))
+		require.True(t, drainingShards.IsDraining(2))
+
+		require.NoError(t, drainingShards.SetDraining([]int{0, 1}))
+		require.True(t, drainingShards.IsDraining(0))
+		require.False(t, drainingShards.IsDraining(2))
+	})
+}
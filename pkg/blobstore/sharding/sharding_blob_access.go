@@ -2,51 +2,132 @@ package sharding
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"sync/atomic"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var (
+	shardingBlobAccessPrometheusMetrics sync.Once
+
+	shardingBlobAccessDrainingShardReadFallthroughs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_draining_shard_read_fallthroughs_total",
+			Help:      "Number of reads against a draining shard that fell through to the next shard in the permutation",
+		},
+		[]string{"storage_type", "shard"})
+	shardingBlobAccessShardReads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_reads_total",
+			Help:      "Number of reads that were sent to a shard, including reads against draining shards",
+		},
+		[]string{"storage_type", "shard"})
+	shardingBlobAccessShardWrites = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "sharding_blob_access_shard_writes_total",
+			Help:      "Number of writes that were sent to a shard, which excludes draining shards",
+		},
+		[]string{"storage_type", "shard"})
+)
+
+// shardMetrics contains the Prometheus metrics of a single shard.
+type shardMetrics struct {
+	drainingShardReadFallthroughs prometheus.Counter
+	reads                         prometheus.Counter
+	writes                        prometheus.Counter
+}
+
 type shardingBlobAccess struct {
 	backends             []blobstore.BlobAccess
 	shardPermuter        ShardPermuter
 	hashInitialization   uint64
+	drainingShards       *DrainingShardSet
+	shardMetrics         []shardMetrics
 	getCapabilitiesRound atomic.Uint64
 }
 
 // NewShardingBlobAccess is an adapter for BlobAccess that partitions
 // requests across backends by hashing the digest. A ShardPermuter is
 // used to map hashes to backends.
-func NewShardingBlobAccess(backends []blobstore.BlobAccess, shardPermuter ShardPermuter, hashInitialization uint64) blobstore.BlobAccess {
+//
+// If a DrainingShardSet is provided, shards may be marked as draining
+// at runtime. Writes skip over draining shards, while reads attempt to
+// consult them before falling through to the next shard in the
+// permutation.
+//
+// The number of reads and writes sent to every shard is exposed through
+// Prometheus metrics. This makes it possible to observe whether a
+// shard that is draining still receives traffic.
+func NewShardingBlobAccess(backends []blobstore.BlobAccess, shardPermuter ShardPermuter, hashInitialization uint64, drainingShards *DrainingShardSet, storageType string) blobstore.BlobAccess {
+	shardingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(shardingBlobAccessDrainingShardReadFallthroughs)
+		prometheus.MustRegister(shardingBlobAccessShardReads)
+		prometheus.MustRegister(shardingBlobAccessShardWrites)
+	})
+
+	metrics := make([]shardMetrics, len(backends))
+	for index, backend := range backends {
+		if backend != nil {
+			shard := strconv.FormatInt(int64(index), 10)
+			metrics[index] = shardMetrics{
+				drainingShardReadFallthroughs: shardingBlobAccessDrainingShardReadFallthroughs.WithLabelValues(storageType, shard),
+				reads:                         shardingBlobAccessShardReads.WithLabelValues(storageType, shard),
+				writes:                        shardingBlobAccessShardWrites.WithLabelValues(storageType, shard),
+			}
+		}
+	}
 	return &shardingBlobAccess{
 		backends:           backends,
 		shardPermuter:      shardPermuter,
 		hashInitialization: hashInitialization,
+		drainingShards:     drainingShards,
+		shardMetrics:       metrics,
 	}
 }
 
-func (ba *shardingBlobAccess) getBackendIndexByDigest(blobDigest digest.Digest) int {
+func (ba *shardingBlobAccess) getHashByDigest(blobDigest digest.Digest) uint64 {
 	// Hash the key using FNV-1a.
 	h := ba.hashInitialization
 	for _, c := range blobDigest.GetKey(digest.KeyWithoutInstance) {
 		h ^= uint64(c)
 		h *= 1099511628211
 	}
-	return ba.getBackendIndexByHash(h)
+	return h
+}
+
+func (ba *shardingBlobAccess) isDraining(index int) bool {
+	return ba.drainingShards != nil && ba.drainingShards.IsDraining(index)
+}
+
+func (ba *shardingBlobAccess) getBackendIndexByDigest(blobDigest digest.Digest) int {
+	return ba.getBackendIndexByHash(ba.getHashByDigest(blobDigest))
 }
 
 func (ba *shardingBlobAccess) getBackendIndexByHash(h uint64) int {
-	// Keep requesting shards until matching one that is undrained.
+	// Keep requesting shards until matching one that is undrained
+	// and not draining.
 	var selectedIndex int
 	ba.shardPermuter.GetShard(h, func(index int) bool {
-		if ba.backends[index] == nil {
+		if ba.backends[index] == nil || ba.isDraining(index) {
 			return true
 		}
 		selectedIndex = index
@@ -55,15 +136,93 @@ func (ba *shardingBlobAccess) getBackendIndexByHash(h uint64) int {
 	return selectedIndex
 }
 
+// getReadBackendIndicesByDigest returns the indices of the shards that
+// should be consulted to read an object. This is the shard to which
+// the object is written, preceded by any draining shards that come
+// before it in the permutation.
+func (ba *shardingBlobAccess) getReadBackendIndicesByDigest(blobDigest digest.Digest) []int {
+	var indices []int
+	ba.shardPermuter.GetShard(ba.getHashByDigest(blobDigest), func(index int) bool {
+		if ba.backends[index] == nil {
+			return true
+		}
+		if ba.isDraining(index) {
+			// The permuter may return the same index
+			// multiple times. Only consult it once.
+			for _, existingIndex := range indices {
+				if existingIndex == index {
+					return true
+				}
+			}
+			indices = append(indices, index)
+			return true
+		}
+		indices = append(indices, index)
+		return false
+	})
+	return indices
+}
+
+// getBlobReplicatorSelector returns a BlobReplicatorSelector that
+// causes reads that fail against a draining shard to be retried
+// against the next shard.
+func (ba *shardingBlobAccess) getBlobReplicatorSelector(indices []int) replication.BlobReplicatorSelector {
+	var drainingErr error
+	return func(observedErr error) (replication.BlobReplicator, error) {
+		index := indices[0]
+		observedErr = util.StatusWrapf(observedErr, "Shard %d", index)
+		if len(indices) == 1 {
+			// Errors other than NotFound returned by
+			// draining shards take precedence, as the
+			// object may still be stored there.
+			if drainingErr != nil && status.Code(observedErr) == codes.NotFound {
+				return nil, drainingErr
+			}
+			return nil, observedErr
+		}
+
+		// Reading from a draining shard failed. This is likely
+		// because the object was written after the shard was
+		// marked as draining, or because the shard is offline.
+		ba.shardMetrics[index].drainingShardReadFallthroughs.Inc()
+		if drainingErr == nil && status.Code(observedErr) != codes.NotFound {
+			drainingErr = observedErr
+		}
+		indices = indices[1:]
+		ba.shardMetrics[indices[0]].reads.Inc()
+		return replication.NewNoopBlobReplicator(ba.backends[indices[0]]), nil
+	}
+}
+
 func (ba *shardingBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
-	index := ba.getBackendIndexByDigest(digest)
+	indices := ba.getReadBackendIndicesByDigest(digest)
+	ba.shardMetrics[indices[0]].reads.Inc()
+	if len(indices) > 1 {
+		return replication.GetWithBlobReplicator(
+			ctx,
+			digest,
+			ba.backends[indices[0]],
+			ba.getBlobReplicatorSelector(indices))
+	}
+	index := indices[0]
 	return buffer.WithErrorHandler(
 		ba.backends[index].Get(ctx, digest),
 		shardIndexAddingErrorHandler{index: index})
 }
 
 func (ba *shardingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
-	index := ba.getBackendIndexByDigest(parentDigest)
+	indices := ba.getReadBackendIndicesByDigest(parentDigest)
+	ba.shardMetrics[indices[0]].reads.Inc()
+	if len(indices) > 1 {
+		return replication.GetFromCompositeWithBlobReplicator(
+			ctx,
+			parentDigest,
+			childDigest,
+			slicer,
+			ba.backends[indices[0]],
+			ba.getBlobReplicatorSelector(indices))
+	}
+	index := indices[0]
 	return buffer.WithErrorHandler(
 		ba.backends[index].GetFromComposite(ctx, parentDigest, childDigest, slicer),
 		shardIndexAddingErrorHandler{index: index})
@@ -71,6 +230,7 @@ func (ba *shardingBlobAccess) GetFromComposite(ctx context.Context, parentDigest
 
 func (ba *shardingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
 	index := ba.getBackendIndexByDigest(digest)
+	ba.shardMetrics[index].writes.Inc()
 	if err := ba.backends[index].Put(ctx, digest, b); err != nil {
 		return util.StatusWrapf(err, "Shard %d", index)
 	}
@@ -78,7 +238,10 @@ func (ba *shardingBlobAccess) Put(ctx context.Context, digest digest.Digest, b b
 }
 
 func (ba *shardingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
-	// Partition all digests by shard.
+	// Partition all digests by shard. Draining shards are not
+	// consulted, as objects only stored in draining shards should
+	// be reuploaded by clients. This causes frequently used objects
+	// to be migrated to the shards that remain.
 	digestsPerBackend := make([]digest.SetBuilder, 0, len(ba.backends))
 	for range ba.backends {
 		digestsPerBackend = append(digestsPerBackend, digest.NewSetBuilder())
@@ -113,6 +276,92 @@ func (ba *shardingBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	return digest.GetUnion(missingPerBackend), nil
 }
 
+func (ba *shardingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	s := &shardingFindMissingStream{
+		context:    ctx,
+		blobAccess: ba,
+		streams:    make([]blobstore.FindMissingStream, len(ba.backends)),
+	}
+	// Streams of backends are finished in parallel, meaning that
+	// calls to reportMissing need to be serialized.
+	s.reportMissing = func(blobDigest digest.Digest) {
+		s.lock.Lock()
+		reportMissing(blobDigest)
+		s.lock.Unlock()
+	}
+	return s
+}
+
+func (ba *shardingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Partition all digests by shard. Unlike FindMissing(),
+	// draining shards are included, as Get() may still return
+	// objects stored in them.
+	digestsPerBackend := make([]digest.SetBuilder, 0, len(ba.backends))
+	for range ba.backends {
+		digestsPerBackend = append(digestsPerBackend, digest.NewSetBuilder())
+	}
+	for _, blobDigest := range digests.Items() {
+		for _, index := range ba.getReadBackendIndicesByDigest(blobDigest) {
+			digestsPerBackend[index].Add(blobDigest)
+		}
+	}
+
+	group, ctxWithCancel := errgroup.WithContext(ctx)
+	for indexIter, digestsIter := range digestsPerBackend {
+		index, digests := indexIter, digestsIter
+		if digests.Length() > 0 {
+			group.Go(func() error {
+				if err := ba.backends[index].Invalidate(ctxWithCancel, digests.Build()); err != nil {
+					return util.StatusWrapf(err, "Shard %d", index)
+				}
+				return nil
+			})
+		}
+	}
+	return group.Wait()
+}
+
+func (ba *shardingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	// Objects under the instance name prefix may be stored in any
+	// of the shards.
+	group, ctxWithCancel := errgroup.WithContext(ctx)
+	for indexIter, backendIter := range ba.backends {
+		index, backend := indexIter, backendIter
+		if backend != nil {
+			group.Go(func() error {
+				if err := backend.InvalidateInstanceNamePrefix(ctxWithCancel, instanceNamePrefix); err != nil {
+					return util.StatusWrapf(err, "Shard %d", index)
+				}
+				return nil
+			})
+		}
+	}
+	return group.Wait()
+}
+
+func (ba *shardingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	// List the contents of all shards sequentially, as reportDigest
+	// may not be called concurrently. Shards may contain objects
+	// that they are no longer responsible for, for example due to
+	// shards having been added. Only report objects that Get()
+	// would be able to access.
+	for index, backend := range ba.backends {
+		if backend != nil {
+			if err := backend.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+				for _, readIndex := range ba.getReadBackendIndicesByDigest(blobDigest) {
+					if readIndex == index {
+						return reportDigest(blobDigest)
+					}
+				}
+				return nil
+			}); err != nil {
+				return util.StatusWrapf(err, "Shard %d", index)
+			}
+		}
+	}
+	return nil
+}
+
 func (ba *shardingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	// Spread requests across shards.
 	index := ba.getBackendIndexByHash(ba.getCapabilitiesRound.Add(1))
@@ -123,6 +372,49 @@ func (ba *shardingBlobAccess) GetCapabilities(ctx context.Context, instanceName
 	return capabilities, nil
 }
 
+// shardingFindMissingStream is returned by
+// shardingBlobAccess.FindMissingStreaming(). Instead of partitioning
+// batches of digests, it forwards digests to streams of the individual
+// backends. This ensures that backends receive batches of a proper
+// size, regardless of the number of shards.
+type shardingFindMissingStream struct {
+	context       context.Context
+	blobAccess    *shardingBlobAccess
+	reportMissing blobstore.FindMissingReporter
+
+	lock    sync.Mutex
+	streams []blobstore.FindMissingStream
+}
+
+func (s *shardingFindMissingStream) Add(blobDigest digest.Digest) error {
+	index := s.blobAccess.getBackendIndexByDigest(blobDigest)
+	stream := s.streams[index]
+	if stream == nil {
+		stream = s.blobAccess.backends[index].FindMissingStreaming(s.context, s.reportMissing)
+		s.streams[index] = stream
+	}
+	if err := stream.Add(blobDigest); err != nil {
+		return util.StatusWrapf(err, "Shard %d", index)
+	}
+	return nil
+}
+
+func (s *shardingFindMissingStream) Finish() error {
+	var group errgroup.Group
+	for indexIter, streamIter := range s.streams {
+		index, stream := indexIter, streamIter
+		if stream != nil {
+			group.Go(func() error {
+				if err := stream.Finish(); err != nil {
+					return util.StatusWrapf(err, "Shard %d", index)
+				}
+				return nil
+			})
+		}
+	}
+	return group.Wait()
+}
+
 type shardIndexAddingErrorHandler struct {
 	index int
 }
@@ -32,7 +32,9 @@ func TestShardingBlobAccess(t *testing.T) {
 			nil, // Shard that is explicitly drained.
 		},
 		shardPermuter,
-		/* hashInitialization = */ 0x62994904405896a1)
+		/* hashInitialization = */ 0x62994904405896a1,
+		/* drainingShards = */ nil,
+		"cas")
 
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
 	llDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "5b54c0a045f179bcbbbc9abcb8b5cd4c", 2)
@@ -191,4 +193,266 @@ func TestShardingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing)
 	})
+
+	t.Run("FindMissingStreamingSuccess", func(t *testing.T) {
+		// Digests should be forwarded to streams that are
+		// created on the backends. Backends that don't receive
+		// any digests shouldn't have any streams created.
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shardPermuter.EXPECT().GetShard(uint64(0xb1e63d21c14e3f12), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		stream1 := mock.NewMockFindMissingStream(ctrl)
+		var reportMissing1 blobstore.FindMissingReporter
+		shard1.EXPECT().FindMissingStreaming(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+				reportMissing1 = reportMissing
+				return stream1
+			})
+		stream1.EXPECT().Add(digest1)
+		stream1.EXPECT().Add(digest2)
+		stream1.EXPECT().Finish().DoAndReturn(func() error {
+			reportMissing1(digest2)
+			return nil
+		})
+
+		var missing []digest.Digest
+		stream := blobAccess.FindMissingStreaming(ctx, func(blobDigest digest.Digest) {
+			missing = append(missing, blobDigest)
+		})
+		require.NoError(t, stream.Add(digest1))
+		require.NoError(t, stream.Add(digest2))
+		require.NoError(t, stream.Finish())
+		require.Equal(t, []digest.Digest{digest2}, missing)
+	})
+
+	t.Run("FindMissingStreamingFailure", func(t *testing.T) {
+		// Errors should be prefixed with a shard number.
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		stream0 := mock.NewMockFindMissingStream(ctrl)
+		shard0.EXPECT().FindMissingStreaming(ctx, gomock.Any()).Return(stream0)
+		stream0.EXPECT().Add(digest1)
+		stream0.EXPECT().Finish().Return(status.Error(codes.Unavailable, "Server offline"))
+
+		stream := blobAccess.FindMissingStreaming(ctx, func(blobDigest digest.Digest) {
+			t.Fatal("No digests should be reported")
+		})
+		require.NoError(t, stream.Add(digest1))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Shard 0: Server offline"), stream.Finish())
+	})
+
+	t.Run("InvalidateSuccess", func(t *testing.T) {
+		// Digests should be partitioned in the same way as
+		// FindMissing().
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		shardPermuter.EXPECT().GetShard(uint64(0xb1e63d21c14e3f12), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shard0.EXPECT().Invalidate(gomock.Any(), digest1.ToSingletonSet())
+		shard1.EXPECT().Invalidate(gomock.Any(), digest2.ToSingletonSet())
+
+		require.NoError(t, blobAccess.Invalidate(
+			ctx,
+			digest.NewSetBuilder().Add(digest1).Add(digest2).Build()))
+	})
+
+	t.Run("InvalidateInstanceNamePrefixFailure", func(t *testing.T) {
+		// Instance name prefixes should be invalidated on all
+		// shards, as objects are not sharded by instance name.
+		shard0.EXPECT().InvalidateInstanceNamePrefix(gomock.Any(), digest.MustNewInstanceName("hello"))
+		shard1.EXPECT().InvalidateInstanceNamePrefix(gomock.Any(), digest.MustNewInstanceName("hello")).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Shard 1: Server offline"),
+			blobAccess.InvalidateInstanceNamePrefix(ctx, digest.MustNewInstanceName("hello")))
+	})
+
+	t.Run("ListDigestsSuccess", func(t *testing.T) {
+		// Shards may contain objects that they are no longer
+		// responsible for. These should not be reported, as
+		// they cannot be accessed through Get().
+		digestFunction := digest1.GetDigestFunction()
+		shardPermuter.EXPECT().GetShard(uint64(0xe4780eee2c3e5c4d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		shardPermuter.EXPECT().GetShard(uint64(0xb1e63d21c14e3f12), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			}).Times(2)
+		shard0.EXPECT().ListDigests(gomock.Any(), digestFunction, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+				require.NoError(t, reportDigest(digest1))
+				require.NoError(t, reportDigest(digest2))
+				return nil
+			})
+		shard1.EXPECT().ListDigests(gomock.Any(), digestFunction, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+				require.NoError(t, reportDigest(digest2))
+				return nil
+			})
+
+		var reportedDigests []digest.Digest
+		require.NoError(t, blobAccess.ListDigests(ctx, digestFunction, func(blobDigest digest.Digest) error {
+			reportedDigests = append(reportedDigests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{digest1, digest2}, reportedDigests)
+	})
+}
+
+func TestShardingBlobAccessDraining(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	shard0 := mock.NewMockBlobAccess(ctrl)
+	shard1 := mock.NewMockBlobAccess(ctrl)
+	shard2 := mock.NewMockBlobAccess(ctrl)
+	backends := []blobstore.BlobAccess{shard0, shard1, shard2}
+	shardPermuter := mock.NewMockShardPermuter(ctrl)
+	drainingShards := sharding.NewDrainingShardSet(backends, "cas")
+	require.NoError(t, drainingShards.SetDraining([]int{0, 1}))
+	blobAccess := sharding.NewShardingBlobAccess(
+		backends,
+		shardPermuter,
+		/* hashInitialization = */ 0x62994904405896a1,
+		drainingShards,
+		"cas")
+
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("GetFromDrainingShard", func(t *testing.T) {
+		// Reads should attempt to use the draining shard
+		// first. The permutation does not need to be traversed
+		// any further than the first non-draining shard.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(1))
+				require.True(t, selector(1))
+				require.False(t, selector(2))
+			})
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetFallthrough", func(t *testing.T) {
+		// If the object is not present in the draining shard,
+		// the next shard in the permutation should be tried.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(1))
+				require.False(t, selector(2))
+			})
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		shard2.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		// If none of the shards have the object, the error of
+		// the last shard should be returned.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(0))
+				require.True(t, selector(1))
+				require.False(t, selector(2))
+			})
+		shard0.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		shard2.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Shard 2: Object not found"), err)
+	})
+
+	t.Run("GetDrainingShardOffline", func(t *testing.T) {
+		// Draining shards may be taken offline. In that case
+		// reads should also fall through. If the object can't
+		// be found in the other shards, the error of the
+		// draining shard should be returned, as the object may
+		// still be stored there.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(1))
+				require.False(t, selector(2))
+			})
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		shard2.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Shard 1: Server offline"), err)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Writes should skip over draining shards.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(1))
+				require.False(t, selector(2))
+			})
+		shard2.EXPECT().Put(ctx, helloDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		// FindMissing() should only consult the shard to which
+		// the object would be written.
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.True(t, selector(0))
+				require.False(t, selector(2))
+			})
+		shard2.EXPECT().FindMissing(gomock.Any(), helloDigest.ToSingletonSet()).
+			Return(helloDigest.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, helloDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, helloDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("Undrain", func(t *testing.T) {
+		// Once shards are no longer draining, requests should
+		// be routed to them directly.
+		require.NoError(t, drainingShards.SetDraining(nil))
+		shardPermuter.EXPECT().GetShard(uint64(0x7118d6877ee9ee3d), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shard1.EXPECT().Get(ctx, helloDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Shard 1: Object not found"), err)
+	})
 }
@@ -0,0 +1,35 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type slicingBlobAccess struct {
+	BlobAccess
+}
+
+// NewSlicingBlobAccess is a decorator for BlobAccess that implements
+// GetFromComposite() by fetching the parent object using Get() and
+// slicing it on every call. The backend's own implementation of
+// GetFromComposite() is never called.
+//
+// This decorator can be placed in front of backends that would
+// otherwise store references to slices of the parent object, but are
+// configured in such a way that those references cannot be resolved.
+// An example of this is LocalBlobAccess with compression enabled, where
+// offsets of slices do not correspond to offsets within the data that
+// is stored.
+func NewSlicingBlobAccess(base BlobAccess) BlobAccess {
+	return &slicingBlobAccess{
+		BlobAccess: base,
+	}
+}
+
+func (ba *slicingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.BlobAccess.Get(ctx, parentDigest), childDigest)
+	return b
+}
@@ -0,0 +1,42 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSlicingBlobAccessGetFromComposite(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewSlicingBlobAccess(baseBlobAccess)
+
+	// GetFromComposite() should be implemented by fetching the
+	// parent object and slicing it, as opposed to calling into the
+	// backend's GetFromComposite().
+	parentDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "ed076287532e86365e841e92bfc50d8c", 12)
+	childDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "f5a7924e621e84c9280a9a27e1bcb7f6", 5)
+	parentBuffer := buffer.NewValidatedBufferFromByteSlice([]byte("Hello World!"))
+	baseBlobAccess.EXPECT().Get(ctx, parentDigest).Return(parentBuffer)
+	slicer := mock.NewMockBlobSlicer(ctrl)
+	slicer.EXPECT().Slice(parentBuffer, childDigest).DoAndReturn(func(b buffer.Buffer, childDigest digest.Digest) (buffer.Buffer, []slicing.BlobSlice) {
+		b.Discard()
+		return buffer.NewValidatedBufferFromByteSlice([]byte("World")), []slicing.BlobSlice{
+			{Digest: childDigest, OffsetBytes: 6, SizeBytes: 5},
+		}
+	})
+
+	data, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("World"), data)
+}
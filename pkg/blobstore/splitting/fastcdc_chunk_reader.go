@@ -0,0 +1,167 @@
+package splitting
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"io"
+	"math/bits"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+)
+
+// gearTable contains the random values that are used to compute the
+// rolling Gear hash. Values are derived from MD5 hashes of individual
+// byte values, so that they do not need to be stored literally.
+var gearTable [256]uint64
+
+// fastCDCMasks contains the masks that are used to determine whether
+// a chunk boundary is found, indexed by the base-2 logarithm of the
+// average chunk size. The number of bits set in each mask is equal to
+// its index, while the bits are spread out over the upper half of the
+// hash, as the lower bits depend on fewer input bytes.
+var fastCDCMasks = [...]uint64{
+	0x0000000001803110, // 64 B
+	0x0000000018035100, // 128 B
+	0x0000001800035300, // 256 B
+	0x0000019000353000, // 512 B
+	0x0000590003530000, // 1 KiB
+	0x0000d90003530000, // 2 KiB
+	0x0000d90103530000, // 4 KiB
+	0x0000d90303530000, // 8 KiB
+	0x0000d90313530000, // 16 KiB
+	0x0000d90f03530000, // 32 KiB
+	0x0000d90303537000, // 64 KiB
+	0x0000d90703537000, // 128 KiB
+	0x0000d90707537000, // 256 KiB
+	0x0000d91707537000, // 512 KiB
+	0x0000d91747537000, // 1 MiB
+	0x0000d91767537000, // 2 MiB
+	0x0000d93767537000, // 4 MiB
+}
+
+const (
+	fastCDCMasksMinimumBits = 6
+
+	// The range of average chunk sizes that is supported. The masks
+	// table needs to contain entries for two bits in either
+	// direction, due to the use of normalization level 2.
+	minimumAverageChunkSizeBits = 10
+	maximumAverageChunkSizeBits = 20
+)
+
+func init() {
+	for i := range gearTable {
+		hash := md5.Sum([]byte{byte(i)})
+		gearTable[i] = binary.BigEndian.Uint64(hash[:8])
+	}
+}
+
+// IsValidAverageChunkSize returns whether an average chunk size can be
+// used in combination with NewFastCDCChunkReader().
+func IsValidAverageChunkSize(averageChunkSizeBytes int) bool {
+	return averageChunkSizeBytes > 0 &&
+		averageChunkSizeBytes&(averageChunkSizeBytes-1) == 0 &&
+		averageChunkSizeBytes >= 1<<minimumAverageChunkSizeBits &&
+		averageChunkSizeBytes <= 1<<maximumAverageChunkSizeBits
+}
+
+// GetMaximumChunkSizeBytes returns the size of the largest chunk that
+// may be returned by a ChunkReader created by NewFastCDCChunkReader()
+// for a given average chunk size.
+func GetMaximumChunkSizeBytes(averageChunkSizeBytes int) int {
+	return averageChunkSizeBytes * 4
+}
+
+type fastCDCChunkReader struct {
+	r                 io.ReadCloser
+	minimumSizeBytes  int
+	averageSizeBytes  int
+	maskSmall         uint64
+	maskLarge         uint64
+	buffer            []byte
+	bufferedSizeBytes int
+	err               error
+}
+
+// NewFastCDCChunkReader creates a ChunkReader that decomposes a stream
+// of data into chunks using content-defined chunking, based on the
+// FastCDC 2020 algorithm with normalization level 2. Because chunk
+// boundaries are determined by the data itself, inserting or removing
+// data in the middle of a stream only affects the chunks surrounding
+// the modification. This makes it possible to deduplicate data that is
+// shared between similar objects.
+//
+// Chunks are at least a quarter of the average chunk size, and at most
+// four times the average chunk size. Only the final chunk may be
+// smaller than the minimum. The average chunk size must be a power of
+// two, as validated by IsValidAverageChunkSize().
+func NewFastCDCChunkReader(r io.ReadCloser, averageChunkSizeBytes int) buffer.ChunkReader {
+	averageChunkSizeBits := bits.Len(uint(averageChunkSizeBytes)) - 1
+	return &fastCDCChunkReader{
+		r:                r,
+		minimumSizeBytes: averageChunkSizeBytes / 4,
+		averageSizeBytes: averageChunkSizeBytes,
+		maskSmall:        fastCDCMasks[averageChunkSizeBits+2-fastCDCMasksMinimumBits],
+		maskLarge:        fastCDCMasks[averageChunkSizeBits-2-fastCDCMasksMinimumBits],
+		buffer:           make([]byte, GetMaximumChunkSizeBytes(averageChunkSizeBytes)),
+	}
+}
+
+// findCutPoint returns the size of the chunk at the start of the data
+// provided. The data must either be of the maximum chunk size, or
+// contain the remainder of the stream.
+func (r *fastCDCChunkReader) findCutPoint(data []byte) int {
+	if len(data) <= r.minimumSizeBytes {
+		return len(data)
+	}
+
+	// Use a stricter mask for chunks smaller than the average size,
+	// and a more lenient mask for chunks larger than the average
+	// size. This causes chunk sizes to be normally distributed.
+	center := r.averageSizeBytes
+	if center > len(data) {
+		center = len(data)
+	}
+	var hash uint64
+	for i := r.minimumSizeBytes; i < center; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&r.maskSmall == 0 {
+			return i
+		}
+	}
+	for i := center; i < len(data); i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&r.maskLarge == 0 {
+			return i
+		}
+	}
+	return len(data)
+}
+
+func (r *fastCDCChunkReader) Read() ([]byte, error) {
+	// Fill the buffer, so that we either have enough data to
+	// produce a chunk of the maximum size, or all remaining data.
+	for r.err == nil && r.bufferedSizeBytes < len(r.buffer) {
+		var n int
+		n, r.err = r.r.Read(r.buffer[r.bufferedSizeBytes:])
+		r.bufferedSizeBytes += n
+	}
+	if r.err != nil && r.err != io.EOF {
+		return nil, r.err
+	}
+	if r.bufferedSizeBytes == 0 {
+		return nil, io.EOF
+	}
+
+	// Return a copy of the chunk, so that callers may retain it.
+	// Move any trailing data to the start of the buffer.
+	cutPoint := r.findCutPoint(r.buffer[:r.bufferedSizeBytes])
+	chunk := append([]byte(nil), r.buffer[:cutPoint]...)
+	r.bufferedSizeBytes = copy(r.buffer, r.buffer[cutPoint:r.bufferedSizeBytes])
+	return chunk, nil
+}
+
+func (r *fastCDCChunkReader) Close() {
+	r.r.Close()
+	r.buffer = nil
+}
@@ -0,0 +1,93 @@
+package splitting_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/splitting"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func readAllChunks(t *testing.T, data []byte, averageChunkSizeBytes int) [][]byte {
+	r := splitting.NewFastCDCChunkReader(io.NopCloser(bytes.NewReader(data)), averageChunkSizeBytes)
+	defer r.Close()
+	var chunks [][]byte
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			return chunks
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestFastCDCChunkReader(t *testing.T) {
+	data := make([]byte, 256*1024)
+	rand.New(rand.NewSource(123)).Read(data)
+
+	t.Run("Empty", func(t *testing.T) {
+		require.Empty(t, readAllChunks(t, nil, 1024))
+	})
+
+	t.Run("SmallerThanMinimum", func(t *testing.T) {
+		// Data that is smaller than the minimum chunk size
+		// should be returned as a single chunk.
+		require.Equal(t, [][]byte{data[:100]}, readAllChunks(t, data[:100], 1024))
+	})
+
+	t.Run("ChunkSizes", func(t *testing.T) {
+		// Chunks should be between a quarter and four times
+		// the average chunk size, and concatenate to the
+		// original data.
+		chunks := readAllChunks(t, data, 1024)
+		for _, chunk := range chunks[:len(chunks)-1] {
+			require.GreaterOrEqual(t, len(chunk), 256)
+			require.LessOrEqual(t, len(chunk), 4096)
+		}
+		require.Equal(t, data, bytes.Join(chunks, nil))
+
+		averageChunkSizeBytes := len(data) / len(chunks)
+		require.Greater(t, averageChunkSizeBytes, 512)
+		require.Less(t, averageChunkSizeBytes, 2048)
+	})
+
+	t.Run("InsertionAtStart", func(t *testing.T) {
+		// Inserting data at the start of the stream should
+		// only cause the leading chunks to change, as chunk
+		// boundaries are determined by the contents of the
+		// data.
+		originalChunks := map[string]struct{}{}
+		for _, chunk := range readAllChunks(t, data, 1024) {
+			originalChunks[string(chunk)] = struct{}{}
+		}
+
+		modifiedData := append([]byte("Hello world"), data...)
+		modifiedChunks := readAllChunks(t, modifiedData, 1024)
+		sharedChunks := 0
+		for _, chunk := range modifiedChunks {
+			if _, ok := originalChunks[string(chunk)]; ok {
+				sharedChunks++
+			}
+		}
+		require.GreaterOrEqual(t, sharedChunks, len(modifiedChunks)-2)
+	})
+
+	t.Run("ReadFailure", func(t *testing.T) {
+		r := splitting.NewFastCDCChunkReader(
+			io.NopCloser(io.MultiReader(
+				bytes.NewReader(data[:100]),
+				iotest.ErrReader(status.Error(codes.Internal, "Disk on fire")))),
+			1024)
+		defer r.Close()
+
+		_, err := r.Read()
+		require.Equal(t, status.Error(codes.Internal, "Disk on fire"), err)
+	})
+}
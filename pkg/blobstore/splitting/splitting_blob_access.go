@@ -0,0 +1,384 @@
+package splitting
+
+import (
+	"context"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/icas"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// splittingBlobAccessFindMissingBatchSize is the number of
+	// chunks whose existence is checked at once when storing an
+	// object.
+	splittingBlobAccessFindMissingBatchSize = 64
+	// splittingBlobAccessChunkListLoadConcurrency is the maximum
+	// number of lists of chunks that are loaded concurrently as
+	// part of FindMissing().
+	splittingBlobAccessChunkListLoadConcurrency = 100
+)
+
+type splittingBlobAccess struct {
+	contentAddressableStorage         blobstore.BlobAccess
+	indirectContentAddressableStorage blobstore.BlobAccess
+	averageChunkSizeBytes             int
+	maximumChunkSizeBytes             int
+	maximumMessageSizeBytes           int
+}
+
+// NewSplittingBlobAccess creates a decorator for the Content
+// Addressable Storage (CAS) that decomposes large objects into chunks
+// using content-defined chunking. Chunks are written into the CAS,
+// while the list of chunks that make up an object is written into an
+// Indirect Content Addressable Storage (ICAS). When objects are read,
+// they are reassembled from their chunks transparently.
+//
+// Objects that do not exceed the maximum chunk size are stored in the
+// CAS directly. Large objects for which no list of chunks exists are
+// also read from the CAS directly, allowing this decorator to be
+// placed in front of a CAS that already contains data.
+//
+// Chunks are written while the object is being received, meaning that
+// the amount of memory used is bounded by the size of a batch of
+// chunks whose existence is checked at once. The list of chunks is
+// only written after the object has been validated against its digest.
+//
+// TODO: Expose the lists of chunks through the REv2 SplitBlob() and
+// SpliceBlob() RPCs. This requires upgrading to a version of the
+// Remote Execution API that provides them.
+func NewSplittingBlobAccess(contentAddressableStorage, indirectContentAddressableStorage blobstore.BlobAccess, averageChunkSizeBytes, maximumMessageSizeBytes int) blobstore.BlobAccess {
+	return &splittingBlobAccess{
+		contentAddressableStorage:         contentAddressableStorage,
+		indirectContentAddressableStorage: indirectContentAddressableStorage,
+		averageChunkSizeBytes:             averageChunkSizeBytes,
+		maximumChunkSizeBytes:             GetMaximumChunkSizeBytes(averageChunkSizeBytes),
+		maximumMessageSizeBytes:           maximumMessageSizeBytes,
+	}
+}
+
+func (ba *splittingBlobAccess) isSplittable(blobDigest digest.Digest) bool {
+	return blobDigest.GetSizeBytes() > int64(ba.maximumChunkSizeBytes)
+}
+
+// getChunkDigests loads the list of chunks of an object from the ICAS.
+func (ba *splittingBlobAccess) getChunkDigests(ctx context.Context, blobDigest digest.Digest) ([]digest.Digest, error) {
+	referenceMessage, err := ba.indirectContentAddressableStorage.Get(ctx, blobDigest).ToProto(&icas.Reference{}, ba.maximumMessageSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	reference := referenceMessage.(*icas.Reference)
+	medium, ok := reference.Medium.(*icas.Reference_ChunkList_)
+	if !ok || reference.OffsetBytes != 0 || reference.SizeBytes != 0 || reference.Decompressor != remoteexecution.Compressor_IDENTITY {
+		return nil, status.Error(codes.Internal, "Reference does not contain a plain list of chunks")
+	}
+
+	digestFunction := blobDigest.GetDigestFunction()
+	chunkDigests := make([]digest.Digest, 0, len(medium.ChunkList.ChunkDigests))
+	var totalSizeBytes int64
+	for i, chunkDigestMessage := range medium.ChunkList.ChunkDigests {
+		chunkDigest, err := digestFunction.NewDigestFromProto(chunkDigestMessage)
+		if err != nil {
+			return nil, util.StatusWrapfWithCode(err, codes.Internal, "Invalid digest for chunk at index %d", i)
+		}
+		chunkDigests = append(chunkDigests, chunkDigest)
+		totalSizeBytes += chunkDigest.GetSizeBytes()
+	}
+	if totalSizeBytes != blobDigest.GetSizeBytes() {
+		return nil, status.Errorf(codes.Internal, "Chunks have a total size of %d bytes, while the object has a size of %d bytes", totalSizeBytes, blobDigest.GetSizeBytes())
+	}
+	return chunkDigests, nil
+}
+
+func (ba *splittingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if !ba.isSplittable(blobDigest) {
+		return ba.contentAddressableStorage.Get(ctx, blobDigest)
+	}
+
+	chunkDigests, err := ba.getChunkDigests(ctx, blobDigest)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Object was stored without being split.
+			return ba.contentAddressableStorage.Get(ctx, blobDigest)
+		}
+		return buffer.NewBufferFromError(util.StatusWrap(err, "Failed to load chunk list"))
+	}
+	return buffer.NewCASBufferFromChunkReader(
+		blobDigest,
+		&concatenatingChunkReader{
+			context:                   ctx,
+			contentAddressableStorage: ba.contentAddressableStorage,
+			chunkDigests:              chunkDigests,
+			maximumChunkSizeBytes:     ba.maximumChunkSizeBytes,
+		},
+		buffer.BackendProvided(buffer.Irreparable(blobDigest)))
+}
+
+func (ba *splittingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *splittingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if !ba.isSplittable(blobDigest) {
+		return ba.contentAddressableStorage.Put(ctx, blobDigest, b)
+	}
+
+	// Split the object into chunks. The existence of chunks is
+	// checked in batches, and chunks that are missing are written
+	// immediately, so that the amount of data held in memory is
+	// bounded. As chunks are content addressed, they are valid on
+	// their own. The buffer is only validated upon reaching the end
+	// of the stream, meaning that objects whose contents do not
+	// match their digest may leave chunks behind. These are not
+	// referenced by any list of chunks, and will age out.
+	digestFunction := blobDigest.GetDigestFunction()
+	r := NewFastCDCChunkReader(b.ToReader(), ba.averageChunkSizeBytes)
+	defer r.Close()
+	var chunkDigests []*remoteexecution.Digest
+	uncheckedChunks := map[digest.Digest][]byte{}
+	checkedChunks := map[digest.Digest]struct{}{}
+	storeMissingChunks := func() error {
+		uncheckedChunkDigests := digest.NewSetBuilder()
+		for chunkDigest := range uncheckedChunks {
+			uncheckedChunkDigests.Add(chunkDigest)
+			checkedChunks[chunkDigest] = struct{}{}
+		}
+		missing, err := ba.contentAddressableStorage.FindMissing(ctx, uncheckedChunkDigests.Build())
+		if err != nil {
+			return util.StatusWrap(err, "Failed to find missing chunks")
+		}
+		for _, chunkDigest := range missing.Items() {
+			if err := ba.contentAddressableStorage.Put(ctx, chunkDigest, buffer.NewValidatedBufferFromByteSlice(uncheckedChunks[chunkDigest])); err != nil {
+				return util.StatusWrapf(err, "Failed to store chunk %s", chunkDigest)
+			}
+		}
+		clear(uncheckedChunks)
+		return nil
+	}
+	for {
+		chunk, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		generator := digestFunction.NewGenerator(int64(len(chunk)))
+		generator.Write(chunk)
+		chunkDigest := generator.Sum()
+		chunkDigests = append(chunkDigests, chunkDigest.GetProto())
+		if _, ok := checkedChunks[chunkDigest]; !ok {
+			uncheckedChunks[chunkDigest] = chunk
+			if len(uncheckedChunks) >= splittingBlobAccessFindMissingBatchSize {
+				if err := storeMissingChunks(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(uncheckedChunks) > 0 {
+		if err := storeMissingChunks(); err != nil {
+			return err
+		}
+	}
+
+	reference := &icas.Reference{
+		Medium: &icas.Reference_ChunkList_{
+			ChunkList: &icas.Reference_ChunkList{
+				ChunkDigests: chunkDigests,
+			},
+		},
+	}
+	if sizeBytes := proto.Size(reference); sizeBytes > ba.maximumMessageSizeBytes {
+		return status.Errorf(codes.InvalidArgument, "List of %d chunks has a size of %d bytes, which exceeds the maximum message size of %d bytes", len(chunkDigests), sizeBytes, ba.maximumMessageSizeBytes)
+	}
+
+	// The object has been validated, and all of its chunks are
+	// present. Store the list of chunks.
+	if err := ba.indirectContentAddressableStorage.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(reference, buffer.UserProvided)); err != nil {
+		return util.StatusWrap(err, "Failed to store chunk list")
+	}
+	return nil
+}
+
+func (ba *splittingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// Only large objects may have been split.
+	smallDigests, largeDigests := digest.NewSetBuilder(), digest.NewSetBuilder()
+	for _, blobDigest := range digests.Items() {
+		if ba.isSplittable(blobDigest) {
+			largeDigests.Add(blobDigest)
+		} else {
+			smallDigests.Add(blobDigest)
+		}
+	}
+	largeDigestsSet := largeDigests.Build()
+	missingChunkLists, err := ba.indirectContentAddressableStorage.FindMissing(ctx, largeDigestsSet)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrap(err, "Failed to find missing chunk lists")
+	}
+
+	// Objects without a list of chunks may have been stored without
+	// being split. For the other objects, load their lists of
+	// chunks concurrently, so that the existence of all chunks can
+	// be checked using a single call.
+	unsplitDigests := digest.GetUnion([]digest.Set{smallDigests.Build(), missingChunkLists})
+	splitDigests, _, _ := digest.GetDifferenceAndIntersection(largeDigestsSet, missingChunkLists)
+	splitDigestsList := splitDigests.Items()
+	chunkDigestsPerObject := make([][]digest.Digest, len(splitDigestsList))
+	chunkListsMissing := make([]bool, len(splitDigestsList))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(splittingBlobAccessChunkListLoadConcurrency)
+	for i, blobDigest := range splitDigestsList {
+		group.Go(func() error {
+			chunkDigests, err := ba.getChunkDigests(groupCtx, blobDigest)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					chunkListsMissing[i] = true
+					return nil
+				}
+				return util.StatusWrapf(err, "Failed to load chunk list for object %s", blobDigest)
+			}
+			chunkDigestsPerObject[i] = chunkDigests
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return digest.EmptySet, err
+	}
+
+	queriedDigests := digest.NewSetBuilder()
+	for _, blobDigest := range unsplitDigests.Items() {
+		queriedDigests.Add(blobDigest)
+	}
+	for _, chunkDigests := range chunkDigestsPerObject {
+		for _, chunkDigest := range chunkDigests {
+			queriedDigests.Add(chunkDigest)
+		}
+	}
+	missingInCAS, err := ba.contentAddressableStorage.FindMissing(ctx, queriedDigests.Build())
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	missingInCASMap := make(map[digest.Digest]struct{}, missingInCAS.Length())
+	for _, missingDigest := range missingInCAS.Items() {
+		missingInCASMap[missingDigest] = struct{}{}
+	}
+
+	// Report objects as missing if they are absent, or if any of
+	// their chunks are absent.
+	missing := digest.NewSetBuilder()
+	for _, blobDigest := range unsplitDigests.Items() {
+		if _, ok := missingInCASMap[blobDigest]; ok {
+			missing.Add(blobDigest)
+		}
+	}
+	for i, blobDigest := range splitDigestsList {
+		if chunkListsMissing[i] {
+			missing.Add(blobDigest)
+			continue
+		}
+		for _, chunkDigest := range chunkDigestsPerObject[i] {
+			if _, ok := missingInCASMap[chunkDigest]; ok {
+				missing.Add(blobDigest)
+				break
+			}
+		}
+	}
+	return missing.Build(), nil
+}
+
+func (ba *splittingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing blobstore.FindMissingReporter) blobstore.FindMissingStream {
+	return blobstore.NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *splittingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	// Remove the lists of chunks of large objects, so that they are
+	// no longer reassembled. Chunks themselves may be shared with
+	// other objects, meaning they are left intact.
+	splitDigests := digest.NewSetBuilder()
+	for _, blobDigest := range digests.Items() {
+		if ba.isSplittable(blobDigest) {
+			splitDigests.Add(blobDigest)
+		}
+	}
+	if err := ba.indirectContentAddressableStorage.Invalidate(ctx, splitDigests.Build()); err != nil {
+		return util.StatusWrap(err, "Failed to invalidate chunk lists")
+	}
+	return ba.contentAddressableStorage.Invalidate(ctx, digests)
+}
+
+func (ba *splittingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	if err := ba.indirectContentAddressableStorage.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix); err != nil {
+		return util.StatusWrap(err, "Failed to invalidate chunk lists")
+	}
+	return ba.contentAddressableStorage.InvalidateInstanceNamePrefix(ctx, instanceNamePrefix)
+}
+
+func (ba *splittingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest blobstore.ListDigestsReporter) error {
+	// Large objects are only present as lists of chunks in the
+	// ICAS. The chunks themselves are reported as well, as they are
+	// indistinguishable from regular objects stored in the CAS.
+	if err := ba.indirectContentAddressableStorage.ListDigests(ctx, digestFunction, reportDigest); err != nil {
+		return util.StatusWrap(err, "Failed to list chunk lists")
+	}
+	return ba.contentAddressableStorage.ListDigests(ctx, digestFunction, reportDigest)
+}
+
+func (ba *splittingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	return ba.contentAddressableStorage.GetCapabilities(ctx, instanceName)
+}
+
+// concatenatingChunkReader is a ChunkReader that returns the contents
+// of a sequence of objects stored in the Content Addressable Storage.
+// It is used to reassemble objects from their chunks.
+type concatenatingChunkReader struct {
+	context                   context.Context
+	contentAddressableStorage blobstore.BlobAccess
+	chunkDigests              []digest.Digest
+	maximumChunkSizeBytes     int
+
+	currentDigest digest.Digest
+	current       buffer.ChunkReader
+}
+
+func (r *concatenatingChunkReader) Read() ([]byte, error) {
+	for {
+		if r.current == nil {
+			if len(r.chunkDigests) == 0 {
+				return nil, io.EOF
+			}
+			r.currentDigest = r.chunkDigests[0]
+			r.chunkDigests = r.chunkDigests[1:]
+			r.current = r.contentAddressableStorage.Get(r.context, r.currentDigest).ToChunkReader(0, r.maximumChunkSizeBytes)
+		}
+
+		data, err := r.current.Read()
+		if err == nil {
+			return data, nil
+		}
+		r.current.Close()
+		r.current = nil
+		if err != io.EOF {
+			return nil, util.StatusWrapf(err, "Failed to read chunk %s", r.currentDigest)
+		}
+	}
+}
+
+func (r *concatenatingChunkReader) Close() {
+	if r.current != nil {
+		r.current.Close()
+		r.current = nil
+	}
+	r.chunkDigests = nil
+}
@@ -0,0 +1,261 @@
+package splitting_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/splitting"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/icas"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func computeDigest(digestFunction digest.Function, data []byte) digest.Digest {
+	generator := digestFunction.NewGenerator(int64(len(data)))
+	generator.Write(data)
+	return generator.Sum()
+}
+
+// countingReader is an io.Reader that keeps track of the number of
+// bytes that have been read.
+type countingReader struct {
+	io.Reader
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += n
+	return n, err
+}
+
+func TestSplittingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	indirectContentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	blobAccess := splitting.NewSplittingBlobAccess(
+		contentAddressableStorage,
+		indirectContentAddressableStorage,
+		/* averageChunkSizeBytes = */ 1024,
+		/* maximumMessageSizeBytes = */ 10000)
+
+	digestFunction := digest.MustNewFunction("hello", remoteexecution.DigestFunction_SHA256)
+	smallData := []byte("Hello world")
+	smallDigest := computeDigest(digestFunction, smallData)
+	largeData := make([]byte, 20000)
+	rand.New(rand.NewSource(123)).Read(largeData)
+	largeDigest := computeDigest(digestFunction, largeData)
+
+	t.Run("PutSmall", func(t *testing.T) {
+		// Objects that do not exceed the maximum chunk size
+		// should be stored without being split.
+		contentAddressableStorage.EXPECT().Put(ctx, smallDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, smallData, data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, smallDigest, buffer.NewValidatedBufferFromByteSlice(smallData)))
+	})
+
+	// Chunks and chunk lists that are written by the test below,
+	// which are used by the tests that follow.
+	storedChunks := map[digest.Digest][]byte{}
+	var storedReference *icas.Reference
+
+	t.Run("PutLarge", func(t *testing.T) {
+		// The existence of chunks should be checked prior to
+		// storing them. Only chunks that are missing should be
+		// written.
+		contentAddressableStorage.EXPECT().FindMissing(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				return digests, nil
+			})
+		contentAddressableStorage.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(4096)
+				require.NoError(t, err)
+				require.Equal(t, computeDigest(digestFunction, data), blobDigest)
+				storedChunks[blobDigest] = data
+				return nil
+			}).
+			MinTimes(5)
+		indirectContentAddressableStorage.EXPECT().Put(ctx, largeDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&icas.Reference{}, 10000)
+				require.NoError(t, err)
+				storedReference = m.(*icas.Reference)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, largeDigest, buffer.NewValidatedBufferFromByteSlice(largeData)))
+		require.Len(t, storedReference.GetChunkList().GetChunkDigests(), len(storedChunks))
+	})
+
+	t.Run("PutLargeDeduplicated", func(t *testing.T) {
+		// Chunks that are already present should not be
+		// written once more.
+		contentAddressableStorage.EXPECT().FindMissing(ctx, gomock.Any()).Return(digest.EmptySet, nil)
+		indirectContentAddressableStorage.EXPECT().Put(ctx, largeDigest, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&icas.Reference{}, 10000)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, storedReference, m)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, largeDigest, buffer.NewValidatedBufferFromByteSlice(largeData)))
+	})
+
+	t.Run("PutLargeCorrupted", func(t *testing.T) {
+		// If the data of the object does not match its digest,
+		// the chunk list should not be written. Chunks that
+		// have already been written are left behind.
+
+		badDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "0000000000000000000000000000000000000000000000000000000000000000", int64(len(largeData)))
+		err := blobAccess.Put(ctx, badDigest, buffer.NewCASBufferFromByteSlice(badDigest, largeData, buffer.UserProvided))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	getStoredChunk := func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+		data, ok := storedChunks[blobDigest]
+		if !ok {
+			return buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))
+		}
+		return buffer.NewValidatedBufferFromByteSlice(data)
+	}
+
+	t.Run("GetLarge", func(t *testing.T) {
+		// The object should be reassembled from its chunks.
+		indirectContentAddressableStorage.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewProtoBufferFromProto(storedReference, buffer.BackendProvided(buffer.Irreparable(largeDigest))))
+		contentAddressableStorage.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(getStoredChunk).Times(len(storedChunks))
+
+		data, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100000)
+		require.NoError(t, err)
+		require.Equal(t, largeData, data)
+	})
+
+	t.Run("GetLargeUnsplit", func(t *testing.T) {
+		// Large objects for which no chunk list exists may
+		// have been stored without being split.
+		indirectContentAddressableStorage.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		contentAddressableStorage.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice(largeData))
+
+		data, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100000)
+		require.NoError(t, err)
+		require.Equal(t, largeData, data)
+	})
+
+	t.Run("GetLargeBadChunkList", func(t *testing.T) {
+		indirectContentAddressableStorage.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewProtoBufferFromProto(&icas.Reference{
+				Medium: &icas.Reference_ChunkList_{
+					ChunkList: &icas.Reference_ChunkList{
+						ChunkDigests: []*remoteexecution.Digest{
+							smallDigest.GetProto(),
+						},
+					},
+				},
+			}, buffer.BackendProvided(buffer.Irreparable(largeDigest))))
+
+		_, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to load chunk list: Chunks have a total size of 11 bytes, while the object has a size of 20000 bytes"), err)
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		missingSmallDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "1111111111111111111111111111111111111111111111111111111111111111", 123)
+		missingLargeDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "2222222222222222222222222222222222222222222222222222222222222222", 100000)
+		incompleteLargeDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "3333333333333333333333333333333333333333333333333333333333333333", 5000)
+		missingChunkDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "4444444444444444444444444444444444444444444444444444444444444444", 5000)
+
+		indirectContentAddressableStorage.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(largeDigest).Add(missingLargeDigest).Add(incompleteLargeDigest).Build()).
+			Return(missingLargeDigest.ToSingletonSet(), nil)
+		indirectContentAddressableStorage.EXPECT().Get(gomock.Any(), largeDigest).
+			Return(buffer.NewProtoBufferFromProto(storedReference, buffer.BackendProvided(buffer.Irreparable(largeDigest))))
+		indirectContentAddressableStorage.EXPECT().Get(gomock.Any(), incompleteLargeDigest).
+			Return(buffer.NewProtoBufferFromProto(&icas.Reference{
+				Medium: &icas.Reference_ChunkList_{
+					ChunkList: &icas.Reference_ChunkList{
+						ChunkDigests: []*remoteexecution.Digest{
+							missingChunkDigest.GetProto(),
+						},
+					},
+				},
+			}, buffer.BackendProvided(buffer.Irreparable(incompleteLargeDigest))))
+
+		casDigests := digest.NewSetBuilder().Add(smallDigest).Add(missingSmallDigest).Add(missingLargeDigest).Add(missingChunkDigest)
+		for chunkDigest := range storedChunks {
+			casDigests.Add(chunkDigest)
+		}
+		contentAddressableStorage.EXPECT().FindMissing(ctx, casDigests.Build()).
+			Return(digest.NewSetBuilder().Add(missingSmallDigest).Add(missingLargeDigest).Add(missingChunkDigest).Build(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(smallDigest).Add(missingSmallDigest).Add(largeDigest).Add(missingLargeDigest).Add(incompleteLargeDigest).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(missingSmallDigest).Add(missingLargeDigest).Add(incompleteLargeDigest).Build(), missing)
+	})
+}
+
+func TestSplittingBlobAccessPutStreaming(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	indirectContentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	blobAccess := splitting.NewSplittingBlobAccess(
+		contentAddressableStorage,
+		indirectContentAddressableStorage,
+		/* averageChunkSizeBytes = */ 1024,
+		/* maximumMessageSizeBytes = */ 100000)
+
+	// Missing chunks should be written while the object is still
+	// being received, so that objects don't need to be held in
+	// memory in their entirety.
+	digestFunction := digest.MustNewFunction("hello", remoteexecution.DigestFunction_SHA256)
+	data := make([]byte, 300000)
+	rand.New(rand.NewSource(456)).Read(data)
+	blobDigest := computeDigest(digestFunction, data)
+	r := &countingReader{Reader: bytes.NewReader(data)}
+
+	contentAddressableStorage.EXPECT().FindMissing(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+			return digests, nil
+		}).
+		MinTimes(2)
+	firstChunkReadOffset := -1
+	contentAddressableStorage.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+			if firstChunkReadOffset < 0 {
+				firstChunkReadOffset = r.n
+			}
+			_, err := b.ToByteSlice(4096)
+			return err
+		}).
+		MinTimes(64)
+	indirectContentAddressableStorage.EXPECT().Put(ctx, blobDigest, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+			require.Equal(t, len(data), r.n)
+			_, err := b.ToProto(&icas.Reference{}, 100000)
+			return err
+		})
+
+	require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewCASBufferFromReader(blobDigest, io.NopCloser(r), buffer.UserProvided)))
+	require.Less(t, firstChunkReadOffset, len(data))
+}
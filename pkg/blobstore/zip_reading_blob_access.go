@@ -4,6 +4,8 @@ import (
 	"archive/zip"
 	"context"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
@@ -93,6 +95,50 @@ func (ba *zipReadingBlobAccess) FindMissing(ctx context.Context, digests digest.
 	return missing.Build(), nil
 }
 
+func (ba *zipReadingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+func (ba *zipReadingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	return status.Error(codes.InvalidArgument, "The ZIP reading storage backend does not permit invalidation")
+}
+
+func (ba *zipReadingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return status.Error(codes.InvalidArgument, "The ZIP reading storage backend does not permit invalidation")
+}
+
+func (ba *zipReadingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	for name := range ba.files {
+		if blobDigest, ok := newDigestFromZIPFileName(name, digestFunction, ba.digestKeyFormat); ok {
+			if err := reportDigest(blobDigest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newDigestFromZIPFileName converts the name of a file stored in a ZIP
+// archive back to a digest. As file names correspond to keys of
+// digests, this is only possible for files that were stored using the
+// provided digest function, and under the digest function's instance
+// name if the key contains one.
+func newDigestFromZIPFileName(name string, digestFunction digest.Function, digestKeyFormat digest.KeyFormat) (digest.Digest, bool) {
+	fields := strings.SplitN(name, "-", 4)
+	if len(fields) < 3 || fields[0] != strconv.FormatInt(int64(digestFunction.GetEnumValue()), 10) {
+		return digest.BadDigest, false
+	}
+	sizeBytes, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return digest.BadDigest, false
+	}
+	blobDigest, err := digestFunction.NewDigest(fields[1], sizeBytes)
+	if err != nil || blobDigest.GetKey(digestKeyFormat) != name {
+		return digest.BadDigest, false
+	}
+	return blobDigest, true
+}
+
 type nopAtCloser struct {
 	io.ReaderAt
 }
@@ -161,4 +161,32 @@ func TestZIPReadingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "522b44d647b6989f60302ef755c277e508d5bcc38f05e139906ebdb03a5b19f2", 9).ToSingletonSet(), missing)
 	})
+
+	t.Run("ListDigests", func(t *testing.T) {
+		// Only files that use the requested digest function
+		// should be reported.
+		var digests []digest.Digest
+		require.NoError(t, blobAccess.ListDigests(
+			ctx,
+			digest.MustNewFunction("example", remoteexecution.DigestFunction_MD5),
+			func(blobDigest digest.Digest) error {
+				digests = append(digests, blobDigest)
+				return nil
+			}))
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		}, digests)
+
+		// Errors returned by the reporter should cause listing
+		// to stop.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Canceled, "Client disconnected"),
+			blobAccess.ListDigests(
+				ctx,
+				digest.MustNewFunction("example", remoteexecution.DigestFunction_SHA1),
+				func(blobDigest digest.Digest) error {
+					return status.Error(codes.Canceled, "Client disconnected")
+				}))
+	})
 }
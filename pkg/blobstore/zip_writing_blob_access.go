@@ -216,6 +216,44 @@ func (ba *ZIPWritingBlobAccess) FindMissing(ctx context.Context, digests digest.
 	return missing.Build(), nil
 }
 
+func (ba *ZIPWritingBlobAccess) FindMissingStreaming(ctx context.Context, reportMissing FindMissingReporter) FindMissingStream {
+	return NewBatchingFindMissingStream(ctx, ba, reportMissing)
+}
+
+// Invalidate is not supported by ZIPWritingBlobAccess, as files that
+// have been written to the ZIP archive cannot be removed.
+func (ba *ZIPWritingBlobAccess) Invalidate(ctx context.Context, digests digest.Set) error {
+	return status.Error(codes.Unimplemented, "ZIP archives do not support invalidation")
+}
+
+// InvalidateInstanceNamePrefix is not supported by
+// ZIPWritingBlobAccess, as files that have been written to the ZIP
+// archive cannot be removed.
+func (ba *ZIPWritingBlobAccess) InvalidateInstanceNamePrefix(ctx context.Context, instanceNamePrefix digest.InstanceName) error {
+	return status.Error(codes.Unimplemented, "ZIP archives do not support invalidation")
+}
+
+// ListDigests reports the digests of all objects that were
+// successfully stored in the ZIP archive through previous calls to
+// Put().
+func (ba *ZIPWritingBlobAccess) ListDigests(ctx context.Context, digestFunction digest.Function, reportDigest ListDigestsReporter) error {
+	ba.lock.Lock()
+	var blobDigests []digest.Digest
+	for key := range ba.filesAccess {
+		if blobDigest, ok := newDigestFromZIPFileName(key, digestFunction, ba.digestKeyFormat); ok {
+			blobDigests = append(blobDigests, blobDigest)
+		}
+	}
+	ba.lock.Unlock()
+
+	for _, blobDigest := range blobDigests {
+		if err := reportDigest(blobDigest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Finalize the ZIP archive by appending a central directory to the
 // underlying file. Once called, it is no longer possible to call Put().
 func (ba *ZIPWritingBlobAccess) Finalize() error {
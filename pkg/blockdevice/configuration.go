@@ -23,3 +23,22 @@ func NewBlockDeviceFromConfiguration(configuration *pb.Configuration, mayZeroIni
 		return nil, 0, 0, status.Error(codes.InvalidArgument, "Configuration did not contain a supported block device source")
 	}
 }
+
+// NewReadOnlyBlockDeviceFromConfiguration is identical to
+// NewBlockDeviceFromConfiguration, except that the block device is
+// opened for reading only. This can be used by tools that need to
+// inspect the contents of a block device without modifying it.
+func NewReadOnlyBlockDeviceFromConfiguration(configuration *pb.Configuration) (BlockDevice, int, int64, error) {
+	if configuration == nil {
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "Block device configuration not specified")
+	}
+
+	switch source := configuration.Source.(type) {
+	case *pb.Configuration_DevicePath:
+		return NewReadOnlyBlockDeviceFromDevice(source.DevicePath)
+	case *pb.Configuration_File:
+		return NewReadOnlyBlockDeviceFromFile(source.File.Path, int(source.File.SizeBytes))
+	default:
+		return nil, 0, 0, status.Error(codes.InvalidArgument, "Configuration did not contain a supported block device source")
+	}
+}
@@ -14,3 +14,11 @@ import (
 func NewBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
 	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
 }
+
+// NewReadOnlyBlockDeviceFromDevice is identical to
+// NewBlockDeviceFromDevice, except that the device node is opened for
+// reading only. This implementation is a stub for operating systems
+// that don't support block device access.
+func NewReadOnlyBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
+	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
+}
@@ -22,7 +22,19 @@ import (
 // Writes may only occur at sector boundaries, as unaligned writes would
 // cause unnecessary read operations against underlying storage.
 func NewBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
-	fd, err := unix.Open(path, unix.O_RDWR, 0)
+	return newBlockDeviceFromDevice(path, unix.O_RDWR)
+}
+
+// NewReadOnlyBlockDeviceFromDevice is identical to
+// NewBlockDeviceFromDevice, except that the device node is opened for
+// reading only. Calls to WriteAt() against the resulting BlockDevice
+// fail.
+func NewReadOnlyBlockDeviceFromDevice(path string) (BlockDevice, int, int64, error) {
+	return newBlockDeviceFromDevice(path, unix.O_RDONLY)
+}
+
+func newBlockDeviceFromDevice(path string, flags int) (BlockDevice, int, int64, error) {
+	fd, err := unix.Open(path, flags, 0)
 	if err != nil {
 		return nil, 0, 0, util.StatusWrapf(err, "Failed to open device node %#v", path)
 	}
@@ -14,3 +14,11 @@ import (
 func NewBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize bool) (BlockDevice, int, int64, error) {
 	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
 }
+
+// NewReadOnlyBlockDeviceFromFile is identical to NewBlockDeviceFromFile,
+// except that the file is opened for reading only. This implementation
+// is a stub for operating systems that don't support block device
+// access.
+func NewReadOnlyBlockDeviceFromFile(path string, minimumSizeBytes int) (BlockDevice, int, int64, error) {
+	return nil, 0, 0, status.Error(codes.Unimplemented, "Memory mapping block devices is not supported on this platform")
+}
@@ -109,3 +109,44 @@ func TestNewBlockDeviceFromFileZeroInitialize(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte("\x00\x00\x00\x00\x00"), b[:])
 }
+
+func TestNewReadOnlyBlockDeviceFromFile(t *testing.T) {
+	blockDevicePath := filepath.Join(t.TempDir(), "blockdevice")
+
+	t.Run("NonExistent", func(t *testing.T) {
+		// Unlike NewBlockDeviceFromFile(), files should not be
+		// created.
+		_, _, _, err := blockdevice.NewReadOnlyBlockDeviceFromFile(blockDevicePath, 123456)
+		require.Error(t, err)
+		_, err = os.Stat(blockDevicePath)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	blockDevice, sectorSizeBytes, sectorCount, err := blockdevice.NewBlockDeviceFromFile(blockDevicePath, 123456, true)
+	require.NoError(t, err)
+	_, err = blockDevice.WriteAt([]byte("Hello"), 12345)
+	require.NoError(t, err)
+
+	t.Run("TooSmall", func(t *testing.T) {
+		_, _, _, err := blockdevice.NewReadOnlyBlockDeviceFromFile(blockDevicePath, 1234567)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// The read-only block device should have the same
+		// dimensions as the original one.
+		readOnlyBlockDevice, readOnlySectorSizeBytes, readOnlySectorCount, err := blockdevice.NewReadOnlyBlockDeviceFromFile(blockDevicePath, 123456)
+		require.NoError(t, err)
+		require.Equal(t, sectorSizeBytes, readOnlySectorSizeBytes)
+		require.Equal(t, sectorCount, readOnlySectorCount)
+
+		var b [5]byte
+		n, err := readOnlyBlockDevice.ReadAt(b[:], 12345)
+		require.Equal(t, 5, n)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), b[:])
+
+		_, err = readOnlyBlockDevice.WriteAt([]byte("World"), 12345)
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // NewBlockDeviceFromFile creates a BlockDevice that is backed by a
@@ -48,3 +50,35 @@ func NewBlockDeviceFromFile(path string, minimumSizeBytes int, zeroInitialize bo
 	}
 	return bd, sectorSizeBytes, sectorCount, nil
 }
+
+// NewReadOnlyBlockDeviceFromFile is identical to NewBlockDeviceFromFile,
+// except that the file is opened for reading only. The file must
+// already exist and have at least the size it would have been given by
+// NewBlockDeviceFromFile. Calls to WriteAt() against the resulting
+// BlockDevice fail.
+func NewReadOnlyBlockDeviceFromFile(path string, minimumSizeBytes int) (BlockDevice, int, int64, error) {
+	fd, err := unix.Open(path, unix.O_RDONLY, 0)
+	if err != nil {
+		return nil, 0, 0, util.StatusWrapf(err, "Failed to open file %#v", path)
+	}
+
+	var stat unix.Stat_t
+	if err := unix.Fstat(fd, &stat); err != nil {
+		unix.Close(fd)
+		return nil, 0, 0, util.StatusWrapf(err, "Failed to obtain size of file %#v", path)
+	}
+	sectorSizeBytes := int(stat.Blksize)
+	sectorCount := int64((uint64(minimumSizeBytes) + uint64(stat.Blksize) - 1) / uint64(stat.Blksize))
+	sizeBytes := int64(sectorSizeBytes) * sectorCount
+	if stat.Size < sizeBytes {
+		unix.Close(fd)
+		return nil, 0, 0, status.Errorf(codes.FailedPrecondition, "File %#v has size %d, while at least %d bytes were expected", path, stat.Size, sizeBytes)
+	}
+
+	bd, err := newMemoryMappedBlockDevice(fd, int(sizeBytes))
+	if err != nil {
+		unix.Close(fd)
+		return nil, 0, 0, err
+	}
+	return bd, sectorSizeBytes, sectorCount, nil
+}
@@ -2,6 +2,7 @@ package builder
 
 import (
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/audit"
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
 	"github.com/buildbarn/bb-storage/pkg/digest"
@@ -9,23 +10,26 @@ import (
 )
 
 // NewAuthorizingBuildQueue creates a BuildQueue which authorizes
-// Execute requests.
+// Execute requests. Both permitted and denied Execute requests are
+// recorded in the audit log.
 // Note that WaitExecution requests are not authorized,
 // as their instance name is not known.
-func NewAuthorizingBuildQueue(backend BuildQueue, authorizer auth.Authorizer) BuildQueue {
+func NewAuthorizingBuildQueue(backend BuildQueue, authorizer auth.Authorizer, auditLogger audit.Logger) BuildQueue {
 	return &authorizingBuildQueue{
 		Provider: capabilities.NewAuthorizingProvider(backend, authorizer),
 
-		backend:    backend,
-		authorizer: authorizer,
+		backend:     backend,
+		authorizer:  authorizer,
+		auditLogger: auditLogger,
 	}
 }
 
 type authorizingBuildQueue struct {
 	capabilities.Provider
 
-	backend    BuildQueue
-	authorizer auth.Authorizer
+	backend     BuildQueue
+	authorizer  auth.Authorizer
+	auditLogger audit.Logger
 }
 
 func (bq *authorizingBuildQueue) Execute(request *remoteexecution.ExecuteRequest, server remoteexecution.Execution_ExecuteServer) error {
@@ -33,7 +37,22 @@ func (bq *authorizingBuildQueue) Execute(request *remoteexecution.ExecuteRequest
 	if err != nil {
 		return util.StatusWrapf(err, "Invalid instance name %#v", request.InstanceName)
 	}
-	if err := auth.AuthorizeSingleInstanceName(server.Context(), bq.authorizer, instanceName); err != nil {
+	ctx := server.Context()
+	err = auth.AuthorizeSingleInstanceName(ctx, bq.authorizer, instanceName)
+	event := audit.Event{
+		Operation:    "Execute",
+		InstanceName: instanceName,
+		Digest:       digest.BadDigest,
+		Error:        err,
+	}
+	// Attach the action digest to the audit log record, if valid.
+	if digestFunction, digestErr := instanceName.GetDigestFunction(request.DigestFunction, len(request.ActionDigest.GetHash())); digestErr == nil {
+		if actionDigest, digestErr := digestFunction.NewDigestFromProto(request.ActionDigest); digestErr == nil {
+			event.Digest = actionDigest
+		}
+	}
+	bq.auditLogger.Log(ctx, &event)
+	if err != nil {
 		return util.StatusWrapf(err, "Failed to authorize to Execute() against instance name %#v", instanceName.String())
 	}
 	return bq.backend.Execute(request, server)
@@ -6,6 +6,7 @@ import (
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/audit"
 	"github.com/buildbarn/bb-storage/pkg/builder"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
@@ -23,7 +24,8 @@ func TestAuthorizingBuildQueueGetExecutionCapabilities(t *testing.T) {
 	ctrl, ctx := gomock.WithContext(context.Background(), t)
 	buildQueue := mock.NewMockBuildQueue(ctrl)
 	authorizer := mock.NewMockAuthorizer(ctrl)
-	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer)
+	auditLogger := mock.NewMockLogger(ctrl)
+	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer, auditLogger)
 
 	instanceName := digest.MustNewInstanceName("hello/world")
 
@@ -79,7 +81,8 @@ func TestAuthorizingBuildQueueExecute(t *testing.T) {
 	executeServer := mock.NewMockExecution_ExecuteServer(ctrl)
 	executeServer.EXPECT().Context().Return(ctx).AnyTimes()
 	authorizer := mock.NewMockAuthorizer(ctrl)
-	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer)
+	auditLogger := mock.NewMockLogger(ctrl)
+	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer, auditLogger)
 
 	t.Run("InvalidInstanceName", func(t *testing.T) {
 		err := authorizingBuildQueue.Execute(&remoteexecution.ExecuteRequest{
@@ -94,6 +97,12 @@ func TestAuthorizingBuildQueueExecute(t *testing.T) {
 
 	t.Run("Denied", func(t *testing.T) {
 		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).Return([]error{status.Error(codes.PermissionDenied, "Permission denied")})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Execute",
+			InstanceName: digest.MustNewInstanceName("hello/world"),
+			Digest:       digest.MustNewDigest("hello/world", remoteexecution.DigestFunction_SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 0),
+			Error:        status.Error(codes.PermissionDenied, "Permission denied"),
+		})
 		err := authorizingBuildQueue.Execute(&remoteexecution.ExecuteRequest{
 			InstanceName: "hello/world",
 			ActionDigest: &remoteexecution.Digest{
@@ -106,6 +115,11 @@ func TestAuthorizingBuildQueueExecute(t *testing.T) {
 
 	t.Run("Allowed", func(t *testing.T) {
 		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{digest.MustNewInstanceName("hello/world")}).Return([]error{nil})
+		auditLogger.EXPECT().Log(ctx, &audit.Event{
+			Operation:    "Execute",
+			InstanceName: digest.MustNewInstanceName("hello/world"),
+			Digest:       digest.MustNewDigest("hello/world", remoteexecution.DigestFunction_SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 0),
+		})
 		buildQueue.EXPECT().Execute(&remoteexecution.ExecuteRequest{
 			InstanceName: "hello/world",
 			ActionDigest: &remoteexecution.Digest{
@@ -142,7 +156,8 @@ func TestAuthorizingBuildQueueWaitExecution(t *testing.T) {
 	executeServer := mock.NewMockExecution_WaitExecutionServer(ctrl)
 	executeServer.EXPECT().Context().Return(ctx).AnyTimes()
 	authorizer := mock.NewMockAuthorizer(ctrl)
-	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer)
+	auditLogger := mock.NewMockLogger(ctrl)
+	authorizingBuildQueue := builder.NewAuthorizingBuildQueue(buildQueue, authorizer, auditLogger)
 
 	t.Run("Success", func(t *testing.T) {
 		buildQueue.EXPECT().WaitExecution(&remoteexecution.WaitExecutionRequest{
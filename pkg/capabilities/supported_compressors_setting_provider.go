@@ -0,0 +1,44 @@
+package capabilities
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type supportedCompressorsSettingProvider struct {
+	base                 Provider
+	supportedCompressors []remoteexecution.Compressor_Value
+}
+
+// NewSupportedCompressorsSettingProvider creates a decorator for a
+// capabilities provider that sets the
+// CacheCapabilities.supported_compressors field. Compression is
+// performed by the gRPC servers that are exposed to clients, as opposed
+// to the storage backends. This decorator can therefore be used to
+// announce the compression algorithms supported by those servers,
+// regardless of what kind of backend is used.
+func NewSupportedCompressorsSettingProvider(base Provider, supportedCompressors []remoteexecution.Compressor_Value) Provider {
+	return &supportedCompressorsSettingProvider{
+		base:                 base,
+		supportedCompressors: supportedCompressors,
+	}
+}
+
+func (p *supportedCompressorsSettingProvider) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	serverCapabilities, err := p.base.GetCapabilities(ctx, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	if serverCapabilities.CacheCapabilities == nil {
+		return serverCapabilities, nil
+	}
+
+	var copiedCapabilities remoteexecution.ServerCapabilities
+	proto.Merge(&copiedCapabilities, serverCapabilities)
+	copiedCapabilities.CacheCapabilities.SupportedCompressors = p.supportedCompressors
+	return &copiedCapabilities, nil
+}
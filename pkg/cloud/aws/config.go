@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	bb_http "github.com/buildbarn/bb-storage/pkg/http"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	aws_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/cloud/aws"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
@@ -22,7 +23,7 @@ import (
 // resulting session object can be used to access AWS services such as
 // EC2, S3 and SQS.
 func NewConfigFromConfiguration(configuration *aws_pb.SessionConfiguration, name string) (aws.Config, error) {
-	roundTripper, err := bb_http.NewRoundTripperFromConfiguration(configuration.GetHttpClient())
+	roundTripper, err := http_client.NewRoundTripperFromConfiguration(configuration.GetHttpClient())
 	if err != nil {
 		return aws.Config{}, util.StatusWrap(err, "Failed to create HTTP client")
 	}
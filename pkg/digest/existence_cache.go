@@ -82,3 +82,18 @@ func (ec *ExistenceCache) Add(digests Set) {
 	}
 	ec.lock.Unlock()
 }
+
+// Remove digests from the cache, causing them to no longer be removed
+// by RemoveExisting() until they are added again.
+func (ec *ExistenceCache) Remove(digests Set) {
+	ec.lock.Lock()
+	for _, d := range digests.Items() {
+		// Entries cannot be removed from the eviction set
+		// directly. Mark the entry as expired instead.
+		key := d.GetKey(ec.keyFormat)
+		if _, ok := ec.insertionTimes[key]; ok {
+			ec.insertionTimes[key] = time.Time{}
+		}
+	}
+	ec.lock.Unlock()
+}
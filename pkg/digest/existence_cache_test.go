@@ -111,4 +111,23 @@ func TestExistenceCache(t *testing.T) {
 		t,
 		allDigests,
 		existenceCache.RemoveExisting(allDigests))
+
+	// Removing digests should cause them to be reported as
+	// missing, even if they were added recently.
+	clock.EXPECT().Now().Return(time.Unix(1067, 0))
+	existenceCache.Add(digests[2].ToSingletonSet())
+	clock.EXPECT().Now().Return(time.Unix(1068, 0))
+	require.Equal(
+		t,
+		digest.NewSetBuilder().
+			Add(digests[0]).
+			Add(digests[1]).
+			Build(),
+		existenceCache.RemoveExisting(allDigests))
+	existenceCache.Remove(digests[2].ToSingletonSet())
+	clock.EXPECT().Now().Return(time.Unix(1069, 0))
+	require.Equal(
+		t,
+		allDigests,
+		existenceCache.RemoveExisting(allDigests))
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	bb_http "github.com/buildbarn/bb-storage/pkg/http"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	bb_otel "github.com/buildbarn/bb-storage/pkg/otel"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	bb_prometheus "github.com/buildbarn/bb-storage/pkg/prometheus"
@@ -114,7 +115,7 @@ func ApplyConfiguration(configuration *pb.Configuration) (*LifecycleState, bb_gr
 	// gRPC resolvers for connecting to Kubernetes service endpoints
 	// without using cluster internal DNS.
 	for schema, resolverConfiguration := range configuration.GetGrpcKubernetesResolvers() {
-		roundTripper, err := bb_http.NewRoundTripperFromConfiguration(resolverConfiguration.ApiServerHttpClient)
+		roundTripper, err := http_client.NewRoundTripperFromConfiguration(resolverConfiguration.ApiServerHttpClient)
 		if err != nil {
 			return nil, nil, util.StatusWrapf(err, "Failed to create HTTP client for gRPC Kubernetes resolver for schema %#v", schema)
 		}
@@ -179,7 +180,7 @@ func ApplyConfiguration(configuration *pb.Configuration) (*LifecycleState, bb_gr
 					if endpoint := jaegerConfiguration.Endpoint; endpoint != "" {
 						collectorEndpointOptions = append(collectorEndpointOptions, jaeger.WithEndpoint(endpoint))
 					}
-					roundTripper, err := bb_http.NewRoundTripperFromConfiguration(jaegerConfiguration.HttpClient)
+					roundTripper, err := http_client.NewRoundTripperFromConfiguration(jaegerConfiguration.HttpClient)
 					if err != nil {
 						return nil, nil, util.StatusWrap(err, "Failed to create Jaeger collector HTTP client")
 					}
@@ -296,7 +297,7 @@ func ApplyConfiguration(configuration *pb.Configuration) (*LifecycleState, bb_gr
 			// as Prometheus Node Exporter.
 			allGatherers := make(prometheus.Gatherers, 0, len(pushgateway.AdditionalScrapeTargets)+1)
 			for _, scrapeTarget := range pushgateway.AdditionalScrapeTargets {
-				roundTripper, err := bb_http.NewRoundTripperFromConfiguration(scrapeTarget.HttpClient)
+				roundTripper, err := http_client.NewRoundTripperFromConfiguration(scrapeTarget.HttpClient)
 				if err != nil {
 					return nil, nil, util.StatusWrapf(err, "Failed to create HTTP client for additional scrape target %#v", scrapeTarget.Url)
 				}
@@ -320,7 +321,7 @@ func ApplyConfiguration(configuration *pb.Configuration) (*LifecycleState, bb_gr
 		for key, value := range pushgateway.Grouping {
 			pusher.Grouping(key, value)
 		}
-		roundTripper, err := bb_http.NewRoundTripperFromConfiguration(pushgateway.HttpClient)
+		roundTripper, err := http_client.NewRoundTripperFromConfiguration(pushgateway.HttpClient)
 		if err != nil {
 			return nil, nil, util.StatusWrap(err, "Failed to create Prometheus Pushgateway HTTP client")
 		}
@@ -3,10 +3,13 @@ package grpc
 import (
 	"context"
 	"crypto/x509"
+	"log"
+	"time"
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/jwt"
+	"github.com/buildbarn/bb-storage/pkg/oauth2"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
 	"github.com/buildbarn/bb-storage/pkg/util"
@@ -97,6 +100,57 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 			return nil, false, false, util.StatusWrap(err, "Failed to compile peer credentials metadata extraction JMESPath expression")
 		}
 		return NewPeerCredentialsAuthenticator(metadataExtractor), true, false, nil
+	case *configuration.AuthenticationPolicy_TokenIntrospection:
+		tokenIntrospector, err := oauth2.NewTokenIntrospectorFromConfiguration(policyKind.TokenIntrospection)
+		if err != nil {
+			return nil, false, false, util.StatusWrap(err, "Failed to create token introspector for token introspection authentication policy")
+		}
+		return NewTokenIntrospectionAuthenticator(tokenIntrospector), false, false, nil
+	case *configuration.AuthenticationPolicy_Spiffe:
+		if err := policyKind.Spiffe.TrustBundleRefreshInterval.CheckValid(); err != nil {
+			return nil, false, false, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid SPIFFE trust bundle refresh interval")
+		}
+		refreshInterval := policyKind.Spiffe.TrustBundleRefreshInterval.AsDuration()
+		if refreshInterval <= 0 {
+			return nil, false, false, status.Error(codes.InvalidArgument, "SPIFFE trust bundle refresh interval must be positive")
+		}
+		trustBundlePath := policyKind.Spiffe.TrustBundlePath
+		trustBundle := util.NewRotatingCertificatePool(trustBundlePath)
+		if err := trustBundle.LoadCertificatePool(); err != nil {
+			return nil, false, false, util.StatusWrapf(err, "Failed to load SPIFFE trust bundle from file at %#v", trustBundlePath)
+		}
+		validator, err := jmespath.Compile(policyKind.Spiffe.ValidationJmespathExpression)
+		if err != nil {
+			return nil, false, false, util.StatusWrap(err, "Failed to compile SPIFFE validation JMESPath expression")
+		}
+		metadataExtractor, err := jmespath.Compile(policyKind.Spiffe.MetadataExtractionJmespathExpression)
+		if err != nil {
+			return nil, false, false, util.StatusWrap(err, "Failed to compile SPIFFE metadata extraction JMESPath expression")
+		}
+
+		group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			t := time.NewTicker(refreshInterval)
+			defer t.Stop()
+
+			for {
+				select {
+				case <-t.C:
+					if err := trustBundle.LoadCertificatePool(); err != nil {
+						log.Printf("Failed to reload SPIFFE trust bundle from file at %#v: %s", trustBundlePath, err)
+					}
+				case <-ctx.Done():
+					return util.StatusFromContext(ctx)
+				}
+			}
+		})
+
+		return NewSPIFFEAuthenticator(
+			trustBundle.GetCertificatePool,
+			policyKind.Spiffe.TrustDomain,
+			clock.SystemClock,
+			validator,
+			metadataExtractor,
+		), false, true, nil
 	default:
 		return nil, false, false, status.Error(codes.InvalidArgument, "Configuration did not contain an authentication policy type")
 	}
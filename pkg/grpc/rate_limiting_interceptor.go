@@ -0,0 +1,314 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/jmespath/go-jmespath"
+
+	"google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimit contains the parameters of a token bucket that is used to
+// limit the rate at which clients may perform operations.
+type RateLimit struct {
+	TokensPerSecond float64
+	BurstTokens     float64
+}
+
+func newRateLimitFromConfiguration(config *configuration.RateLimitingPolicy_TokenBucket) (*RateLimit, error) {
+	if config == nil {
+		return nil, nil
+	}
+	if !(config.TokensPerSecond > 0) {
+		return nil, status.Error(codes.InvalidArgument, "Tokens per second must be positive")
+	}
+	if !(config.BurstTokens >= 1) {
+		return nil, status.Error(codes.InvalidArgument, "Burst tokens must be at least 1")
+	}
+	return &RateLimit{
+		TokensPerSecond: config.TokensPerSecond,
+		BurstTokens:     config.BurstTokens,
+	}, nil
+}
+
+// TokenBucketKey is the key under which token buckets are stored by
+// RateLimiter. It is exported, so that callers can construct eviction
+// sets for it. Every key obtained from the authentication metadata has
+// a separate token bucket for every method, and one for the number of
+// bytes transferred through ByteStream.
+type TokenBucketKey struct {
+	key        string
+	fullMethod string
+	bytes      bool
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastUpdate time.Time
+}
+
+// RateLimiter keeps track of the rate at which clients call into a gRPC
+// server, and rejects requests once configured limits are exceeded.
+// Clients are distinguished by evaluating a JMESPath expression against
+// the authentication metadata of a request.
+type RateLimiter struct {
+	clock               clock.Clock
+	keyExtractor        *jmespath.JMESPath
+	methodRequestLimits map[string]RateLimit
+	defaultRequestLimit *RateLimit
+	byteStreamByteLimit *RateLimit
+	maximumTokenBuckets int
+
+	lock         sync.Mutex
+	tokenBuckets map[TokenBucketKey]*tokenBucket
+	evictionSet  eviction.Set[TokenBucketKey]
+}
+
+// NewRateLimiter creates a new RateLimiter that does not have any
+// token buckets. Token buckets are created on demand, and start off
+// being full.
+func NewRateLimiter(clock clock.Clock, keyExtractor *jmespath.JMESPath, methodRequestLimits map[string]RateLimit, defaultRequestLimit, byteStreamByteLimit *RateLimit, maximumTokenBuckets int, evictionSet eviction.Set[TokenBucketKey]) *RateLimiter {
+	return &RateLimiter{
+		clock:               clock,
+		keyExtractor:        keyExtractor,
+		methodRequestLimits: methodRequestLimits,
+		defaultRequestLimit: defaultRequestLimit,
+		byteStreamByteLimit: byteStreamByteLimit,
+		maximumTokenBuckets: maximumTokenBuckets,
+
+		tokenBuckets: map[TokenBucketKey]*tokenBucket{},
+		evictionSet:  evictionSet,
+	}
+}
+
+// NewRateLimiterFromConfiguration creates a new RateLimiter based on
+// options stored in a configuration file.
+func NewRateLimiterFromConfiguration(config *configuration.RateLimitingPolicy) (*RateLimiter, error) {
+	keyExtractor, err := jmespath.Compile(config.KeyJmespathExpression)
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to compile key JMESPath expression")
+	}
+
+	methodRequestLimits := make(map[string]RateLimit, len(config.MethodRequestLimits))
+	for fullMethod, limitConfiguration := range config.MethodRequestLimits {
+		limit, err := newRateLimitFromConfiguration(limitConfiguration)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid request limit for method %#v", fullMethod)
+		}
+		if limit != nil {
+			methodRequestLimits[fullMethod] = *limit
+		}
+	}
+	defaultRequestLimit, err := newRateLimitFromConfiguration(config.DefaultRequestLimit)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid default request limit")
+	}
+	byteStreamByteLimit, err := newRateLimitFromConfiguration(config.BytestreamByteLimit)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Invalid ByteStream byte limit")
+	}
+
+	if config.MaximumTokenBuckets <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum number of token buckets must be positive")
+	}
+	evictionSet, err := eviction.NewSetFromConfiguration[TokenBucketKey](config.TokenBucketReplacementPolicy)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create eviction set")
+	}
+
+	return NewRateLimiter(
+		clock.SystemClock,
+		keyExtractor,
+		methodRequestLimits,
+		defaultRequestLimit,
+		byteStreamByteLimit,
+		int(config.MaximumTokenBuckets),
+		eviction.NewMetricsSet(evictionSet, "RateLimiter")), nil
+}
+
+// getKey computes the key of the client that issued a request, based
+// on the authentication metadata stored in the context.
+func (rl *RateLimiter) getKey(ctx context.Context) (string, error) {
+	result, err := rl.keyExtractor.Search(auth.AuthenticationMetadataFromContext(ctx).GetRaw())
+	if err != nil {
+		return "", util.StatusWrapWithCode(err, codes.Internal, "Failed to evaluate rate limiting key JMESPath expression")
+	}
+	if key, ok := result.(string); ok {
+		return key, nil
+	}
+	key, err := json.Marshal(result)
+	if err != nil {
+		return "", util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal rate limiting key")
+	}
+	return string(key), nil
+}
+
+// getTokenBucket returns the token bucket associated with a key,
+// creating it if needed. The token bucket is refilled based on the
+// amount of time that has passed since it was last updated. The caller
+// must hold the lock.
+func (rl *RateLimiter) getTokenBucket(key TokenBucketKey, limit *RateLimit) *tokenBucket {
+	now := rl.clock.Now()
+	tb, ok := rl.tokenBuckets[key]
+	if ok {
+		rl.evictionSet.Touch(key)
+		tb.tokens = math.Min(
+			limit.BurstTokens,
+			tb.tokens+now.Sub(tb.lastUpdate).Seconds()*limit.TokensPerSecond)
+	} else {
+		for len(rl.tokenBuckets) >= rl.maximumTokenBuckets {
+			delete(rl.tokenBuckets, rl.evictionSet.Peek())
+			rl.evictionSet.Remove()
+		}
+		rl.evictionSet.Insert(key)
+		tb = &tokenBucket{tokens: limit.BurstTokens}
+		rl.tokenBuckets[key] = tb
+	}
+	tb.lastUpdate = now
+	return tb
+}
+
+// takeRequest takes a single token from the token bucket associated
+// with a method. If the token bucket is empty, an error is returned
+// that indicates when the request may be retried.
+func (rl *RateLimiter) takeRequest(key, fullMethod string) error {
+	limit := rl.defaultRequestLimit
+	if methodLimit, ok := rl.methodRequestLimits[fullMethod]; ok {
+		limit = &methodLimit
+	}
+	if limit == nil {
+		return nil
+	}
+
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	tb := rl.getTokenBucket(TokenBucketKey{key: key, fullMethod: fullMethod}, limit)
+	if tb.tokens < 1 {
+		retryDelay := time.Duration(math.Ceil((1 - tb.tokens) / limit.TokensPerSecond * float64(time.Second)))
+		s := status.New(codes.ResourceExhausted, "Request rate limit exceeded for method "+fullMethod)
+		if sWithDetails, err := s.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryDelay),
+		}); err == nil {
+			s = sWithDetails
+		}
+		return s.Err()
+	}
+	tb.tokens--
+	return nil
+}
+
+// takeBytes takes tokens from the token bucket that is associated with
+// the number of bytes transferred through ByteStream. Instead of
+// failing, it blocks until the token bucket has been refilled, thereby
+// applying backpressure to transfers that are in progress.
+//
+// Tokens are taken from the bucket immediately, causing it to go into
+// debt if needed. Successive calls wait until the debt of their
+// predecessors has been paid off.
+func (rl *RateLimiter) takeBytes(ctx context.Context, key string, bytes int) error {
+	limit := rl.byteStreamByteLimit
+	rl.lock.Lock()
+	tb := rl.getTokenBucket(TokenBucketKey{key: key, bytes: true}, limit)
+	tb.tokens -= float64(bytes)
+	debt := -tb.tokens
+	rl.lock.Unlock()
+
+	if debt <= 0 {
+		return nil
+	}
+	timer, t := rl.clock.NewTimer(time.Duration(math.Ceil(debt / limit.TokensPerSecond * float64(time.Second))))
+	select {
+	case <-t:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return util.StatusFromContext(ctx)
+	}
+}
+
+// NewRateLimitingUnaryInterceptor creates a gRPC request interceptor
+// for unary calls that rejects requests once they exceed the limits
+// enforced by a RateLimiter. This interceptor must be placed after the
+// authenticating interceptor, as it depends on authentication metadata
+// being present.
+func NewRateLimitingUnaryInterceptor(rl *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key, err := rl.getKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := rl.takeRequest(key, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewRateLimitingStreamInterceptor creates a gRPC request interceptor
+// for streaming calls that rejects requests once they exceed the
+// limits enforced by a RateLimiter. In addition to limiting the number
+// of requests, it limits the rate at which bytes are transferred
+// through the ByteStream service by delaying the transfer of data.
+func NewRateLimitingStreamInterceptor(rl *RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		key, err := rl.getKey(ss.Context())
+		if err != nil {
+			return err
+		}
+		if err := rl.takeRequest(key, info.FullMethod); err != nil {
+			return err
+		}
+		if rl.byteStreamByteLimit != nil {
+			ss = &rateLimitingServerStream{
+				ServerStream: ss,
+				rateLimiter:  rl,
+				key:          key,
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// rateLimitingServerStream is a decorator for grpc.ServerStream that
+// takes tokens for every byte of data transferred as part of
+// ByteStream Read() and Write() operations, blocking if the rate limit
+// is exceeded.
+type rateLimitingServerStream struct {
+	grpc.ServerStream
+	rateLimiter *RateLimiter
+	key         string
+}
+
+func (s *rateLimitingServerStream) SendMsg(m interface{}) error {
+	if response, ok := m.(*bytestream.ReadResponse); ok {
+		if err := s.rateLimiter.takeBytes(s.Context(), s.key, len(response.Data)); err != nil {
+			return err
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *rateLimitingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if request, ok := m.(*bytestream.WriteRequest); ok {
+		return s.rateLimiter.takeBytes(s.Context(), s.key, len(request.Data))
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/jmespath/go-jmespath"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newRateLimitingContext(ctx context.Context, user string) context.Context {
+	return auth.NewContextWithAuthenticationMetadata(
+		ctx,
+		auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+			Public: structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"user": structpb.NewStringValue(user),
+				},
+			}),
+		}))
+}
+
+func requireResourceExhausted(t *testing.T, message string, retryDelay time.Duration, err error) {
+	s, detailsErr := status.New(codes.ResourceExhausted, message).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	require.NoError(t, detailsErr)
+	testutil.RequireEqualStatus(t, s.Err(), err)
+}
+
+func TestRateLimitingUnaryInterceptor(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	rateLimiter := bb_grpc.NewRateLimiter(
+		clock,
+		jmespath.MustCompile("public.user"),
+		map[string]bb_grpc.RateLimit{
+			"/build.bazel.remote.execution.v2.ContentAddressableStorage/FindMissingBlobs": {
+				TokensPerSecond: 2,
+				BurstTokens:     2,
+			},
+		},
+		/* defaultRequestLimit = */ nil,
+		/* byteStreamByteLimit = */ nil,
+		/* maximumTokenBuckets = */ 2,
+		eviction.NewLRUSet[bb_grpc.TokenBucketKey]())
+	interceptor := bb_grpc.NewRateLimitingUnaryInterceptor(rateLimiter)
+	handler := mock.NewMockUnaryHandler(ctrl)
+	req := &emptypb.Empty{}
+	resp := &emptypb.Empty{}
+	findMissingBlobsInfo := &grpc.UnaryServerInfo{
+		FullMethod: "/build.bazel.remote.execution.v2.ContentAddressableStorage/FindMissingBlobs",
+	}
+
+	aliceCtx := newRateLimitingContext(ctx, "alice")
+	bobCtx := newRateLimitingContext(ctx, "bob")
+	carolCtx := newRateLimitingContext(ctx, "carol")
+
+	t.Run("Unlimited", func(t *testing.T) {
+		// Methods for which no limit is configured should not
+		// be rate limited.
+		for i := 0; i < 10; i++ {
+			handler.EXPECT().Call(aliceCtx, req).Return(resp, nil)
+			gotResp, err := interceptor(aliceCtx, req, &grpc.UnaryServerInfo{
+				FullMethod: "/build.bazel.remote.execution.v2.Capabilities/GetCapabilities",
+			}, handler.Call)
+			require.NoError(t, err)
+			require.Equal(t, resp, gotResp)
+		}
+	})
+
+	t.Run("Burst", func(t *testing.T) {
+		// The token bucket starts off being full, meaning that
+		// two requests may be performed right away.
+		for i := 0; i < 2; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000, 0))
+			handler.EXPECT().Call(aliceCtx, req).Return(resp, nil)
+			_, err := interceptor(aliceCtx, req, findMissingBlobsInfo, handler.Call)
+			require.NoError(t, err)
+		}
+
+		// The third request should be rejected, with a
+		// suggestion to retry once the bucket has a token.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		_, err := interceptor(aliceCtx, req, findMissingBlobsInfo, handler.Call)
+		requireResourceExhausted(t, "Request rate limit exceeded for method /build.bazel.remote.execution.v2.ContentAddressableStorage/FindMissingBlobs", 500*time.Millisecond, err)
+
+		// Other users should have their own token bucket.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		handler.EXPECT().Call(bobCtx, req).Return(resp, nil)
+		_, err = interceptor(bobCtx, req, findMissingBlobsInfo, handler.Call)
+		require.NoError(t, err)
+	})
+
+	t.Run("Refill", func(t *testing.T) {
+		// After half a second, a single token should have been
+		// added to the bucket.
+		clock.EXPECT().Now().Return(time.Unix(1000, 500000000))
+		handler.EXPECT().Call(aliceCtx, req).Return(resp, nil)
+		_, err := interceptor(aliceCtx, req, findMissingBlobsInfo, handler.Call)
+		require.NoError(t, err)
+
+		clock.EXPECT().Now().Return(time.Unix(1000, 750000000))
+		_, err = interceptor(aliceCtx, req, findMissingBlobsInfo, handler.Call)
+		requireResourceExhausted(t, "Request rate limit exceeded for method /build.bazel.remote.execution.v2.ContentAddressableStorage/FindMissingBlobs", 250*time.Millisecond, err)
+	})
+
+	t.Run("Eviction", func(t *testing.T) {
+		// Only two token buckets may be tracked. Creating a
+		// token bucket for a third user should cause the least
+		// recently used one to be discarded, causing the next
+		// request for that user to get a full bucket.
+		clock.EXPECT().Now().Return(time.Unix(1000, 750000000))
+		handler.EXPECT().Call(bobCtx, req).Return(resp, nil)
+		_, err := interceptor(bobCtx, req, findMissingBlobsInfo, handler.Call)
+		require.NoError(t, err)
+
+		clock.EXPECT().Now().Return(time.Unix(1000, 750000000))
+		handler.EXPECT().Call(carolCtx, req).Return(resp, nil)
+		_, err = interceptor(carolCtx, req, findMissingBlobsInfo, handler.Call)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000, 750000000))
+			handler.EXPECT().Call(aliceCtx, req).Return(resp, nil)
+			_, err := interceptor(aliceCtx, req, findMissingBlobsInfo, handler.Call)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestRateLimitingStreamInterceptor(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	rateLimiter := bb_grpc.NewRateLimiter(
+		clock,
+		jmespath.MustCompile("public"),
+		/* methodRequestLimits = */ nil,
+		/* defaultRequestLimit = */ &bb_grpc.RateLimit{
+			TokensPerSecond: 10,
+			BurstTokens:     10,
+		},
+		/* byteStreamByteLimit = */ &bb_grpc.RateLimit{
+			TokensPerSecond: 1000,
+			BurstTokens:     1000,
+		},
+		/* maximumTokenBuckets = */ 10,
+		eviction.NewLRUSet[bb_grpc.TokenBucketKey]())
+	interceptor := bb_grpc.NewRateLimitingStreamInterceptor(rateLimiter)
+	streamCtx := newRateLimitingContext(ctx, "alice")
+
+	t.Run("ByteStreamRead", func(t *testing.T) {
+		readCtx, cancel := context.WithCancel(streamCtx)
+		defer cancel()
+		serverStream := mock.NewMockServerStream(ctrl)
+		serverStream.EXPECT().Context().Return(readCtx).AnyTimes()
+		handler := mock.NewMockStreamHandler(ctrl)
+
+		// Chunks that exceed the burst size should cause the
+		// bucket to go into debt. Instead of failing, the
+		// transfer should be delayed until the debt has been
+		// paid off.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		timer1 := mock.NewMockTimer(ctrl)
+		timerChannel1 := make(chan time.Time, 1)
+		timerChannel1 <- time.Unix(1000, 500000000)
+		clock.EXPECT().NewTimer(500*time.Millisecond).Return(timer1, timerChannel1)
+		serverStream.EXPECT().SendMsg(&bytestream.ReadResponse{Data: make([]byte, 1500)})
+
+		// Cancelation of the stream should interrupt waiting.
+		clock.EXPECT().Now().Return(time.Unix(1000, 500000000))
+		timer2 := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(time.Second).DoAndReturn(func(d time.Duration) (*mock.MockTimer, <-chan time.Time) {
+			cancel()
+			return timer2, make(chan time.Time)
+		})
+		timer2.EXPECT().Stop().Return(true)
+		handler.EXPECT().Call(nil, gomock.Any()).DoAndReturn(func(srv interface{}, stream grpc.ServerStream) error {
+			if err := stream.SendMsg(&bytestream.ReadResponse{Data: make([]byte, 1500)}); err != nil {
+				return err
+			}
+			return stream.SendMsg(&bytestream.ReadResponse{Data: make([]byte, 1000)})
+		})
+
+		err := interceptor(nil, serverStream, &grpc.StreamServerInfo{
+			FullMethod: "/google.bytestream.ByteStream/Read",
+		}, handler.Call)
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), err)
+	})
+
+	t.Run("ByteStreamWrite", func(t *testing.T) {
+		serverStream := mock.NewMockServerStream(ctrl)
+		serverStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+		handler := mock.NewMockStreamHandler(ctrl)
+
+		// After ten seconds, the bucket should be full again.
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		serverStream.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+			m.(*bytestream.WriteRequest).Data = make([]byte, 800)
+			return nil
+		})
+		handler.EXPECT().Call(nil, gomock.Any()).DoAndReturn(func(srv interface{}, stream grpc.ServerStream) error {
+			var request bytestream.WriteRequest
+			return stream.RecvMsg(&request)
+		})
+
+		require.NoError(t, interceptor(nil, serverStream, &grpc.StreamServerInfo{
+			FullMethod: "/google.bytestream.ByteStream/Write",
+		}, handler.Call))
+	})
+}
@@ -63,6 +63,18 @@ func NewServersFromConfigurationAndServe(configurations []*configuration.ServerC
 		unaryInterceptors = append(unaryInterceptors, NewAuthenticatingUnaryInterceptor(authenticator))
 		streamInterceptors = append(streamInterceptors, NewAuthenticatingStreamInterceptor(authenticator))
 
+		// Enable rate limiting. This needs to be performed after
+		// authentication, as limits may be keyed by
+		// authentication metadata.
+		if policy := configuration.RateLimitingPolicy; policy != nil {
+			rateLimiter, err := NewRateLimiterFromConfiguration(policy)
+			if err != nil {
+				return util.StatusWrap(err, "Failed to create rate limiter")
+			}
+			unaryInterceptors = append(unaryInterceptors, NewRateLimitingUnaryInterceptor(rateLimiter))
+			streamInterceptors = append(streamInterceptors, NewRateLimitingStreamInterceptor(rateLimiter))
+		}
+
 		serverOptions := []grpc.ServerOption{
 			grpc.ChainUnaryInterceptor(unaryInterceptors...),
 			grpc.ChainStreamInterceptor(streamInterceptors...),
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/jmespath/go-jmespath"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type spiffeAuthenticator struct {
+	getTrustBundle    func() *x509.CertPool
+	trustDomain       string
+	clock             clock.Clock
+	validator         *jmespath.JMESPath
+	metadataExtractor *jmespath.JMESPath
+}
+
+// NewSPIFFEAuthenticator creates an Authenticator that only grants
+// access in case the client connected to the gRPC server using a TLS
+// client certificate that is a valid SPIFFE X.509-SVID. The SPIFFE ID
+// contained in the X.509-SVID can be converted to authentication
+// metadata.
+func NewSPIFFEAuthenticator(getTrustBundle func() *x509.CertPool, trustDomain string, clock clock.Clock, validator, metadataExtractor *jmespath.JMESPath) Authenticator {
+	return &spiffeAuthenticator{
+		getTrustBundle:    getTrustBundle,
+		trustDomain:       trustDomain,
+		clock:             clock,
+		validator:         validator,
+		metadataExtractor: metadataExtractor,
+	}
+}
+
+func (a *spiffeAuthenticator) Authenticate(ctx context.Context) (*auth.AuthenticationMetadata, error) {
+	// Extract client certificate chain from the connection.
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Connection was not established using gRPC")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Connection was not established using TLS")
+	}
+	certs := tlsInfo.State.PeerCertificates
+	if len(certs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Client provided no TLS client certificate")
+	}
+
+	spiffeID, err := util.VerifyX509SVID(certs, a.getTrustBundle(), a.trustDomain, a.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	searchContext := map[string]any{
+		"spiffeId":    spiffeID.String(),
+		"trustDomain": spiffeID.Host,
+		"path":        spiffeID.Path,
+	}
+
+	// Validate the SPIFFE ID matches our expectations.
+	validationResult, err := a.validator.Search(searchContext)
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Unauthenticated, "Cannot validate SPIFFE ID")
+	}
+	if validationResult != true {
+		return nil, status.Errorf(codes.Unauthenticated, "Rejected SPIFFE ID %#v", spiffeID.String())
+	}
+
+	// Extract metadata from the SPIFFE ID.
+	metadataRaw, err := a.metadataExtractor.Search(searchContext)
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Unauthenticated, "Cannot extract metadata from SPIFFE ID")
+	}
+	return auth.NewAuthenticationMetadataFromRaw(metadataRaw)
+}
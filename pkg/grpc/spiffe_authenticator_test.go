@@ -0,0 +1,161 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/jmespath/go-jmespath"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newSPIFFECertificate creates a certificate for testing. The
+// certificate is signed by the parent, or self-signed if no parent is
+// provided.
+func newSPIFFECertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey ed25519.PrivateKey) (*x509.Certificate, ed25519.PrivateKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(1)
+	template.NotBefore = time.Unix(1000, 0)
+	template.NotAfter = time.Unix(2000, 0)
+	if parent == nil {
+		parent, parentKey = template, privateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, privateKey
+}
+
+func newSPIFFEPeerContext(certs ...*x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{PeerCertificates: certs},
+		},
+	})
+}
+
+func TestSPIFFEAuthenticator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	ca, caKey := newSPIFFECertificate(t, &x509.Certificate{
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		URIs:                  []*url.URL{{Scheme: "spiffe", Host: "example.org"}},
+	}, nil, nil)
+	trustBundle := x509.NewCertPool()
+	trustBundle.AddCert(ca)
+
+	newSVID := func(spiffeIDs ...string) *x509.Certificate {
+		var uris []*url.URL
+		for _, spiffeID := range spiffeIDs {
+			uri, err := url.Parse(spiffeID)
+			require.NoError(t, err)
+			uris = append(uris, uri)
+		}
+		cert, _ := newSPIFFECertificate(t, &x509.Certificate{
+			KeyUsage: x509.KeyUsageDigitalSignature,
+			URIs:     uris,
+		}, ca, caKey)
+		return cert
+	}
+
+	clock := mock.NewMockClock(ctrl)
+	authenticator := bb_grpc.NewSPIFFEAuthenticator(
+		func() *x509.CertPool { return trustBundle },
+		"example.org",
+		clock,
+		jmespath.MustCompile("starts_with(path, '/ns/default/')"),
+		jmespath.MustCompile("{\"public\": {\"spiffeId\": spiffeId, \"trustDomain\": trustDomain}}"))
+
+	t.Run("NoCertificate", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "Client provided no TLS client certificate"), err)
+	})
+
+	t.Run("NoSPIFFEID", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID()))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "X.509-SVID contains 0 URI SANs, while exactly one was expected"), err)
+	})
+
+	t.Run("MultipleSPIFFEIDs", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("spiffe://example.org/ns/default/a", "spiffe://example.org/ns/default/b")))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "X.509-SVID contains 2 URI SANs, while exactly one was expected"), err)
+	})
+
+	t.Run("InvalidSPIFFEID", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("https://example.org/ns/default/a")))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "X.509-SVID contains invalid SPIFFE ID \"https://example.org/ns/default/a\""), err)
+	})
+
+	t.Run("WrongTrustDomain", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("spiffe://other.org/ns/default/a")))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "SPIFFE ID \"spiffe://other.org/ns/default/a\" does not belong to trust domain \"example.org\""), err)
+	})
+
+	t.Run("UntrustedSigner", func(t *testing.T) {
+		// X.509-SVIDs that are signed by an authority that is
+		// not part of the trust bundle should be rejected.
+		otherCA, otherCAKey := newSPIFFECertificate(t, &x509.Certificate{
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}, nil, nil)
+		uri, err := url.Parse("spiffe://example.org/ns/default/a")
+		require.NoError(t, err)
+		svid, _ := newSPIFFECertificate(t, &x509.Certificate{
+			KeyUsage: x509.KeyUsageDigitalSignature,
+			URIs:     []*url.URL{uri},
+		}, otherCA, otherCAKey)
+
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err = authenticator.Authenticate(newSPIFFEPeerContext(svid))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(2500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("spiffe://example.org/ns/default/a")))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("RejectedByValidator", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		_, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("spiffe://example.org/ns/other/a")))
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "Rejected SPIFFE ID \"spiffe://example.org/ns/other/a\""), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1500, 0))
+		metadata, err := authenticator.Authenticate(newSPIFFEPeerContext(newSVID("spiffe://example.org/ns/default/a")))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"public": map[string]any{
+				"spiffeId":    "spiffe://example.org/ns/default/a",
+				"trustDomain": "example.org",
+			},
+		}, metadata.GetRaw())
+	})
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/oauth2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type tokenIntrospectionAuthenticator struct {
+	tokenIntrospector *oauth2.TokenIntrospector
+}
+
+// NewTokenIntrospectionAuthenticator creates an authenticator for
+// incoming gRPC requests that validates requests that contain an
+// "Authorization" of shape "Bearer ${token}", where ${token} is an
+// OAuth2 access token that is reported to be active by a token
+// introspection endpoint.
+func NewTokenIntrospectionAuthenticator(tokenIntrospector *oauth2.TokenIntrospector) Authenticator {
+	return &tokenIntrospectionAuthenticator{
+		tokenIntrospector: tokenIntrospector,
+	}
+}
+
+func (a *tokenIntrospectionAuthenticator) Authenticate(ctx context.Context) (*auth.AuthenticationMetadata, error) {
+	grpcMetadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Not called from within an incoming gRPC context")
+	}
+	return a.tokenIntrospector.ParseAuthorizationHeaders(ctx, grpcMetadata.Get("authorization"))
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	"github.com/buildbarn/bb-storage/pkg/jwt"
+	bb_oauth2 "github.com/buildbarn/bb-storage/pkg/oauth2"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/http"
 	"github.com/buildbarn/bb-storage/pkg/random"
@@ -93,7 +95,7 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to compile OIDC metadata extraction JMESPath expression")
 		}
-		roundTripper, err := NewRoundTripperFromConfiguration(policyKind.Oidc.HttpClient)
+		roundTripper, err := http_client.NewRoundTripperFromConfiguration(policyKind.Oidc.HttpClient)
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to create OIDC HTTP client")
 		}
@@ -124,6 +126,12 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 			return nil, err
 		}
 		return NewAcceptHeaderAuthenticator(base, policyKind.AcceptHeader.MediaTypes), nil
+	case *configuration.AuthenticationPolicy_TokenIntrospection:
+		tokenIntrospector, err := bb_oauth2.NewTokenIntrospectorFromConfiguration(policyKind.TokenIntrospection)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Failed to create token introspector for token introspection authentication policy")
+		}
+		return NewTokenIntrospectionAuthenticator(tokenIntrospector), nil
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Configuration did not contain an authentication policy type")
 	}
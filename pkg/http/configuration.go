@@ -1,42 +1,24 @@
 package http
 
 import (
-	"net"
 	"net/http"
-	"net/url"
-	"time"
 
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/http"
-	"github.com/buildbarn/bb-storage/pkg/util"
 )
 
 // NewRoundTripperFromConfiguration makes a new HTTP RoundTripper on
 // parameters provided in a configuration file.
+//
+// Deprecated: Use client.NewRoundTripperFromConfiguration() instead.
 func NewRoundTripperFromConfiguration(configuration *pb.ClientConfiguration) (http.RoundTripper, error) {
-	tlsConfig, err := util.NewTLSConfigFromClientConfiguration(configuration.GetTls())
-	if err != nil {
-		return nil, err
-	}
-	defaultTransport := http.Transport{
-		DialContext: (&net.Dialer{
-			Timeout:   30 * time.Second,
-			KeepAlive: 30 * time.Second,
-		}).DialContext,
-		ForceAttemptHTTP2: !configuration.GetDisableHttp2(),
-		TLSClientConfig:   tlsConfig,
-	}
-	if proxyURL := configuration.GetProxyUrl(); proxyURL != "" {
-		parsedProxyURL, err := url.Parse(proxyURL)
-		if err != nil {
-			return nil, util.StatusWrap(err, "Failed to parse proxy URL")
-		}
-		defaultTransport.Proxy = http.ProxyURL(parsedProxyURL)
-	}
-	var roundTripper http.RoundTripper = &defaultTransport
-
-	if headerValues := configuration.GetAddHeaders(); len(headerValues) > 0 {
-		roundTripper = NewHeaderAddingRoundTripper(roundTripper, headerValues)
-	}
+	return http_client.NewRoundTripperFromConfiguration(configuration)
+}
 
-	return roundTripper, nil
+// NewHeaderAddingRoundTripper is a decorator for RoundTripper that adds
+// additional HTTP header values to all outgoing requests.
+//
+// Deprecated: Use client.NewHeaderAddingRoundTripper() instead.
+func NewHeaderAddingRoundTripper(base http.RoundTripper, headerValues []*pb.ClientConfiguration_HeaderValues) http.RoundTripper {
+	return http_client.NewHeaderAddingRoundTripper(base, headerValues)
 }
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/oauth2"
+)
+
+type tokenIntrospectionAuthenticator struct {
+	tokenIntrospector *oauth2.TokenIntrospector
+}
+
+// NewTokenIntrospectionAuthenticator creates an authenticator for
+// incoming HTTP requests that validates requests that contain an
+// "Authorization" of shape "Bearer ${token}", where ${token} is an
+// OAuth2 access token that is reported to be active by a token
+// introspection endpoint.
+func NewTokenIntrospectionAuthenticator(tokenIntrospector *oauth2.TokenIntrospector) Authenticator {
+	return &tokenIntrospectionAuthenticator{
+		tokenIntrospector: tokenIntrospector,
+	}
+}
+
+func (a *tokenIntrospectionAuthenticator) Authenticate(w http.ResponseWriter, r *http.Request) (*auth.AuthenticationMetadata, error) {
+	return a.tokenIntrospector.ParseAuthorizationHeaders(r.Context(), r.Header["Authorization"])
+}
@@ -7,12 +7,14 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"log"
+	"net/http"
 	"os"
 	"reflect"
 	"time"
 
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/eviction"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/jwt"
 	"github.com/buildbarn/bb-storage/pkg/util"
@@ -50,6 +52,12 @@ func NewAuthorizationHeaderParserFromConfiguration(config *configuration.Authori
 		if err != nil {
 			return nil, err
 		}
+	case *configuration.AuthorizationHeaderParserConfiguration_JwksRemote:
+		var err error
+		signatureValidator, err = NewSignatureValidatorFromRemoteJSONWebKeySet(key.JwksRemote, group)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, status.Error(codes.InvalidArgument, "No key type provided")
 	}
@@ -155,6 +163,72 @@ func NewSignatureValidatorFromJSONWebKeySetFile(path string, group program.Group
 	return forwardingValidator, nil
 }
 
+// NewSignatureValidatorFromRemoteJSONWebKeySet creates a new
+// SignatureValidator capable of validating JWTs matching keys contained
+// in a JSON Web Key Set that is fetched over HTTP. The JSON Web Key Set
+// is refreshed periodically, and when a JWT references an unknown key.
+func NewSignatureValidatorFromRemoteJSONWebKeySet(config *configuration.RemoteJwksConfiguration, group program.Group) (SignatureValidator, error) {
+	roundTripper, err := http_client.NewRoundTripperFromConfiguration(config.HttpClient)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create HTTP client")
+	}
+	fetchTimeout := 30 * time.Second
+	if config.FetchTimeout != nil {
+		if err := config.FetchTimeout.CheckValid(); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid fetch timeout")
+		}
+		fetchTimeout = config.FetchTimeout.AsDuration()
+		if fetchTimeout <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Fetch timeout must be positive")
+		}
+	}
+	client := &http.Client{
+		Transport: roundTripper,
+		Timeout:   fetchTimeout,
+	}
+
+	var fetcher JSONWebKeySetFetcher
+	switch source := config.Source.(type) {
+	case *configuration.RemoteJwksConfiguration_JwksUrl:
+		fetcher = NewHTTPJSONWebKeySetFetcher(client, source.JwksUrl)
+	case *configuration.RemoteJwksConfiguration_OidcIssuerUrl:
+		fetcher = NewOpenIDConnectDiscoveryJSONWebKeySetFetcher(client, source.OidcIssuerUrl)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "No JSON Web Key Set source provided")
+	}
+
+	if err := config.RefreshInterval.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid refresh interval")
+	}
+	refreshInterval := config.RefreshInterval.AsDuration()
+	if refreshInterval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Refresh interval must be positive")
+	}
+	// Don't permit refreshes triggered by unknown key IDs to take
+	// place without any delay, as that would allow unauthenticated
+	// clients to generate an unbounded amount of traffic against
+	// the identity provider.
+	minimumRefreshInterval := 10 * time.Second
+	if config.MinimumRefreshInterval != nil {
+		if err := config.MinimumRefreshInterval.CheckValid(); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid minimum refresh interval")
+		}
+		minimumRefreshInterval = config.MinimumRefreshInterval.AsDuration()
+		if minimumRefreshInterval <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Minimum refresh interval must be positive")
+		}
+	}
+
+	signatureValidator, err := NewRefreshingSignatureValidator(context.Background(), fetcher, clock.SystemClock, minimumRefreshInterval)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to obtain initial JSON Web Key Set")
+	}
+	group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		return signatureValidator.Run(ctx, refreshInterval)
+	})
+	return signatureValidator, nil
+}
+
 func getJWKSFromFile(path string) (SignatureValidator, error) {
 	jwksJSON, err := os.ReadFile(path)
 	if err != nil {
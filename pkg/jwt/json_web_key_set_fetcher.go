@@ -0,0 +1,73 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	jose "github.com/go-jose/go-jose/v3"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JSONWebKeySetFetcher is called into by RefreshingSignatureValidator
+// to obtain the latest copy of a JSON Web Key Set.
+type JSONWebKeySetFetcher func(ctx context.Context) (*jose.JSONWebKeySet, error)
+
+// NewHTTPJSONWebKeySetFetcher creates a JSONWebKeySetFetcher that
+// downloads a JSON Web Key Set from a fixed URL.
+func NewHTTPJSONWebKeySetFetcher(client *http.Client, jwksURL string) JSONWebKeySetFetcher {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		var jwks jose.JSONWebKeySet
+		if err := getJSON(ctx, client, jwksURL, &jwks); err != nil {
+			return nil, util.StatusWrap(err, "Failed to fetch JSON Web Key Set")
+		}
+		return &jwks, nil
+	}
+}
+
+// NewOpenIDConnectDiscoveryJSONWebKeySetFetcher creates a
+// JSONWebKeySetFetcher that downloads the JSON Web Key Set of an OpenID
+// Connect issuer. The URL of the JSON Web Key Set is obtained from the
+// issuer's discovery document, as described in OpenID Connect
+// Discovery 1.0, section 4.
+func NewOpenIDConnectDiscoveryJSONWebKeySetFetcher(client *http.Client, issuerURL string) JSONWebKeySetFetcher {
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		var discoveryDocument struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := getJSON(ctx, client, discoveryURL, &discoveryDocument); err != nil {
+			return nil, util.StatusWrap(err, "Failed to fetch OpenID Connect discovery document")
+		}
+		if discoveryDocument.JWKSURI == "" {
+			return nil, status.Error(codes.Unavailable, "OpenID Connect discovery document does not contain a JWKS URI")
+		}
+		return NewHTTPJSONWebKeySetFetcher(client, discoveryDocument.JWKSURI)(ctx)
+	}
+}
+
+// getJSON performs a HTTP GET request and unmarshals the JSON response
+// body into a provided value.
+func getJSON(ctx context.Context, client *http.Client, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to perform request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return status.Errorf(codes.Unavailable, "Request failed with HTTP status %#v", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to unmarshal response")
+	}
+	return nil
+}
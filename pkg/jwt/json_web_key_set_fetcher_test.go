@@ -0,0 +1,72 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/jwt"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	jose "github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestJSONWebKeySetFetcher(t *testing.T) {
+	ctx := context.Background()
+
+	privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	jwksJSON, err := json.Marshal(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: privateKey.Public(), KeyID: "key1", Algorithm: "EdDSA"}},
+	})
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jwksJSON)
+	})
+	mux.HandleFunc("/good/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL + "/good",
+			"jwks_uri": server.URL + "/jwks.json",
+		})
+	})
+	mux.HandleFunc("/bad/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issuer": "https://example.com"}`))
+	})
+
+	t.Run("HTTPSuccess", func(t *testing.T) {
+		jwks, err := jwt.NewHTTPJSONWebKeySetFetcher(server.Client(), server.URL+"/jwks.json")(ctx)
+		require.NoError(t, err)
+		require.Len(t, jwks.Keys, 1)
+		require.Equal(t, "key1", jwks.Keys[0].KeyID)
+	})
+
+	t.Run("HTTPNotFound", func(t *testing.T) {
+		_, err := jwt.NewHTTPJSONWebKeySetFetcher(server.Client(), server.URL+"/nonexistent.json")(ctx)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to fetch JSON Web Key Set: Request failed with HTTP status \"404 Not Found\""), err)
+	})
+
+	t.Run("OpenIDConnectDiscoverySuccess", func(t *testing.T) {
+		// Trailing slashes in the issuer URL should be ignored.
+		jwks, err := jwt.NewOpenIDConnectDiscoveryJSONWebKeySetFetcher(server.Client(), server.URL+"/good/")(ctx)
+		require.NoError(t, err)
+		require.Len(t, jwks.Keys, 1)
+		require.Equal(t, "key1", jwks.Keys[0].KeyID)
+	})
+
+	t.Run("OpenIDConnectDiscoveryMissingJWKSURI", func(t *testing.T) {
+		_, err := jwt.NewOpenIDConnectDiscoveryJSONWebKeySetFetcher(server.Client(), server.URL+"/bad")(ctx)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "OpenID Connect discovery document does not contain a JWKS URI"), err)
+	})
+}
@@ -0,0 +1,175 @@
+package jwt
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type refreshingSignatureValidatorState struct {
+	signatureValidator SignatureValidator
+	keyIDs             map[string]struct{}
+}
+
+func (s *refreshingSignatureValidatorState) hasKeyID(keyID string) bool {
+	_, ok := s.keyIDs[keyID]
+	return ok
+}
+
+// RefreshingSignatureValidator is a SignatureValidator that validates
+// signatures against a JSON Web Key Set that is obtained through a
+// JSONWebKeySetFetcher. The JSON Web Key Set is refreshed periodically.
+// It is also refreshed when a token is presented that references a key
+// ID that is not part of the JSON Web Key Set, as this may indicate
+// that the identity provider has rotated its signing keys.
+type RefreshingSignatureValidator struct {
+	fetcher                JSONWebKeySetFetcher
+	clock                  clock.Clock
+	minimumRefreshInterval time.Duration
+	refreshRequests        chan struct{}
+
+	state atomic.Pointer[refreshingSignatureValidatorState]
+
+	refreshLock sync.Mutex
+	lastRefresh time.Time
+}
+
+var _ SignatureValidator = (*RefreshingSignatureValidator)(nil)
+
+// NewRefreshingSignatureValidator creates a new
+// RefreshingSignatureValidator. The initial copy of the JSON Web Key
+// Set is fetched synchronously, so that misconfigurations are detected
+// at startup.
+func NewRefreshingSignatureValidator(ctx context.Context, fetcher JSONWebKeySetFetcher, clock clock.Clock, minimumRefreshInterval time.Duration) (*RefreshingSignatureValidator, error) {
+	sv := &RefreshingSignatureValidator{
+		fetcher:                fetcher,
+		clock:                  clock,
+		minimumRefreshInterval: minimumRefreshInterval,
+		refreshRequests:        make(chan struct{}, 1),
+	}
+	if err := sv.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	return sv, nil
+}
+
+// refreshLocked fetches a new copy of the JSON Web Key Set. The caller
+// must hold refreshLock.
+func (sv *RefreshingSignatureValidator) refreshLocked(ctx context.Context) error {
+	sv.lastRefresh = sv.clock.Now()
+	jwks, err := sv.fetcher(ctx)
+	if err != nil {
+		return err
+	}
+	signatureValidator, err := NewSignatureValidatorFromJSONWebKeySet(jwks)
+	if err != nil {
+		return util.StatusWrap(err, "Invalid JSON Web Key Set")
+	}
+	keyIDs := map[string]struct{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyID != "" {
+			keyIDs[jwk.KeyID] = struct{}{}
+		}
+	}
+	sv.state.Store(&refreshingSignatureValidatorState{
+		signatureValidator: signatureValidator,
+		keyIDs:             keyIDs,
+	})
+	return nil
+}
+
+// refreshForUnknownKeyID is called when a token is presented that
+// references a key ID that is not part of the JSON Web Key Set. It
+// refreshes the JSON Web Key Set synchronously, unless the last
+// refresh took place less than the minimum refresh interval ago. In
+// that case a refresh is requested from Run() once the minimum refresh
+// interval has passed.
+func (sv *RefreshingSignatureValidator) refreshForUnknownKeyID(keyID string) *refreshingSignatureValidatorState {
+	sv.refreshLock.Lock()
+	defer sv.refreshLock.Unlock()
+
+	// Another goroutine may have refreshed the JSON Web Key Set
+	// while we were waiting for the lock.
+	if state := sv.state.Load(); state.hasKeyID(keyID) {
+		return state
+	}
+
+	if sv.clock.Now().Before(sv.lastRefresh.Add(sv.minimumRefreshInterval)) {
+		// The JSON Web Key Set was refreshed recently. Don't
+		// overload the server, but do make sure the refresh
+		// isn't lost.
+		select {
+		case sv.refreshRequests <- struct{}{}:
+		default:
+		}
+		return nil
+	}
+	if err := sv.refreshLocked(context.Background()); err != nil {
+		log.Printf("Failed to refresh JSON Web Key Set: %s", err)
+		return nil
+	}
+	return sv.state.Load()
+}
+
+// ValidateSignature validates a signature using the most recently
+// fetched copy of the JSON Web Key Set.
+func (sv *RefreshingSignatureValidator) ValidateSignature(algorithm string, keyID *string, headerAndPayload string, signature []byte) bool {
+	state := sv.state.Load()
+	if state.signatureValidator.ValidateSignature(algorithm, keyID, headerAndPayload, signature) {
+		return true
+	}
+	if keyID == nil || state.hasKeyID(*keyID) {
+		return false
+	}
+	state = sv.refreshForUnknownKeyID(*keyID)
+	return state != nil && state.signatureValidator.ValidateSignature(algorithm, keyID, headerAndPayload, signature)
+}
+
+// Run the refresh loop of the RefreshingSignatureValidator. This
+// function blocks until the provided context is cancelled. Failures to
+// refresh the JSON Web Key Set are logged, causing the previously
+// fetched copy to remain in use.
+func (sv *RefreshingSignatureValidator) Run(ctx context.Context, refreshInterval time.Duration) error {
+	refreshRequested := false
+	for {
+		// Determine when the next refresh needs to take place.
+		// Refreshes that were requested due to the presence
+		// of unknown key IDs are deferred until the minimum
+		// refresh interval has passed.
+		sv.refreshLock.Lock()
+		lastRefresh := sv.lastRefresh
+		sv.refreshLock.Unlock()
+		nextRefresh := lastRefresh.Add(refreshInterval)
+		if refreshRequested {
+			if t := lastRefresh.Add(sv.minimumRefreshInterval); t.Before(nextRefresh) {
+				nextRefresh = t
+			}
+		}
+
+		timer, t := sv.clock.NewTimer(nextRefresh.Sub(sv.clock.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return util.StatusFromContext(ctx)
+		case <-sv.refreshRequests:
+			timer.Stop()
+			refreshRequested = true
+		case <-t:
+			// Refresh, unless the JSON Web Key Set was
+			// refreshed synchronously in the meantime.
+			sv.refreshLock.Lock()
+			if sv.lastRefresh.Equal(lastRefresh) {
+				refreshRequested = false
+				if err := sv.refreshLocked(ctx); err != nil {
+					log.Printf("Failed to refresh JSON Web Key Set: %s", err)
+				}
+			}
+			sv.refreshLock.Unlock()
+		}
+	}
+}
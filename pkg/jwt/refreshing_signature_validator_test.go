@@ -0,0 +1,141 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/jwt"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	jose "github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshingSignatureValidator(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	privateKey1 := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	privateKey2 := ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+	privateKey3 := ed25519.NewKeyFromSeed([]byte("abcdef0123456789abcdef0123456789"))
+	jwk1 := jose.JSONWebKey{Key: privateKey1.Public(), KeyID: "key1", Algorithm: "EdDSA"}
+	jwk2 := jose.JSONWebKey{Key: privateKey2.Public(), KeyID: "key2", Algorithm: "EdDSA"}
+	jwk3 := jose.JSONWebKey{Key: privateKey3.Public(), KeyID: "key3", Algorithm: "EdDSA"}
+	keyID1 := "key1"
+	keyID2 := "key2"
+	keyID3 := "key3"
+	headerAndPayload := "eyJhbGciOiJFZERTQSJ9.eyJpZCI6MX0"
+	signature1 := ed25519.Sign(privateKey1, []byte(headerAndPayload))
+	signature2 := ed25519.Sign(privateKey2, []byte(headerAndPayload))
+	signature3 := ed25519.Sign(privateKey3, []byte(headerAndPayload))
+
+	clock := mock.NewMockClock(ctrl)
+	fetcher := mock.NewMockJSONWebKeySetFetcher(ctrl)
+
+	t.Run("InitialFetchFailure", func(t *testing.T) {
+		// Failing to obtain the initial JSON Web Key Set should
+		// cause construction to fail.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		fetcher.EXPECT().Call(ctx).Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := jwt.NewRefreshingSignatureValidator(ctx, fetcher.Call, clock, time.Minute)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("UnknownKeyIDsWithinMinimumRefreshInterval", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		fetcher.EXPECT().Call(ctx).Return(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk1}}, nil)
+
+		signatureValidator, err := jwt.NewRefreshingSignatureValidator(ctx, fetcher.Call, clock, time.Minute)
+		require.NoError(t, err)
+
+		// Tokens referencing made up key IDs should not cause
+		// the JSON Web Key Set to be fetched while the minimum
+		// refresh interval has not passed, regardless of how
+		// many of them are presented.
+		for i := 0; i < 100; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000+int64(i)/2, 0))
+			keyID := fmt.Sprintf("bogus%d", i)
+			require.False(t, signatureValidator.ValidateSignature("EdDSA", &keyID, headerAndPayload, signature1))
+		}
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		fetcher.EXPECT().Call(ctx).Return(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk1}}, nil)
+
+		signatureValidator, err := jwt.NewRefreshingSignatureValidator(ctx, fetcher.Call, clock, time.Minute)
+		require.NoError(t, err)
+
+		// Signatures created with the initial key should be
+		// accepted. Signatures referencing the second key
+		// should be rejected. As the JSON Web Key Set was
+		// refreshed less than a minute ago, the refresh should
+		// be deferred.
+		require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID1, headerAndPayload, signature1))
+		require.False(t, signatureValidator.ValidateSignature("EdDSA", &keyID1, headerAndPayload, signature2))
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		require.False(t, signatureValidator.ValidateSignature("EdDSA", &keyID2, headerAndPayload, signature2))
+
+		runCtx, cancel := context.WithCancel(ctx)
+
+		// The refresh loop should pick up the deferred refresh
+		// request, and perform it once the minimum refresh
+		// interval has passed.
+		timer1 := mock.NewMockTimer(ctrl)
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		clock.EXPECT().NewTimer(290*time.Second).Return(timer1, make(chan time.Time))
+		timer1.EXPECT().Stop().Return(true)
+
+		timer2 := mock.NewMockTimer(ctrl)
+		timerChannel2 := make(chan time.Time, 1)
+		timerChannel2 <- time.Unix(1060, 0)
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		clock.EXPECT().NewTimer(50*time.Second).Return(timer2, timerChannel2)
+		clock.EXPECT().Now().Return(time.Unix(1060, 0))
+		fetcher.EXPECT().Call(runCtx).Return(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk1, jwk2}}, nil)
+
+		// Periodic refreshes should still take place. Failures
+		// should cause the existing keys to remain in use.
+		timer3 := mock.NewMockTimer(ctrl)
+		timerChannel3 := make(chan time.Time, 1)
+		timerChannel3 <- time.Unix(1360, 0)
+		clock.EXPECT().Now().Return(time.Unix(1070, 0))
+		clock.EXPECT().NewTimer(290*time.Second).Return(timer3, timerChannel3)
+		clock.EXPECT().Now().Return(time.Unix(1360, 0))
+		fetcher.EXPECT().Call(runCtx).Return(nil, status.Error(codes.Unavailable, "Server offline"))
+
+		// Presenting a token referencing a third key after the
+		// minimum refresh interval has passed should cause the
+		// JSON Web Key Set to be refreshed synchronously, so
+		// that the token is accepted right away.
+		timer4 := mock.NewMockTimer(ctrl)
+		clock.EXPECT().Now().Return(time.Unix(1370, 0))
+		clock.EXPECT().NewTimer(290 * time.Second).DoAndReturn(func(d time.Duration) (*mock.MockTimer, <-chan time.Time) {
+			require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID2, headerAndPayload, signature2))
+
+			clock.EXPECT().Now().Return(time.Unix(1420, 0)).Times(2)
+			fetcher.EXPECT().Call(gomock.Any()).Return(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk1, jwk2, jwk3}}, nil)
+			require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID3, headerAndPayload, signature3))
+
+			// Terminate the refresh loop.
+			cancel()
+			return timer4, make(chan time.Time)
+		})
+		timer4.EXPECT().Stop().Return(true)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), signatureValidator.Run(runCtx, 300*time.Second))
+
+		// All keys should now be accepted.
+		require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID1, headerAndPayload, signature1))
+		require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID2, headerAndPayload, signature2))
+		require.True(t, signatureValidator.ValidateSignature("EdDSA", &keyID3, headerAndPayload, signature3))
+	})
+}
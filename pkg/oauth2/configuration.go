@@ -0,0 +1,57 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	http_client "github.com/buildbarn/bb-storage/pkg/http/client"
+	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/oauth2"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/jmespath/go-jmespath"
+
+	"google.golang.org/grpc/codes"
+)
+
+// NewTokenIntrospectorFromConfiguration creates a new TokenIntrospector
+// based on options stored in a configuration file.
+func NewTokenIntrospectorFromConfiguration(config *configuration.TokenIntrospectionConfiguration) (*TokenIntrospector, error) {
+	roundTripper, err := http_client.NewRoundTripperFromConfiguration(config.HttpClient)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create HTTP client")
+	}
+
+	if err := config.PositiveCacheDuration.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid positive cache duration")
+	}
+	if err := config.NegativeCacheDuration.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid negative cache duration")
+	}
+
+	evictionSet, err := eviction.NewSetFromConfiguration[string](config.CacheReplacementPolicy)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create eviction set")
+	}
+
+	validator, err := jmespath.Compile(config.ValidationJmespathExpression)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to compile validation JMESPath expression")
+	}
+	metadataExtractor, err := jmespath.Compile(config.MetadataExtractionJmespathExpression)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to compile metadata extraction JMESPath expression")
+	}
+
+	return NewTokenIntrospector(
+		&http.Client{Transport: roundTripper},
+		config.IntrospectionEndpointUrl,
+		config.ClientId,
+		config.ClientSecret,
+		clock.SystemClock,
+		validator,
+		metadataExtractor,
+		config.PositiveCacheDuration.AsDuration(),
+		config.NegativeCacheDuration.AsDuration(),
+		int(config.MaximumCacheSize),
+		eviction.NewMetricsSet(evictionSet, "TokenIntrospector")), nil
+}
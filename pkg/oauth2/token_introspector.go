@@ -0,0 +1,204 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/jmespath/go-jmespath"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pattern of authorization headers from which to extract an OAuth2
+// bearer token.
+var bearerTokenHeaderPattern = regexp.MustCompile(`^Bearer\s+(\S+)$`)
+
+type introspectionResult struct {
+	cacheExpirationTime time.Time
+	metadata            *auth.AuthenticationMetadata
+}
+
+// TokenIntrospector is a helper type for validating OAuth2 bearer
+// tokens stored in HTTP "Authorization" headers of shape
+// "Bearer ${token}". Tokens are validated by submitting them to an
+// OAuth 2.0 token introspection endpoint, as described in RFC 7662.
+// To prevent sending excessive amounts of requests to the
+// introspection endpoint, this type holds on to a cache of recently
+// observed tokens.
+type TokenIntrospector struct {
+	client                *http.Client
+	endpointURL           string
+	clientID              string
+	clientSecret          string
+	clock                 clock.Clock
+	validator             *jmespath.JMESPath
+	metadataExtractor     *jmespath.JMESPath
+	positiveCacheDuration time.Duration
+	negativeCacheDuration time.Duration
+	maximumCacheSize      int
+
+	lock          sync.Mutex
+	cachedResults map[string]introspectionResult
+	evictionSet   eviction.Set[string]
+}
+
+// NewTokenIntrospector creates a new TokenIntrospector that does not
+// have any cached introspection results.
+func NewTokenIntrospector(client *http.Client, endpointURL, clientID, clientSecret string, clock clock.Clock, validator, metadataExtractor *jmespath.JMESPath, positiveCacheDuration, negativeCacheDuration time.Duration, maximumCacheSize int, evictionSet eviction.Set[string]) *TokenIntrospector {
+	return &TokenIntrospector{
+		client:                client,
+		endpointURL:           endpointURL,
+		clientID:              clientID,
+		clientSecret:          clientSecret,
+		clock:                 clock,
+		validator:             validator,
+		metadataExtractor:     metadataExtractor,
+		positiveCacheDuration: positiveCacheDuration,
+		negativeCacheDuration: negativeCacheDuration,
+		maximumCacheSize:      maximumCacheSize,
+
+		cachedResults: map[string]introspectionResult{},
+		evictionSet:   evictionSet,
+	}
+}
+
+func (ti *TokenIntrospector) introspect(ctx context.Context, token string, now time.Time) (introspectionResult, error) {
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		ti.endpointURL,
+		strings.NewReader(url.Values{
+			"token":           {token},
+			"token_type_hint": {"access_token"},
+		}.Encode()))
+	if err != nil {
+		return introspectionResult{}, util.StatusWrapWithCode(err, codes.Internal, "Failed to create token introspection request")
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if ti.clientID != "" {
+		request.SetBasicAuth(url.QueryEscape(ti.clientID), url.QueryEscape(ti.clientSecret))
+	}
+	response, err := ti.client.Do(request)
+	if err != nil {
+		return introspectionResult{}, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to perform token introspection request")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return introspectionResult{}, status.Errorf(codes.Unavailable, "Token introspection failed with HTTP status %#v", response.Status)
+	}
+
+	var fullResponseMessage interface{}
+	if err := json.NewDecoder(response.Body).Decode(&fullResponseMessage); err != nil {
+		return introspectionResult{}, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to unmarshal token introspection response")
+	}
+	fullResponseMap, ok := fullResponseMessage.(map[string]interface{})
+	if !ok {
+		return introspectionResult{}, status.Error(codes.Unavailable, "Token introspection response is not a JSON object")
+	}
+
+	inactive := introspectionResult{
+		cacheExpirationTime: now.Add(ti.negativeCacheDuration),
+	}
+	if fullResponseMap["active"] != true {
+		return inactive, nil
+	}
+	if result, err := ti.validator.Search(fullResponseMessage); err != nil || result != true {
+		return inactive, nil
+	}
+
+	// Don't cache the result beyond the token's expiration time.
+	r := introspectionResult{
+		cacheExpirationTime: now.Add(ti.positiveCacheDuration),
+	}
+	if exp, ok := fullResponseMap["exp"].(float64); ok {
+		if expirationTime := time.Unix(int64(exp), 0); expirationTime.Before(r.cacheExpirationTime) {
+			if !now.Before(expirationTime) {
+				return inactive, nil
+			}
+			r.cacheExpirationTime = expirationTime
+		}
+	}
+
+	// Convert the response to authentication metadata.
+	metadataRaw, err := ti.metadataExtractor.Search(fullResponseMessage)
+	if err != nil {
+		return inactive, nil
+	}
+	r.metadata, err = auth.NewAuthenticationMetadataFromRaw(metadataRaw)
+	if err != nil {
+		log.Print("Failed to create authentication metadata: ", err)
+		return inactive, nil
+	}
+	return r, nil
+}
+
+// ParseAuthorizationHeaders takes a set of HTTP "Authorization" headers
+// and returns the authentication metadata of the first header that
+// contains a bearer token that is reported to be active by the
+// introspection endpoint.
+func (ti *TokenIntrospector) ParseAuthorizationHeaders(ctx context.Context, headers []string) (*auth.AuthenticationMetadata, error) {
+	// Extract tokens from the headers, and check whether any of them
+	// have been introspected before. If so, skip introspection
+	// entirely.
+	now := ti.clock.Now()
+	tokensToCheck := make([]string, 0, len(headers))
+	ti.lock.Lock()
+	for _, header := range headers {
+		match := bearerTokenHeaderPattern.FindStringSubmatch(header)
+		if match == nil {
+			continue
+		}
+		token := match[1]
+		if result, ok := ti.cachedResults[token]; ok && now.Before(result.cacheExpirationTime) {
+			ti.evictionSet.Touch(token)
+			if result.metadata != nil {
+				ti.lock.Unlock()
+				return result.metadata, nil
+			}
+		} else {
+			tokensToCheck = append(tokensToCheck, token)
+		}
+	}
+	ti.lock.Unlock()
+
+	// Tokens are not cached. Introspect them and cache the results.
+	// Don't hold the lock while the introspection request is in
+	// flight, as that would block requests using other tokens.
+	for _, token := range tokensToCheck {
+		result, err := ti.introspect(ctx, token, now)
+		if err != nil {
+			return nil, err
+		}
+
+		ti.lock.Lock()
+		if _, ok := ti.cachedResults[token]; ok {
+			ti.evictionSet.Touch(token)
+		} else {
+			for len(ti.cachedResults) >= ti.maximumCacheSize {
+				delete(ti.cachedResults, ti.evictionSet.Peek())
+				ti.evictionSet.Remove()
+			}
+			ti.evictionSet.Insert(token)
+		}
+		ti.cachedResults[token] = result
+		ti.lock.Unlock()
+
+		if result.metadata != nil {
+			return result.metadata, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "No valid authorization header containing an active bearer token provided")
+}
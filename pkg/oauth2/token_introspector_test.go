@@ -0,0 +1,154 @@
+package oauth2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/oauth2"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/jmespath/go-jmespath"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTokenIntrospector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	// Introspection endpoint that considers tokens "good" and
+	// "expiring" to be active, and all other tokens inactive.
+	requestsCount := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "my-client", clientID)
+		require.Equal(t, "my-secret", clientSecret)
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "access_token", r.PostFormValue("token_type_hint"))
+
+		token := r.PostFormValue("token")
+		requestsCount[token]++
+		w.Header().Set("Content-Type", "application/json")
+		switch token {
+		case "good":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"active":   true,
+				"username": "jdoe",
+				"scope":    "read build",
+			})
+		case "expiring":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"active":   true,
+				"username": "expiring",
+				"scope":    "build",
+				"exp":      1030,
+			})
+		case "wrong-scope":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"active":   true,
+				"username": "jdoe",
+				"scope":    "read",
+			})
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"active": false,
+			})
+		}
+	}))
+	defer server.Close()
+
+	clock := mock.NewMockClock(ctrl)
+	tokenIntrospector := oauth2.NewTokenIntrospector(
+		server.Client(),
+		server.URL,
+		"my-client",
+		"my-secret",
+		clock,
+		jmespath.MustCompile("contains(scope, 'build')"),
+		jmespath.MustCompile("{\"public\": {\"username\": username}}"),
+		time.Minute,
+		10*time.Second,
+		10,
+		eviction.NewLRUSet[string]())
+
+	t.Run("NoAuthorizationHeaders", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+
+		_, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, nil)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "No valid authorization header containing an active bearer token provided"), err)
+	})
+
+	t.Run("ActiveToken", func(t *testing.T) {
+		// Only the first request should cause the introspection
+		// endpoint to be called. Successive requests should be
+		// served from the cache, until the cache duration
+		// expires.
+		for _, now := range []int64{1000, 1030, 1059, 1060} {
+			clock.EXPECT().Now().Return(time.Unix(now, 0))
+			metadata, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Bearer good"})
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{
+				"public": map[string]any{"username": "jdoe"},
+			}, metadata.GetRaw())
+		}
+		require.Equal(t, 2, requestsCount["good"])
+	})
+
+	t.Run("ExpiringToken", func(t *testing.T) {
+		// The cache duration should be limited by the token's
+		// expiration time.
+		clock.EXPECT().Now().Return(time.Unix(1020, 0))
+		_, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Bearer expiring"})
+		require.NoError(t, err)
+
+		clock.EXPECT().Now().Return(time.Unix(1030, 0))
+		_, err = tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Bearer expiring"})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "No valid authorization header containing an active bearer token provided"), err)
+		require.Equal(t, 2, requestsCount["expiring"])
+	})
+
+	t.Run("InactiveToken", func(t *testing.T) {
+		// Both inactive tokens and tokens that fail validation
+		// should be rejected. These results should be cached
+		// as well, but for a shorter amount of time.
+		for _, now := range []int64{1000, 1009, 1010} {
+			clock.EXPECT().Now().Return(time.Unix(now, 0))
+			_, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Bearer revoked", "Bearer wrong-scope"})
+			testutil.RequireEqualStatus(t, status.Error(codes.Unauthenticated, "No valid authorization header containing an active bearer token provided"), err)
+		}
+		require.Equal(t, 2, requestsCount["revoked"])
+		require.Equal(t, 2, requestsCount["wrong-scope"])
+	})
+
+	t.Run("MultipleHeaders", func(t *testing.T) {
+		// Headers that don't contain bearer tokens should be
+		// ignored. The first active token should be used.
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		metadata, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Basic Zm9vOmJhcg==", "Bearer revoked", "Bearer good"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"username": "jdoe"}, metadata.GetRaw()["public"])
+	})
+
+	t.Run("IntrospectionFailure", func(t *testing.T) {
+		// Failures to contact the introspection endpoint should
+		// be propagated, and not be cached.
+		for i := 0; i < 2; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000, 0))
+			_, err := tokenIntrospector.ParseAuthorizationHeaders(ctx, []string{"Bearer broken"})
+			testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Token introspection failed with HTTP status \"500 Internal Server Error\""), err)
+		}
+		require.Equal(t, 2, requestsCount["broken"])
+	})
+}
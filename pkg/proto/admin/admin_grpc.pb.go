@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.1
+// source: pkg/proto/admin/admin.proto
+
+package admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Admin_InvalidateBlobs_FullMethodName              = "/buildbarn.admin.Admin/InvalidateBlobs"
+	Admin_InvalidateInstanceNamePrefix_FullMethodName = "/buildbarn.admin.Admin/InvalidateInstanceNamePrefix"
+	Admin_ListDigests_FullMethodName                  = "/buildbarn.admin.Admin/ListDigests"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	InvalidateBlobs(ctx context.Context, in *InvalidateBlobsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InvalidateInstanceNamePrefix(ctx context.Context, in *InvalidateInstanceNamePrefixRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (Admin_ListDigestsClient, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) InvalidateBlobs(ctx context.Context, in *InvalidateBlobsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Admin_InvalidateBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) InvalidateInstanceNamePrefix(ctx context.Context, in *InvalidateInstanceNamePrefixRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Admin_InvalidateInstanceNamePrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (Admin_ListDigestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], Admin_ListDigests_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminListDigestsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_ListDigestsClient interface {
+	Recv() (*ListDigestsResponse, error)
+	grpc.ClientStream
+}
+
+type adminListDigestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminListDigestsClient) Recv() (*ListDigestsResponse, error) {
+	m := new(ListDigestsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations should embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	InvalidateBlobs(context.Context, *InvalidateBlobsRequest) (*emptypb.Empty, error)
+	InvalidateInstanceNamePrefix(context.Context, *InvalidateInstanceNamePrefixRequest) (*emptypb.Empty, error)
+	ListDigests(*ListDigestsRequest, Admin_ListDigestsServer) error
+}
+
+// UnimplementedAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) InvalidateBlobs(context.Context, *InvalidateBlobsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateBlobs not implemented")
+}
+func (UnimplementedAdminServer) InvalidateInstanceNamePrefix(context.Context, *InvalidateInstanceNamePrefixRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInstanceNamePrefix not implemented")
+}
+func (UnimplementedAdminServer) ListDigests(*ListDigestsRequest, Admin_ListDigestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDigests not implemented")
+}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_InvalidateBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).InvalidateBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_InvalidateBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).InvalidateBlobs(ctx, req.(*InvalidateBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_InvalidateInstanceNamePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateInstanceNamePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).InvalidateInstanceNamePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_InvalidateInstanceNamePrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).InvalidateInstanceNamePrefix(ctx, req.(*InvalidateInstanceNamePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListDigests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDigestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).ListDigests(m, &adminListDigestsServer{stream})
+}
+
+type Admin_ListDigestsServer interface {
+	Send(*ListDigestsResponse) error
+	grpc.ServerStream
+}
+
+type adminListDigestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminListDigestsServer) Send(m *ListDigestsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buildbarn.admin.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvalidateBlobs",
+			Handler:    _Admin_InvalidateBlobs_Handler,
+		},
+		{
+			MethodName: "InvalidateInstanceNamePrefix",
+			Handler:    _Admin_InvalidateInstanceNamePrefix_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListDigests",
+			Handler:       _Admin_ListDigests_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/proto/admin/admin.proto",
+}
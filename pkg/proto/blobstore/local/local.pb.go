@@ -25,8 +25,10 @@ type BlockLocation struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OffsetBytes int64 `protobuf:"varint,1,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	SizeBytes   int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	OffsetBytes     int64  `protobuf:"varint,1,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	SizeBytes       int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	EncryptionKeyId uint32 `protobuf:"varint,3,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
+	DeviceIndex     uint32 `protobuf:"varint,4,opt,name=device_index,json=deviceIndex,proto3" json:"device_index,omitempty"`
 }
 
 func (x *BlockLocation) Reset() {
@@ -75,6 +77,20 @@ func (x *BlockLocation) GetSizeBytes() int64 {
 	return 0
 }
 
+func (x *BlockLocation) GetEncryptionKeyId() uint32 {
+	if x != nil {
+		return x.EncryptionKeyId
+	}
+	return 0
+}
+
+func (x *BlockLocation) GetDeviceIndex() uint32 {
+	if x != nil {
+		return x.DeviceIndex
+	}
+	return 0
+}
+
 type BlockState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -146,6 +162,7 @@ type PersistentState struct {
 	OldestEpochId                    uint32        `protobuf:"varint,1,opt,name=oldest_epoch_id,json=oldestEpochId,proto3" json:"oldest_epoch_id,omitempty"`
 	Blocks                           []*BlockState `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
 	KeyLocationMapHashInitialization uint64        `protobuf:"varint,3,opt,name=key_location_map_hash_initialization,json=keyLocationMapHashInitialization,proto3" json:"key_location_map_hash_initialization,omitempty"`
+	KeyLocationMapRecordsCount       int64         `protobuf:"varint,4,opt,name=key_location_map_records_count,json=keyLocationMapRecordsCount,proto3" json:"key_location_map_records_count,omitempty"`
 }
 
 func (x *PersistentState) Reset() {
@@ -201,6 +218,287 @@ func (x *PersistentState) GetKeyLocationMapHashInitialization() uint64 {
 	return 0
 }
 
+func (x *PersistentState) GetKeyLocationMapRecordsCount() int64 {
+	if x != nil {
+		return x.KeyLocationMapRecordsCount
+	}
+	return 0
+}
+
+type InvalidatedInstanceNamePrefixes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generations map[string]uint64 `protobuf:"bytes,1,rep,name=generations,proto3" json:"generations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *InvalidatedInstanceNamePrefixes) Reset() {
+	*x = InvalidatedInstanceNamePrefixes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidatedInstanceNamePrefixes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidatedInstanceNamePrefixes) ProtoMessage() {}
+
+func (x *InvalidatedInstanceNamePrefixes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidatedInstanceNamePrefixes.ProtoReflect.Descriptor instead.
+func (*InvalidatedInstanceNamePrefixes) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_local_local_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvalidatedInstanceNamePrefixes) GetGenerations() map[string]uint64 {
+	if x != nil {
+		return x.Generations
+	}
+	return nil
+}
+
+type JournalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//
+	//	*JournalRecord_SegmentStart_
+	//	*JournalRecord_BlockPushed
+	//	*JournalRecord_BlockPopped
+	//	*JournalRecord_KeyLocationMapPut_
+	Kind isJournalRecord_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *JournalRecord) Reset() {
+	*x = JournalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JournalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalRecord) ProtoMessage() {}
+
+func (x *JournalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalRecord.ProtoReflect.Descriptor instead.
+func (*JournalRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_local_local_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *JournalRecord) GetKind() isJournalRecord_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *JournalRecord) GetSegmentStart() *JournalRecord_SegmentStart {
+	if x, ok := x.GetKind().(*JournalRecord_SegmentStart_); ok {
+		return x.SegmentStart
+	}
+	return nil
+}
+
+func (x *JournalRecord) GetBlockPushed() *BlockLocation {
+	if x, ok := x.GetKind().(*JournalRecord_BlockPushed); ok {
+		return x.BlockPushed
+	}
+	return nil
+}
+
+func (x *JournalRecord) GetBlockPopped() *BlockLocation {
+	if x, ok := x.GetKind().(*JournalRecord_BlockPopped); ok {
+		return x.BlockPopped
+	}
+	return nil
+}
+
+func (x *JournalRecord) GetKeyLocationMapPut() *JournalRecord_KeyLocationMapPut {
+	if x, ok := x.GetKind().(*JournalRecord_KeyLocationMapPut_); ok {
+		return x.KeyLocationMapPut
+	}
+	return nil
+}
+
+type isJournalRecord_Kind interface {
+	isJournalRecord_Kind()
+}
+
+type JournalRecord_SegmentStart_ struct {
+	SegmentStart *JournalRecord_SegmentStart `protobuf:"bytes,1,opt,name=segment_start,json=segmentStart,proto3,oneof"`
+}
+
+type JournalRecord_BlockPushed struct {
+	BlockPushed *BlockLocation `protobuf:"bytes,2,opt,name=block_pushed,json=blockPushed,proto3,oneof"`
+}
+
+type JournalRecord_BlockPopped struct {
+	BlockPopped *BlockLocation `protobuf:"bytes,3,opt,name=block_popped,json=blockPopped,proto3,oneof"`
+}
+
+type JournalRecord_KeyLocationMapPut_ struct {
+	KeyLocationMapPut *JournalRecord_KeyLocationMapPut `protobuf:"bytes,4,opt,name=key_location_map_put,json=keyLocationMapPut,proto3,oneof"`
+}
+
+func (*JournalRecord_SegmentStart_) isJournalRecord_Kind() {}
+
+func (*JournalRecord_BlockPushed) isJournalRecord_Kind() {}
+
+func (*JournalRecord_BlockPopped) isJournalRecord_Kind() {}
+
+func (*JournalRecord_KeyLocationMapPut_) isJournalRecord_Kind() {}
+
+type JournalRecord_SegmentStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockLocation `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *JournalRecord_SegmentStart) Reset() {
+	*x = JournalRecord_SegmentStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JournalRecord_SegmentStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalRecord_SegmentStart) ProtoMessage() {}
+
+func (x *JournalRecord_SegmentStart) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalRecord_SegmentStart.ProtoReflect.Descriptor instead.
+func (*JournalRecord_SegmentStart) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_local_local_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *JournalRecord_SegmentStart) GetBlocks() []*BlockLocation {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type JournalRecord_KeyLocationMapPut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           []byte         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	BlockLocation *BlockLocation `protobuf:"bytes,2,opt,name=block_location,json=blockLocation,proto3" json:"block_location,omitempty"`
+	OffsetBytes   int64          `protobuf:"varint,3,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	SizeBytes     int64          `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *JournalRecord_KeyLocationMapPut) Reset() {
+	*x = JournalRecord_KeyLocationMapPut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JournalRecord_KeyLocationMapPut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalRecord_KeyLocationMapPut) ProtoMessage() {}
+
+func (x *JournalRecord_KeyLocationMapPut) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_local_local_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalRecord_KeyLocationMapPut.ProtoReflect.Descriptor instead.
+func (*JournalRecord_KeyLocationMapPut) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_local_local_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *JournalRecord_KeyLocationMapPut) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *JournalRecord_KeyLocationMapPut) GetBlockLocation() *BlockLocation {
+	if x != nil {
+		return x.BlockLocation
+	}
+	return nil
+}
+
+func (x *JournalRecord_KeyLocationMapPut) GetOffsetBytes() int64 {
+	if x != nil {
+		return x.OffsetBytes
+	}
+	return 0
+}
+
+func (x *JournalRecord_KeyLocationMapPut) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
 var File_pkg_proto_blobstore_local_local_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
@@ -208,12 +506,17 @@ var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
 	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
 	0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x22, 0x51, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x61, 0x6c, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x66,
 	0x66, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x79, 0x74,
@@ -225,7 +528,7 @@ var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
 	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x01, 0x10, 0x02, 0x22, 0x8c, 0x02, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
 	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x73,
 	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12,
@@ -237,12 +540,70 @@ var file_pkg_proto_blobstore_local_local_proto_rawDesc = []byte{
 	0x61, 0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
 	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x20, 0x6b, 0x65,
 	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x48, 0x61, 0x73, 0x68,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b,
-	0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42,
+	0x0a, 0x1e, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x1f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x05, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x5c, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70,
+	0x75, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x75,
+	0x73, 0x68, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x6d, 0x0a, 0x14, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x50, 0x75, 0x74, 0x48, 0x00, 0x52,
+	0x11, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x50,
+	0x75, 0x74, 0x1a, 0x50, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x50, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42,
+	0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f,
+	0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -257,20 +618,32 @@ func file_pkg_proto_blobstore_local_local_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_blobstore_local_local_proto_rawDescData
 }
 
-var file_pkg_proto_blobstore_local_local_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_proto_blobstore_local_local_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pkg_proto_blobstore_local_local_proto_goTypes = []interface{}{
-	(*BlockLocation)(nil),   // 0: buildbarn.blobstore.local.BlockLocation
-	(*BlockState)(nil),      // 1: buildbarn.blobstore.local.BlockState
-	(*PersistentState)(nil), // 2: buildbarn.blobstore.local.PersistentState
+	(*BlockLocation)(nil),                   // 0: buildbarn.blobstore.local.BlockLocation
+	(*BlockState)(nil),                      // 1: buildbarn.blobstore.local.BlockState
+	(*PersistentState)(nil),                 // 2: buildbarn.blobstore.local.PersistentState
+	(*InvalidatedInstanceNamePrefixes)(nil), // 3: buildbarn.blobstore.local.InvalidatedInstanceNamePrefixes
+	(*JournalRecord)(nil),                   // 4: buildbarn.blobstore.local.JournalRecord
+	nil,                                     // 5: buildbarn.blobstore.local.InvalidatedInstanceNamePrefixes.GenerationsEntry
+	(*JournalRecord_SegmentStart)(nil),      // 6: buildbarn.blobstore.local.JournalRecord.SegmentStart
+	(*JournalRecord_KeyLocationMapPut)(nil), // 7: buildbarn.blobstore.local.JournalRecord.KeyLocationMapPut
 }
 var file_pkg_proto_blobstore_local_local_proto_depIdxs = []int32{
 	0, // 0: buildbarn.blobstore.local.BlockState.block_location:type_name -> buildbarn.blobstore.local.BlockLocation
 	1, // 1: buildbarn.blobstore.local.PersistentState.blocks:type_name -> buildbarn.blobstore.local.BlockState
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: buildbarn.blobstore.local.InvalidatedInstanceNamePrefixes.generations:type_name -> buildbarn.blobstore.local.InvalidatedInstanceNamePrefixes.GenerationsEntry
+	6, // 3: buildbarn.blobstore.local.JournalRecord.segment_start:type_name -> buildbarn.blobstore.local.JournalRecord.SegmentStart
+	0, // 4: buildbarn.blobstore.local.JournalRecord.block_pushed:type_name -> buildbarn.blobstore.local.BlockLocation
+	0, // 5: buildbarn.blobstore.local.JournalRecord.block_popped:type_name -> buildbarn.blobstore.local.BlockLocation
+	7, // 6: buildbarn.blobstore.local.JournalRecord.key_location_map_put:type_name -> buildbarn.blobstore.local.JournalRecord.KeyLocationMapPut
+	0, // 7: buildbarn.blobstore.local.JournalRecord.SegmentStart.blocks:type_name -> buildbarn.blobstore.local.BlockLocation
+	0, // 8: buildbarn.blobstore.local.JournalRecord.KeyLocationMapPut.block_location:type_name -> buildbarn.blobstore.local.BlockLocation
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_blobstore_local_local_proto_init() }
@@ -315,6 +688,60 @@ func file_pkg_proto_blobstore_local_local_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_blobstore_local_local_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidatedInstanceNamePrefixes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_blobstore_local_local_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_blobstore_local_local_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalRecord_SegmentStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_blobstore_local_local_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalRecord_KeyLocationMapPut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_proto_blobstore_local_local_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*JournalRecord_SegmentStart_)(nil),
+		(*JournalRecord_BlockPushed)(nil),
+		(*JournalRecord_BlockPopped)(nil),
+		(*JournalRecord_KeyLocationMapPut_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -322,7 +749,7 @@ func file_pkg_proto_blobstore_local_local_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_blobstore_local_local_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
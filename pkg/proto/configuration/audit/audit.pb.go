@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v5.27.1
+// source: pkg/proto/configuration/audit/audit.proto
+
+package audit
+
+import (
+	grpc "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoggerConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Backend:
+	//
+	//	*LoggerConfiguration_JsonLinesPath
+	//	*LoggerConfiguration_Otlp
+	Backend isLoggerConfiguration_Backend `protobuf_oneof:"backend"`
+}
+
+func (x *LoggerConfiguration) Reset() {
+	*x = LoggerConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_audit_audit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggerConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggerConfiguration) ProtoMessage() {}
+
+func (x *LoggerConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_audit_audit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggerConfiguration.ProtoReflect.Descriptor instead.
+func (*LoggerConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_audit_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *LoggerConfiguration) GetBackend() isLoggerConfiguration_Backend {
+	if m != nil {
+		return m.Backend
+	}
+	return nil
+}
+
+func (x *LoggerConfiguration) GetJsonLinesPath() string {
+	if x, ok := x.GetBackend().(*LoggerConfiguration_JsonLinesPath); ok {
+		return x.JsonLinesPath
+	}
+	return ""
+}
+
+func (x *LoggerConfiguration) GetOtlp() *OTLPLoggerConfiguration {
+	if x, ok := x.GetBackend().(*LoggerConfiguration_Otlp); ok {
+		return x.Otlp
+	}
+	return nil
+}
+
+type isLoggerConfiguration_Backend interface {
+	isLoggerConfiguration_Backend()
+}
+
+type LoggerConfiguration_JsonLinesPath struct {
+	JsonLinesPath string `protobuf:"bytes,1,opt,name=json_lines_path,json=jsonLinesPath,proto3,oneof"`
+}
+
+type LoggerConfiguration_Otlp struct {
+	Otlp *OTLPLoggerConfiguration `protobuf:"bytes,2,opt,name=otlp,proto3,oneof"`
+}
+
+func (*LoggerConfiguration_JsonLinesPath) isLoggerConfiguration_Backend() {}
+
+func (*LoggerConfiguration_Otlp) isLoggerConfiguration_Backend() {}
+
+type OTLPLoggerConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GrpcClient             *grpc.ClientConfiguration `protobuf:"bytes,1,opt,name=grpc_client,json=grpcClient,proto3" json:"grpc_client,omitempty"`
+	FlushInterval          *durationpb.Duration      `protobuf:"bytes,2,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	MaximumBatchSize       int32                     `protobuf:"varint,3,opt,name=maximum_batch_size,json=maximumBatchSize,proto3" json:"maximum_batch_size,omitempty"`
+	MaximumBufferedRecords int32                     `protobuf:"varint,4,opt,name=maximum_buffered_records,json=maximumBufferedRecords,proto3" json:"maximum_buffered_records,omitempty"`
+}
+
+func (x *OTLPLoggerConfiguration) Reset() {
+	*x = OTLPLoggerConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_audit_audit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OTLPLoggerConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTLPLoggerConfiguration) ProtoMessage() {}
+
+func (x *OTLPLoggerConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_audit_audit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTLPLoggerConfiguration.ProtoReflect.Descriptor instead.
+func (*OTLPLoggerConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_audit_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OTLPLoggerConfiguration) GetGrpcClient() *grpc.ClientConfiguration {
+	if x != nil {
+		return x.GrpcClient
+	}
+	return nil
+}
+
+func (x *OTLPLoggerConfiguration) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *OTLPLoggerConfiguration) GetMaximumBatchSize() int32 {
+	if x != nil {
+		return x.MaximumBatchSize
+	}
+	return 0
+}
+
+func (x *OTLPLoggerConfiguration) GetMaximumBufferedRecords() int32 {
+	if x != nil {
+		return x.MaximumBufferedRecords
+	}
+	return 0
+}
+
+var File_pkg_proto_configuration_audit_audit_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_configuration_audit_audit_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2f,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x04, 0x6f, 0x74, 0x6c, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6f,
+	0x74, 0x6c, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x97,
+	0x02, 0x0a, 0x17, 0x4f, 0x54, 0x4c, 0x50, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x0b, 0x67, 0x72,
+	0x70, 0x63, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x40,
+	0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38,
+	0x0a, 0x18, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pkg_proto_configuration_audit_audit_proto_rawDescOnce sync.Once
+	file_pkg_proto_configuration_audit_audit_proto_rawDescData = file_pkg_proto_configuration_audit_audit_proto_rawDesc
+)
+
+func file_pkg_proto_configuration_audit_audit_proto_rawDescGZIP() []byte {
+	file_pkg_proto_configuration_audit_audit_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_configuration_audit_audit_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_configuration_audit_audit_proto_rawDescData)
+	})
+	return file_pkg_proto_configuration_audit_audit_proto_rawDescData
+}
+
+var file_pkg_proto_configuration_audit_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_proto_configuration_audit_audit_proto_goTypes = []interface{}{
+	(*LoggerConfiguration)(nil),      // 0: buildbarn.configuration.audit.LoggerConfiguration
+	(*OTLPLoggerConfiguration)(nil),  // 1: buildbarn.configuration.audit.OTLPLoggerConfiguration
+	(*grpc.ClientConfiguration)(nil), // 2: buildbarn.configuration.grpc.ClientConfiguration
+	(*durationpb.Duration)(nil),      // 3: google.protobuf.Duration
+}
+var file_pkg_proto_configuration_audit_audit_proto_depIdxs = []int32{
+	1, // 0: buildbarn.configuration.audit.LoggerConfiguration.otlp:type_name -> buildbarn.configuration.audit.OTLPLoggerConfiguration
+	2, // 1: buildbarn.configuration.audit.OTLPLoggerConfiguration.grpc_client:type_name -> buildbarn.configuration.grpc.ClientConfiguration
+	3, // 2: buildbarn.configuration.audit.OTLPLoggerConfiguration.flush_interval:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_configuration_audit_audit_proto_init() }
+func file_pkg_proto_configuration_audit_audit_proto_init() {
+	if File_pkg_proto_configuration_audit_audit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_proto_configuration_audit_audit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggerConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_configuration_audit_audit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OTLPLoggerConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_proto_configuration_audit_audit_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*LoggerConfiguration_JsonLinesPath)(nil),
+		(*LoggerConfiguration_Otlp)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_configuration_audit_audit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_configuration_audit_audit_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_configuration_audit_audit_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_configuration_audit_audit_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_configuration_audit_audit_proto = out.File
+	file_pkg_proto_configuration_audit_audit_proto_rawDesc = nil
+	file_pkg_proto_configuration_audit_audit_proto_goTypes = nil
+	file_pkg_proto_configuration_audit_audit_proto_depIdxs = nil
+}
@@ -38,6 +38,7 @@ type ApplicationConfiguration struct {
 	MaximumMessageSizeBytes int64                                  `protobuf:"varint,9,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
 	TraversalConcurrency    int32                                  `protobuf:"varint,10,opt,name=traversal_concurrency,json=traversalConcurrency,proto3" json:"traversal_concurrency,omitempty"`
 	DigestFunction          v2.DigestFunction_Value                `protobuf:"varint,11,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	CopyAllBlobs            bool                                   `protobuf:"varint,12,opt,name=copy_all_blobs,json=copyAllBlobs,proto3" json:"copy_all_blobs,omitempty"`
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -149,6 +150,13 @@ func (x *ApplicationConfiguration) GetDigestFunction() v2.DigestFunction_Value {
 	return v2.DigestFunction_Value(0)
 }
 
+func (x *ApplicationConfiguration) GetCopyAllBlobs() bool {
+	if x != nil {
+		return x.CopyAllBlobs
+	}
+	return false
+}
+
 var File_pkg_proto_configuration_bb_copy_bb_copy_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
@@ -163,7 +171,7 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x06, 0x0a, 0x18,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x06, 0x0a, 0x18,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
@@ -213,8 +221,10 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
 	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x70, 0x79, 0x41, 0x6c, 0x6c,
+	0x42, 0x6c, 0x6f, 0x62, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/http/client"
+)
+
+// ClientConfiguration is an alias of the HTTP client configuration
+// message, which has been moved into a separate package to prevent a
+// cyclic dependency between this package and the JWT configuration.
+//
+// Deprecated: Use client.Configuration instead.
+type ClientConfiguration = client.Configuration
+
+// ClientConfiguration_HeaderValues is an alias of the HTTP client
+// configuration's header values message.
+//
+// Deprecated: Use client.Configuration_HeaderValues instead.
+type ClientConfiguration_HeaderValues = client.Configuration_HeaderValues
@@ -26,10 +26,11 @@ type ClientConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerCertificateAuthorities string       `protobuf:"bytes,1,opt,name=server_certificate_authorities,json=serverCertificateAuthorities,proto3" json:"server_certificate_authorities,omitempty"`
-	CipherSuites                 []string     `protobuf:"bytes,4,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
-	ServerName                   string       `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
-	ClientKeyPair                *X509KeyPair `protobuf:"bytes,6,opt,name=client_key_pair,json=clientKeyPair,proto3" json:"client_key_pair,omitempty"`
+	ServerCertificateAuthorities string                     `protobuf:"bytes,1,opt,name=server_certificate_authorities,json=serverCertificateAuthorities,proto3" json:"server_certificate_authorities,omitempty"`
+	CipherSuites                 []string                   `protobuf:"bytes,4,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+	ServerName                   string                     `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	ClientKeyPair                *X509KeyPair               `protobuf:"bytes,6,opt,name=client_key_pair,json=clientKeyPair,proto3" json:"client_key_pair,omitempty"`
+	Spiffe                       *SPIFFEClientConfiguration `protobuf:"bytes,7,opt,name=spiffe,proto3" json:"spiffe,omitempty"`
 }
 
 func (x *ClientConfiguration) Reset() {
@@ -92,6 +93,100 @@ func (x *ClientConfiguration) GetClientKeyPair() *X509KeyPair {
 	return nil
 }
 
+func (x *ClientConfiguration) GetSpiffe() *SPIFFEClientConfiguration {
+	if x != nil {
+		return x.Spiffe
+	}
+	return nil
+}
+
+type SPIFFEClientConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SvidCertificatePath string               `protobuf:"bytes,1,opt,name=svid_certificate_path,json=svidCertificatePath,proto3" json:"svid_certificate_path,omitempty"`
+	SvidPrivateKeyPath  string               `protobuf:"bytes,2,opt,name=svid_private_key_path,json=svidPrivateKeyPath,proto3" json:"svid_private_key_path,omitempty"`
+	TrustBundlePath     string               `protobuf:"bytes,3,opt,name=trust_bundle_path,json=trustBundlePath,proto3" json:"trust_bundle_path,omitempty"`
+	RefreshInterval     *durationpb.Duration `protobuf:"bytes,4,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	TrustDomain         string               `protobuf:"bytes,5,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	ServerSpiffeIds     []string             `protobuf:"bytes,6,rep,name=server_spiffe_ids,json=serverSpiffeIds,proto3" json:"server_spiffe_ids,omitempty"`
+}
+
+func (x *SPIFFEClientConfiguration) Reset() {
+	*x = SPIFFEClientConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPIFFEClientConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPIFFEClientConfiguration) ProtoMessage() {}
+
+func (x *SPIFFEClientConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPIFFEClientConfiguration.ProtoReflect.Descriptor instead.
+func (*SPIFFEClientConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SPIFFEClientConfiguration) GetSvidCertificatePath() string {
+	if x != nil {
+		return x.SvidCertificatePath
+	}
+	return ""
+}
+
+func (x *SPIFFEClientConfiguration) GetSvidPrivateKeyPath() string {
+	if x != nil {
+		return x.SvidPrivateKeyPath
+	}
+	return ""
+}
+
+func (x *SPIFFEClientConfiguration) GetTrustBundlePath() string {
+	if x != nil {
+		return x.TrustBundlePath
+	}
+	return ""
+}
+
+func (x *SPIFFEClientConfiguration) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *SPIFFEClientConfiguration) GetTrustDomain() string {
+	if x != nil {
+		return x.TrustDomain
+	}
+	return ""
+}
+
+func (x *SPIFFEClientConfiguration) GetServerSpiffeIds() []string {
+	if x != nil {
+		return x.ServerSpiffeIds
+	}
+	return nil
+}
+
 type ServerConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -104,7 +199,7 @@ type ServerConfiguration struct {
 func (x *ServerConfiguration) Reset() {
 	*x = ServerConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[1]
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -117,7 +212,7 @@ func (x *ServerConfiguration) String() string {
 func (*ServerConfiguration) ProtoMessage() {}
 
 func (x *ServerConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[1]
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -130,7 +225,7 @@ func (x *ServerConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerConfiguration.ProtoReflect.Descriptor instead.
 func (*ServerConfiguration) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ServerConfiguration) GetCipherSuites() []string {
@@ -162,7 +257,7 @@ type X509KeyPair struct {
 func (x *X509KeyPair) Reset() {
 	*x = X509KeyPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -175,7 +270,7 @@ func (x *X509KeyPair) String() string {
 func (*X509KeyPair) ProtoMessage() {}
 
 func (x *X509KeyPair) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +283,7 @@ func (x *X509KeyPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use X509KeyPair.ProtoReflect.Descriptor instead.
 func (*X509KeyPair) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *X509KeyPair) GetKeyPair() isX509KeyPair_KeyPair {
@@ -240,7 +335,7 @@ type X509KeyPair_Inline struct {
 func (x *X509KeyPair_Inline) Reset() {
 	*x = X509KeyPair_Inline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +348,7 @@ func (x *X509KeyPair_Inline) String() string {
 func (*X509KeyPair_Inline) ProtoMessage() {}
 
 func (x *X509KeyPair_Inline) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +361,7 @@ func (x *X509KeyPair_Inline) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use X509KeyPair_Inline.ProtoReflect.Descriptor instead.
 func (*X509KeyPair_Inline) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2, 0}
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *X509KeyPair_Inline) GetCertificate() string {
@@ -296,7 +391,7 @@ type X509KeyPair_Files struct {
 func (x *X509KeyPair_Files) Reset() {
 	*x = X509KeyPair_Files{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +404,7 @@ func (x *X509KeyPair_Files) String() string {
 func (*X509KeyPair_Files) ProtoMessage() {}
 
 func (x *X509KeyPair_Files) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +417,7 @@ func (x *X509KeyPair_Files) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use X509KeyPair_Files.ProtoReflect.Descriptor instead.
 func (*X509KeyPair_Files) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2, 1}
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *X509KeyPair_Files) GetCertificatePath() string {
@@ -355,7 +450,7 @@ var file_pkg_proto_configuration_tls_tls_proto_rawDesc = []byte{
 	0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x74, 0x6c, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x1e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
@@ -370,48 +465,73 @@ var file_pkg_proto_configuration_tls_tls_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e,
 	0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
-	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
-	0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65,
-	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x4b,
-	0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x50, 0x61, 0x69, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10,
-	0x03, 0x22, 0x9e, 0x03, 0x0a, 0x0b, 0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x12, 0x49, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x70,
+	0x69, 0x66, 0x66, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xc3, 0x02, 0x0a, 0x19, 0x53, 0x50, 0x49, 0x46, 0x46,
+	0x45, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x76, 0x69, 0x64, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x76, 0x69, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x76, 0x69, 0x64,
+	0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x76, 0x69, 0x64, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x69, 0x66, 0x66,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x70, 0x69, 0x66, 0x66, 0x65, 0x49, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73,
+	0x2e, 0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x9e, 0x03, 0x0a, 0x0b, 0x58, 0x35, 0x30, 0x39,
+	0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x49, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x2e, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e,
-	0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x49, 0x6e, 0x6c, 0x69,
-	0x6e, 0x65, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x4b, 0x65,
-	0x79, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x06, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x1a, 0xa2, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61,
-	0x69, 0x72, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x6c,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x58, 0x35, 0x30, 0x39, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x06, 0x49, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0xa2, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -426,26 +546,29 @@ func file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_configuration_tls_tls_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_tls_tls_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_proto_configuration_tls_tls_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pkg_proto_configuration_tls_tls_proto_goTypes = []interface{}{
-	(*ClientConfiguration)(nil), // 0: buildbarn.configuration.tls.ClientConfiguration
-	(*ServerConfiguration)(nil), // 1: buildbarn.configuration.tls.ServerConfiguration
-	(*X509KeyPair)(nil),         // 2: buildbarn.configuration.tls.X509KeyPair
-	(*X509KeyPair_Inline)(nil),  // 3: buildbarn.configuration.tls.X509KeyPair.Inline
-	(*X509KeyPair_Files)(nil),   // 4: buildbarn.configuration.tls.X509KeyPair.Files
-	(*durationpb.Duration)(nil), // 5: google.protobuf.Duration
+	(*ClientConfiguration)(nil),       // 0: buildbarn.configuration.tls.ClientConfiguration
+	(*SPIFFEClientConfiguration)(nil), // 1: buildbarn.configuration.tls.SPIFFEClientConfiguration
+	(*ServerConfiguration)(nil),       // 2: buildbarn.configuration.tls.ServerConfiguration
+	(*X509KeyPair)(nil),               // 3: buildbarn.configuration.tls.X509KeyPair
+	(*X509KeyPair_Inline)(nil),        // 4: buildbarn.configuration.tls.X509KeyPair.Inline
+	(*X509KeyPair_Files)(nil),         // 5: buildbarn.configuration.tls.X509KeyPair.Files
+	(*durationpb.Duration)(nil),       // 6: google.protobuf.Duration
 }
 var file_pkg_proto_configuration_tls_tls_proto_depIdxs = []int32{
-	2, // 0: buildbarn.configuration.tls.ClientConfiguration.client_key_pair:type_name -> buildbarn.configuration.tls.X509KeyPair
-	2, // 1: buildbarn.configuration.tls.ServerConfiguration.server_key_pair:type_name -> buildbarn.configuration.tls.X509KeyPair
-	3, // 2: buildbarn.configuration.tls.X509KeyPair.inline:type_name -> buildbarn.configuration.tls.X509KeyPair.Inline
-	4, // 3: buildbarn.configuration.tls.X509KeyPair.files:type_name -> buildbarn.configuration.tls.X509KeyPair.Files
-	5, // 4: buildbarn.configuration.tls.X509KeyPair.Files.refresh_interval:type_name -> google.protobuf.Duration
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3, // 0: buildbarn.configuration.tls.ClientConfiguration.client_key_pair:type_name -> buildbarn.configuration.tls.X509KeyPair
+	1, // 1: buildbarn.configuration.tls.ClientConfiguration.spiffe:type_name -> buildbarn.configuration.tls.SPIFFEClientConfiguration
+	6, // 2: buildbarn.configuration.tls.SPIFFEClientConfiguration.refresh_interval:type_name -> google.protobuf.Duration
+	3, // 3: buildbarn.configuration.tls.ServerConfiguration.server_key_pair:type_name -> buildbarn.configuration.tls.X509KeyPair
+	4, // 4: buildbarn.configuration.tls.X509KeyPair.inline:type_name -> buildbarn.configuration.tls.X509KeyPair.Inline
+	5, // 5: buildbarn.configuration.tls.X509KeyPair.files:type_name -> buildbarn.configuration.tls.X509KeyPair.Files
+	6, // 6: buildbarn.configuration.tls.X509KeyPair.Files.refresh_interval:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_tls_tls_proto_init() }
@@ -467,7 +590,7 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			}
 		}
 		file_pkg_proto_configuration_tls_tls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerConfiguration); i {
+			switch v := v.(*SPIFFEClientConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -479,7 +602,7 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			}
 		}
 		file_pkg_proto_configuration_tls_tls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*X509KeyPair); i {
+			switch v := v.(*ServerConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -491,7 +614,7 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			}
 		}
 		file_pkg_proto_configuration_tls_tls_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*X509KeyPair_Inline); i {
+			switch v := v.(*X509KeyPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -503,6 +626,18 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			}
 		}
 		file_pkg_proto_configuration_tls_tls_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*X509KeyPair_Inline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_configuration_tls_tls_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*X509KeyPair_Files); i {
 			case 0:
 				return &v.state
@@ -515,7 +650,7 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			}
 		}
 	}
-	file_pkg_proto_configuration_tls_tls_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_pkg_proto_configuration_tls_tls_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*X509KeyPair_Inline_)(nil),
 		(*X509KeyPair_Files_)(nil),
 	}
@@ -525,7 +660,7 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_tls_tls_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
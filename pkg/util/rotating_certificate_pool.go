@@ -0,0 +1,64 @@
+package util
+
+import (
+	"bytes"
+	"crypto/x509"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RotatingCertificatePool provides an up-to-date pool of certificates
+// stored in a PEM file, such as the X.509 authorities of a SPIFFE trust
+// bundle.
+type RotatingCertificatePool struct {
+	path string
+
+	lock sync.RWMutex
+	pool *x509.CertPool
+	data []byte
+}
+
+// NewRotatingCertificatePool creates a RotatingCertificatePool that
+// loads certificates from a given path. LoadCertificatePool() needs to
+// be called to perform the initial load.
+func NewRotatingCertificatePool(path string) *RotatingCertificatePool {
+	return &RotatingCertificatePool{
+		path: path,
+	}
+}
+
+// GetCertificatePool provides the most recently obtained semantically
+// valid certificate pool.
+func (r *RotatingCertificatePool) GetCertificatePool() *x509.CertPool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.pool
+}
+
+// LoadCertificatePool from a file on disk. Aborts if the file has not
+// changed.
+func (r *RotatingCertificatePool) LoadCertificatePool() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return StatusWrap(err, "Failed to read certificate file")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Skip updating if the certificates have not changed.
+	if bytes.Equal(r.data, data) {
+		return nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return status.Error(codes.InvalidArgument, "Certificate file does not contain any valid certificates")
+	}
+	r.pool = pool
+	r.data = data
+	return nil
+}
@@ -110,6 +110,26 @@ func refreshTLSCertOnInterval(cert *RotatingTLSCertificate, refreshInterval *dur
 	return nil
 }
 
+func refreshCertificatePoolOnInterval(pool *RotatingCertificatePool, refreshInterval time.Duration) error {
+	if err := pool.LoadCertificatePool(); err != nil {
+		return err
+	}
+
+	// TODO: Run this as part of the program.Group, so that it gets
+	// cleaned up upon shutdown.
+	go func() {
+		t := time.NewTicker(refreshInterval)
+		for {
+			<-t.C
+			if err := pool.LoadCertificatePool(); err != nil {
+				log.Printf("Failed to reload trust bundle: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
 func registerTLSCertificate(tlsKeyPair *pb.X509KeyPair, certificateUsage string) (func() *tls.Certificate, error) {
 	switch keyPair := tlsKeyPair.KeyPair.(type) {
 	case *pb.X509KeyPair_Inline_:
@@ -151,6 +171,16 @@ func NewTLSConfigFromClientConfiguration(configuration *pb.ClientConfiguration)
 	}
 	tlsConfig.ServerName = configuration.ServerName
 
+	if spiffeConfiguration := configuration.Spiffe; spiffeConfiguration != nil {
+		if configuration.ServerCertificateAuthorities != "" || configuration.ClientKeyPair != nil {
+			return nil, status.Error(codes.InvalidArgument, "SPIFFE cannot be combined with server certificate authorities or a client key pair")
+		}
+		if err := configureSPIFFEClient(tlsConfig, spiffeConfiguration); err != nil {
+			return nil, StatusWrapWithCode(err, codes.InvalidArgument, "Failed to configure SPIFFE")
+		}
+		return tlsConfig, nil
+	}
+
 	// No client TLS is used when this is unset.
 	if configuration.ClientKeyPair != nil {
 		getLatestCert, err := registerTLSCertificate(configuration.ClientKeyPair, tlsCertificateUsageClient)
@@ -175,6 +205,65 @@ func NewTLSConfigFromClientConfiguration(configuration *pb.ClientConfiguration)
 	return tlsConfig, nil
 }
 
+// configureSPIFFEClient adjusts a TLS configuration for use with a TLS
+// client, so that it presents an X.509-SVID to the server, and
+// validates that the server presents an X.509-SVID as well.
+func configureSPIFFEClient(tlsConfig *tls.Config, configuration *pb.SPIFFEClientConfiguration) error {
+	if err := configuration.RefreshInterval.CheckValid(); err != nil {
+		return StatusWrap(err, "Failed to parse refresh interval")
+	}
+	refreshInterval := configuration.RefreshInterval.AsDuration()
+	if refreshInterval <= 0 {
+		return status.Error(codes.InvalidArgument, "Refresh interval must be positive")
+	}
+
+	cert := NewRotatingTLSCertificate(configuration.SvidCertificatePath, configuration.SvidPrivateKeyPath)
+	if err := refreshTLSCertOnInterval(cert, configuration.RefreshInterval, tlsCertificateUsageClient); err != nil {
+		return StatusWrap(err, "Failed to initialize X.509-SVID")
+	}
+	trustBundle := NewRotatingCertificatePool(configuration.TrustBundlePath)
+	if err := refreshCertificatePoolOnInterval(trustBundle, refreshInterval); err != nil {
+		return StatusWrap(err, "Failed to initialize trust bundle")
+	}
+
+	trustDomain := configuration.TrustDomain
+	serverSPIFFEIDs := make(map[string]struct{}, len(configuration.ServerSpiffeIds))
+	for _, serverSPIFFEID := range configuration.ServerSpiffeIds {
+		serverSPIFFEIDs[serverSPIFFEID] = struct{}{}
+	}
+
+	tlsConfig.GetClientCertificate = func(chi *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return cert.GetCertificate(), nil
+	}
+
+	// X.509-SVIDs identify workloads by SPIFFE ID, as opposed to
+	// DNS names. Disable the standard validation of the server's
+	// certificate and hostname, and validate the server's
+	// X.509-SVID against the trust bundle instead.
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return StatusWrapWithCode(err, codes.Unauthenticated, "Failed to parse server certificate")
+			}
+			certs = append(certs, cert)
+		}
+		spiffeID, err := VerifyX509SVID(certs, trustBundle.GetCertificatePool(), trustDomain, time.Now())
+		if err != nil {
+			return err
+		}
+		if len(serverSPIFFEIDs) > 0 {
+			if _, ok := serverSPIFFEIDs[spiffeID.String()]; !ok {
+				return status.Errorf(codes.Unauthenticated, "Server presented unexpected SPIFFE ID %#v", spiffeID.String())
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 // NewTLSConfigFromServerConfiguration creates a TLS configuration
 // object based on parameters specified in a Protobuf message for use
 // with a TLS server. This Protobuf message is embedded in Buildbarn
@@ -265,6 +265,50 @@ func TestTLSConfigFromClientConfiguration(t *testing.T) {
 			ServerName: "example.com",
 		}, tlsConfig)
 	})
+
+	t.Run("SPIFFEWithClientKeyPair", func(t *testing.T) {
+		_, err := util.NewTLSConfigFromClientConfiguration(
+			&configuration.ClientConfiguration{
+				ClientKeyPair: &configuration.X509KeyPair{
+					KeyPair: &configuration.X509KeyPair_Inline_{
+						Inline: &configuration.X509KeyPair_Inline{
+							Certificate: exampleCertificate,
+							PrivateKey:  examplePrivateKey,
+						},
+					},
+				},
+				Spiffe: &configuration.SPIFFEClientConfiguration{},
+			})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "SPIFFE cannot be combined with server certificate authorities or a client key pair"), err)
+	})
+
+	t.Run("SPIFFE", func(t *testing.T) {
+		tlsConfig, err := util.NewTLSConfigFromClientConfiguration(
+			&configuration.ClientConfiguration{
+				Spiffe: &configuration.SPIFFEClientConfiguration{
+					SvidCertificatePath: exampleCertFile,
+					SvidPrivateKeyPath:  exampleKeyFile,
+					TrustBundlePath:     exampleCertFile,
+					RefreshInterval:     durationpb.New(time.Hour),
+					TrustDomain:         "example.com",
+				},
+			})
+		require.NoError(t, err)
+
+		// The X.509-SVID should be presented to the server.
+		clientCert, err := tlsConfig.GetClientCertificate(nil)
+		require.NoError(t, err)
+		require.NotNil(t, clientCert)
+
+		// The server's certificate should be validated as an
+		// X.509-SVID, instead of being matched against the
+		// server name.
+		require.True(t, tlsConfig.InsecureSkipVerify)
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unauthenticated, "X.509-SVID contains 0 URI SANs, while exactly one was expected"),
+			tlsConfig.VerifyPeerCertificate(clientCert.Certificate, nil))
+	})
 }
 
 func TestTLSConfigFromServerConfiguration(t *testing.T) {
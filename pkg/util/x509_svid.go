@@ -0,0 +1,51 @@
+package util
+
+import (
+	"crypto/x509"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyX509SVID verifies that a certificate chain contains a valid
+// SPIFFE X.509-SVID that can be validated against the X.509 authorities
+// of a trust bundle, and belongs to a given trust domain. Upon success,
+// the SPIFFE ID contained in the X.509-SVID is returned.
+//
+// The rules that are applied are described in the SPIFFE X.509-SVID
+// specification: https://github.com/spiffe/spiffe/blob/main/standards/X509-SVID.md
+func VerifyX509SVID(certs []*x509.Certificate, trustBundle *x509.CertPool, trustDomain string, now time.Time) (*url.URL, error) {
+	if len(certs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "No X.509-SVID provided")
+	}
+	leaf := certs[0]
+	if leaf.IsCA {
+		return nil, status.Error(codes.Unauthenticated, "X.509-SVID leaf certificate is a CA certificate")
+	}
+	if len(leaf.URIs) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "X.509-SVID contains %d URI SANs, while exactly one was expected", len(leaf.URIs))
+	}
+	spiffeID := leaf.URIs[0]
+	if spiffeID.Scheme != "spiffe" || spiffeID.Host == "" || spiffeID.User != nil || spiffeID.RawQuery != "" || spiffeID.Fragment != "" {
+		return nil, status.Errorf(codes.Unauthenticated, "X.509-SVID contains invalid SPIFFE ID %#v", spiffeID.String())
+	}
+	if spiffeID.Host != trustDomain {
+		return nil, status.Errorf(codes.Unauthenticated, "SPIFFE ID %#v does not belong to trust domain %#v", spiffeID.String(), trustDomain)
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         trustBundle,
+		CurrentTime:   now,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(opts); err != nil {
+		return nil, StatusWrapWithCode(err, codes.Unauthenticated, "Cannot validate X.509-SVID")
+	}
+	return spiffeID, nil
+}